@@ -0,0 +1,36 @@
+package libphonenumber
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	// A mapping from a country calling code to the alternate formats
+	// metadata for that country calling code. Alternate formats list
+	// extra ways of grouping a number that are commonly seen in writing,
+	// on top of the formats used by Format. They are loaded from
+	// alternateFormatsData on first use.
+	countryCodeToAlternateFormatsMap map[int]*PhoneMetadata
+	alternateFormatsOnce             sync.Once
+)
+
+func loadAlternateFormats() {
+	alternateFormats, err := unmarshalMetadataCollection(alternateFormatsData)
+	if err != nil {
+		// Compiled in like the metadata; see the panic in init.
+		panic(fmt.Sprintf("libphonenumber: invalid alternate formats metadata: %v", err))
+	}
+	countryCodeToAlternateFormatsMap = make(map[int]*PhoneMetadata)
+	for _, meta := range alternateFormats.GetMetadata() {
+		countryCodeToAlternateFormatsMap[int(meta.GetCountryCode())] = meta
+	}
+}
+
+// Returns the alternate formats metadata for the given country calling
+// code, or nil if there are no alternate formats for it. Only the
+// country code and the number formats of the returned metadata are set.
+func GetAlternateFormatsForCountry(countryCallingCode int) *PhoneMetadata {
+	alternateFormatsOnce.Do(loadAlternateFormats)
+	return countryCodeToAlternateFormatsMap[countryCallingCode]
+}
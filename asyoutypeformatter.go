@@ -0,0 +1,730 @@
+package libphonenumber
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/builder"
+)
+
+const (
+	// Character used when appropriate to separate a prefix, such as a
+	// long NDD or a country calling code, from the national number.
+	SEPARATOR_BEFORE_NATIONAL_NUMBER = ' '
+
+	// The digits that have not been entered yet will be represented by
+	// a  , the punctuation space.
+	DIGIT_PLACEHOLDER = ' '
+
+	// The minimum length of national number accrued that is required to
+	// trigger the formatter. The first element of the
+	// leadingDigitsPattern of each numberFormat contains a regular
+	// expression that matches up to this number of digits.
+	MIN_LEADING_DIGITS_LENGTH = 3
+)
+
+var (
+	// A pattern that is used to determine if a numberFormat under
+	// availableFormats is eligible to be used by the AYTF. It is eligible
+	// when the format element under numberFormat contains groups of the
+	// dollar sign followed by a single digit, separated by valid phone
+	// number punctuation. This prevents invalid punctuation (such as the
+	// star sign in Israeli star numbers) getting into the output of the
+	// AYTF.
+	ELIGIBLE_FORMAT_PATTERN = regexp.MustCompile(
+		"^[" + VALID_PUNCTUATION + "]*" + "\\$1" +
+			"[" + VALID_PUNCTUATION + "]*(\\$\\d" +
+			"[" + VALID_PUNCTUATION + "]*)*$")
+
+	// A set of characters that, if found in a national prefix formatting
+	// rule, are an indicator to us that we should separate the national
+	// prefix from the number when formatting.
+	NATIONAL_PREFIX_SEPARATORS_PATTERN = regexp.MustCompile("[- ]")
+
+	// This is the minimum length of the longest phone number that the
+	// formatting templates are built from.
+	LONGEST_PHONE_NUMBER = "999999999999999"
+
+	// Metadata used when no metadata is available for the region the
+	// formatter was created for.
+	EMPTY_METADATA = &PhoneMetadata{
+		Id:                  proto.String(""),
+		InternationalPrefix: proto.String("NA"),
+	}
+)
+
+// An AsYouTypeFormatter formats phone numbers on-the-fly as users enter
+// each digit. A formatter is created for a region with
+// GetAsYouTypeFormatter, after which digits are fed to it one at a time
+// with InputDigit. Each call returns the number entered so far, formatted
+// as far as the metadata for the region allows:
+//
+//	formatter := GetAsYouTypeFormatter("US")
+//	for _, c := range "6502530000" {
+//	        fmt.Println(formatter.InputDigit(c))
+//	}
+//
+// prints "6", "65", "650", "650-2", "650-25", "650-253", "650-2530",
+// "(650) 253-00", "(650) 253-000" and finally "(650) 253-0000". Call
+// Clear to reuse the formatter for a new number. An AsYouTypeFormatter
+// is not safe for concurrent use.
+type AsYouTypeFormatter struct {
+	// The PhoneNumberUtil whose metadata is used.
+	util *PhoneNumberUtil
+
+	currentOutput            string
+	formattingTemplate       []rune
+	currentFormattingPattern string
+
+	// The characters entered so far, including formatting.
+	accruedInput []rune
+	// The digits and leading plus sign entered so far.
+	accruedInputWithoutFormatting *builder.Builder
+	// This indicates whether AsYouTypeFormatter is currently doing the
+	// formatting.
+	ableToFormat bool
+	// Set to true when users enter their own formatting.
+	// AsYouTypeFormatter will do no formatting at all when this is set
+	// to true.
+	inputHasFormatting bool
+	// This is set to true when we know the user is entering a full
+	// national significant number, since we have either detected a
+	// national prefix or an international dialing prefix. When this is
+	// true, we will no longer use local number formatting patterns.
+	isCompleteNumber              bool
+	isExpectingCountryCallingCode bool
+	defaultCountry                string
+
+	defaultMetadata *PhoneMetadata
+	currentMetadata *PhoneMetadata
+
+	lastMatchPosition int
+	// The position of a digit upon which InputDigitAndRememberPosition is
+	// most recently invoked, as found in the original sequence of
+	// characters the user entered.
+	originalPosition int
+	// The position of a digit upon which InputDigitAndRememberPosition is
+	// most recently invoked, as found in accruedInputWithoutFormatting.
+	positionToRemember int
+	// This contains anything that has been entered so far preceding the
+	// national significant number, and it is formatted (e.g. with space
+	// inserted). For example, this can contain IDD, country calling code,
+	// and/or NDD, etc.
+	prefixBeforeNationalNumber *builder.Builder
+	// This indicates whether a space should be added between the
+	// national prefix and the national significant number.
+	shouldAddSpaceAfterNationalPrefix bool
+	// This contains the national prefix that has been extracted. It
+	// contains only digits without formatting.
+	extractedNationalPrefix string
+	nationalNumber          *builder.Builder
+	possibleFormats         []*NumberFormat
+}
+
+// Constructs an as-you-type formatter. Should be obtained from
+// GetAsYouTypeFormatter.
+func newAsYouTypeFormatter(
+	util *PhoneNumberUtil,
+	regionCode string) *AsYouTypeFormatter {
+
+	f := &AsYouTypeFormatter{
+		util:                          util,
+		ableToFormat:                  true,
+		accruedInputWithoutFormatting: builder.NewBuilder(nil),
+		prefixBeforeNationalNumber:    builder.NewBuilder(nil),
+		nationalNumber:                builder.NewBuilder(nil),
+		defaultCountry:                regionCode,
+	}
+	f.currentMetadata = f.getMetadataForRegion(regionCode)
+	f.defaultMetadata = f.currentMetadata
+	return f
+}
+
+// The metadata needed by this class is the same for all regions sharing
+// the same country calling code. Therefore, we return the metadata for
+// "main" region for this country calling code.
+func (f *AsYouTypeFormatter) getMetadataForRegion(regionCode string) *PhoneMetadata {
+	countryCallingCode := f.util.GetCountryCodeForRegion(regionCode)
+	mainCountry := f.util.GetRegionCodeForCountryCode(countryCallingCode)
+	metadata := f.util.getMetadataForRegion(mainCountry)
+	if metadata != nil {
+		return metadata
+	}
+	// Set to a default instance of the metadata. This allows us to
+	// function with an incorrect region code, even if formatting only
+	// works for numbers specified with "+".
+	return EMPTY_METADATA
+}
+
+// Returns true if a new template is created as opposed to reusing the
+// existing template.
+func (f *AsYouTypeFormatter) maybeCreateNewTemplate() bool {
+	// When there are multiple available formats, the formatter uses the
+	// first format where a formatting template could be created.
+	for len(f.possibleFormats) > 0 {
+		numberFormat := f.possibleFormats[0]
+		pattern := numberFormat.GetPattern()
+		if f.currentFormattingPattern == pattern {
+			return false
+		}
+		if f.createFormattingTemplate(numberFormat) {
+			f.currentFormattingPattern = pattern
+			f.shouldAddSpaceAfterNationalPrefix =
+				NATIONAL_PREFIX_SEPARATORS_PATTERN.MatchString(
+					numberFormat.GetNationalPrefixFormattingRule())
+			// With a new formatting template, the matched position
+			// using the old template needs to be reset.
+			f.lastMatchPosition = 0
+			return true
+		}
+		f.possibleFormats = f.possibleFormats[1:]
+	}
+	f.ableToFormat = false
+	return false
+}
+
+func (f *AsYouTypeFormatter) getAvailableFormats(leadingDigits string) {
+	// First decide whether we should use international or national
+	// number rules.
+	isInternationalNumber :=
+		f.isCompleteNumber && len(f.extractedNationalPrefix) == 0
+	formatList := f.currentMetadata.GetNumberFormat()
+	if isInternationalNumber && len(f.currentMetadata.GetIntlNumberFormat()) > 0 {
+		formatList = f.currentMetadata.GetIntlNumberFormat()
+	}
+	for _, format := range formatList {
+		// Discard a few formats that we know are not relevant based on
+		// the presence of the national prefix.
+		if len(f.extractedNationalPrefix) > 0 &&
+			formattingRuleHasFirstGroupOnly(format.GetNationalPrefixFormattingRule()) &&
+			!format.GetNationalPrefixOptionalWhenFormatting() &&
+			format.DomesticCarrierCodeFormattingRule == nil {
+			// If it is a national number that had a national prefix,
+			// any rules that aren't valid with a national prefix should
+			// be excluded. A rule that has a carrier-code formatting
+			// rule is kept since the national prefix might actually be
+			// an extracted carrier code - we don't distinguish between
+			// these when extracting it in the AYTF.
+			continue
+		} else if len(f.extractedNationalPrefix) == 0 &&
+			!f.isCompleteNumber &&
+			!formattingRuleHasFirstGroupOnly(format.GetNationalPrefixFormattingRule()) &&
+			!format.GetNationalPrefixOptionalWhenFormatting() {
+			// This number was entered without a national prefix, and
+			// this formatting rule requires one, so we discard it.
+			continue
+		}
+		if ELIGIBLE_FORMAT_PATTERN.MatchString(format.GetFormat()) {
+			f.possibleFormats = append(f.possibleFormats, format)
+		}
+	}
+	f.narrowDownPossibleFormats(leadingDigits)
+}
+
+func (f *AsYouTypeFormatter) narrowDownPossibleFormats(leadingDigits string) {
+	indexOfLeadingDigitsPattern := len(leadingDigits) - MIN_LEADING_DIGITS_LENGTH
+	var formats = f.possibleFormats[:0]
+	for _, format := range f.possibleFormats {
+		leadingDigitsPatterns := format.GetLeadingDigitsPattern()
+		if len(leadingDigitsPatterns) == 0 {
+			// Keep everything that isn't restricted by leading digits.
+			formats = append(formats, format)
+			continue
+		}
+		lastLeadingDigitsPattern := indexOfLeadingDigitsPattern
+		if lastLeadingDigitsPattern > len(leadingDigitsPatterns)-1 {
+			lastLeadingDigitsPattern = len(leadingDigitsPatterns) - 1
+		}
+		leadingDigitsPattern := f.util.regexForPrefix(
+			leadingDigitsPatterns[lastLeadingDigitsPattern])
+		if leadingDigitsPattern.MatchString(leadingDigits) {
+			formats = append(formats, format)
+		}
+	}
+	f.possibleFormats = formats
+}
+
+func (f *AsYouTypeFormatter) createFormattingTemplate(format *NumberFormat) bool {
+	f.formattingTemplate = f.formattingTemplate[:0]
+	tempTemplate := f.getFormattingTemplate(format.GetPattern(), format.GetFormat())
+	if len(tempTemplate) > 0 {
+		f.formattingTemplate = append(f.formattingTemplate, []rune(tempTemplate)...)
+		return true
+	}
+	return false
+}
+
+// Gets a formatting template which can be used to efficiently format a
+// partial number where digits are added one by one.
+func (f *AsYouTypeFormatter) getFormattingTemplate(
+	numberPattern, numberFormat string) string {
+
+	// Creates a phone number consisting only of the digit 9 that matches
+	// the numberPattern by applying the pattern to the
+	// LONGEST_PHONE_NUMBER string.
+	pattern, ok := f.util.readFromRegexCache(numberPattern)
+	if !ok {
+		pattern = regexp.MustCompile(numberPattern)
+		f.util.writeToRegexCache(numberPattern, pattern)
+	}
+	aPhoneNumber := pattern.FindString(LONGEST_PHONE_NUMBER)
+	// No formatting template can be created if the number of digits
+	// entered so far is longer than the maximum the current formatting
+	// rule can accommodate.
+	if len(aPhoneNumber) == 0 || len(aPhoneNumber) < f.nationalNumber.Len() {
+		return ""
+	}
+	// Formats the number according to numberFormat.
+	template := pattern.ReplaceAllString(aPhoneNumber, numberFormat)
+	// Replaces each digit with character DIGIT_PLACEHOLDER.
+	return strings.Replace(template, "9", string(DIGIT_PLACEHOLDER), -1)
+}
+
+// Clears the internal state of the formatter, so it can be reused.
+func (f *AsYouTypeFormatter) Clear() {
+	f.currentOutput = ""
+	f.accruedInput = f.accruedInput[:0]
+	f.accruedInputWithoutFormatting.Reset()
+	f.formattingTemplate = f.formattingTemplate[:0]
+	f.lastMatchPosition = 0
+	f.currentFormattingPattern = ""
+	f.prefixBeforeNationalNumber.Reset()
+	f.extractedNationalPrefix = ""
+	f.nationalNumber.Reset()
+	f.ableToFormat = true
+	f.inputHasFormatting = false
+	f.positionToRemember = 0
+	f.originalPosition = 0
+	f.isCompleteNumber = false
+	f.isExpectingCountryCallingCode = false
+	f.possibleFormats = f.possibleFormats[:0]
+	f.shouldAddSpaceAfterNationalPrefix = false
+	if f.currentMetadata != f.defaultMetadata {
+		f.currentMetadata = f.getMetadataForRegion(f.defaultCountry)
+	}
+}
+
+// Formats a phone number on-the-fly as each digit is entered. The
+// nextChar is the most recently entered digit of a phone number.
+// Formatting characters are allowed, but as soon as they are
+// encountered this method formats the number as entered and not
+// "as you type" anymore. Full width digits and Arabic-indic digits are
+// allowed, and will be shown as they are. Returns the partially
+// formatted phone number.
+func (f *AsYouTypeFormatter) InputDigit(nextChar rune) string {
+	f.currentOutput = f.inputDigitWithOptionToRememberPosition(nextChar, false)
+	return f.currentOutput
+}
+
+// Same as InputDigit, but remembers the position where nextChar is
+// inserted, so that it can be retrieved later by using
+// GetRememberedPosition. The remembered position will be automatically
+// adjusted if additional formatting characters are later inserted or
+// removed in front of nextChar.
+func (f *AsYouTypeFormatter) InputDigitAndRememberPosition(nextChar rune) string {
+	f.currentOutput = f.inputDigitWithOptionToRememberPosition(nextChar, true)
+	return f.currentOutput
+}
+
+func (f *AsYouTypeFormatter) inputDigitWithOptionToRememberPosition(
+	nextChar rune,
+	rememberPosition bool) string {
+
+	f.accruedInput = append(f.accruedInput, nextChar)
+	if rememberPosition {
+		f.originalPosition = len(f.accruedInput)
+	}
+	// We do formatting on-the-fly only when each character entered is
+	// either a digit, or a plus sign (accepted at the start of the
+	// number only).
+	if !f.isDigitOrLeadingPlusSign(nextChar) {
+		f.ableToFormat = false
+		f.inputHasFormatting = true
+	} else {
+		nextChar = f.normalizeAndAccrueDigitsAndPlusSign(nextChar, rememberPosition)
+	}
+	if !f.ableToFormat {
+		// When we are unable to format because of reasons other than
+		// that formatting chars have been entered, it can be due to
+		// really long IDDs or NDDs. If that is the case, we might be
+		// able to do formatting again after extracting them.
+		if f.inputHasFormatting {
+			return string(f.accruedInput)
+		} else if f.attemptToExtractIdd() {
+			if f.attemptToExtractCountryCallingCode() {
+				return f.attemptToChoosePatternWithPrefixExtracted()
+			}
+		} else if f.ableToExtractLongerNdd() {
+			// Add an additional space to separate long NDD and national
+			// significant number for readability. We don't set
+			// shouldAddSpaceAfterNationalPrefix to true, since we don't
+			// want this to change later when we choose formatting
+			// templates.
+			f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+			return f.attemptToChoosePatternWithPrefixExtracted()
+		}
+		return string(f.accruedInput)
+	}
+
+	// We start to attempt to format only when at least
+	// MIN_LEADING_DIGITS_LENGTH digits (the plus sign is counted as a
+	// digit as well for this purpose) have been entered.
+	switch f.accruedInputWithoutFormatting.Len() {
+	case 0, 1, 2:
+		return string(f.accruedInput)
+	case 3:
+		if f.attemptToExtractIdd() {
+			f.isExpectingCountryCallingCode = true
+		} else {
+			// No IDD or plus sign is found, might be entering in
+			// national format.
+			f.extractedNationalPrefix = f.removeNationalPrefixFromNationalNumber()
+			return f.attemptToChooseFormattingPattern()
+		}
+	}
+	if f.isExpectingCountryCallingCode {
+		if f.attemptToExtractCountryCallingCode() {
+			f.isExpectingCountryCallingCode = false
+		}
+		return f.prefixBeforeNationalNumber.String() + f.nationalNumber.String()
+	}
+	if len(f.possibleFormats) == 0 {
+		return f.attemptToChooseFormattingPattern()
+	}
+	// The formatting patterns are already chosen.
+	tempNationalNumber := f.inputDigitHelper(nextChar)
+	// See if the accrued digits can be formatted properly already. If
+	// not, use the results from inputDigitHelper, which does formatting
+	// based on the formatting pattern chosen.
+	formattedNumber := f.attemptToFormatAccruedDigits()
+	if len(formattedNumber) > 0 {
+		return formattedNumber
+	}
+	f.narrowDownPossibleFormats(f.nationalNumber.String())
+	if f.maybeCreateNewTemplate() {
+		return f.inputAccruedNationalNumber()
+	}
+	if f.ableToFormat {
+		return f.appendNationalNumber(tempNationalNumber)
+	}
+	return string(f.accruedInput)
+}
+
+func (f *AsYouTypeFormatter) attemptToChoosePatternWithPrefixExtracted() string {
+	f.ableToFormat = true
+	f.isExpectingCountryCallingCode = false
+	f.possibleFormats = f.possibleFormats[:0]
+	f.lastMatchPosition = 0
+	f.formattingTemplate = f.formattingTemplate[:0]
+	f.currentFormattingPattern = ""
+	return f.attemptToChooseFormattingPattern()
+}
+
+// Some national prefixes are a substring of others. If extracting the
+// shorter NDD doesn't result in a number we can format, we try to see
+// if we can extract a longer version here.
+func (f *AsYouTypeFormatter) ableToExtractLongerNdd() bool {
+	if len(f.extractedNationalPrefix) > 0 {
+		// Put the extracted NDD back to the national number before
+		// attempting to extract a new NDD.
+		f.nationalNumber.ResetWithString(
+			f.extractedNationalPrefix + f.nationalNumber.String())
+		// Remove the previously extracted NDD from
+		// prefixBeforeNationalNumber. We cannot simply set it to empty
+		// string because people sometimes incorrectly enter national
+		// prefix after the country code, e.g. +44 (0)20-1234-5678.
+		prefix := f.prefixBeforeNationalNumber.String()
+		indexOfPreviousNdd := strings.LastIndex(prefix, f.extractedNationalPrefix)
+		f.prefixBeforeNationalNumber.ResetWithString(prefix[0:indexOfPreviousNdd])
+	}
+	return f.extractedNationalPrefix != f.removeNationalPrefixFromNationalNumber()
+}
+
+func (f *AsYouTypeFormatter) isDigitOrLeadingPlusSign(nextChar rune) bool {
+	return unicode.IsDigit(nextChar) ||
+		(len(f.accruedInput) == 1 &&
+			PLUS_CHARS_PATTERN.MatchString(string(nextChar)))
+}
+
+// Checks to see if there is an exact pattern match for these digits. If
+// so, we should use this instead of any other formatting template whose
+// leadingDigitsPattern also matches the input.
+func (f *AsYouTypeFormatter) attemptToFormatAccruedDigits() string {
+	nationalNumber := f.nationalNumber.String()
+	for _, numberFormat := range f.possibleFormats {
+		pattern := f.util.regexForMatch(numberFormat.GetPattern())
+		if !pattern.MatchString(nationalNumber) {
+			continue
+		}
+		f.shouldAddSpaceAfterNationalPrefix =
+			NATIONAL_PREFIX_SEPARATORS_PATTERN.MatchString(
+				numberFormat.GetNationalPrefixFormattingRule())
+		formattedNumber := pattern.ReplaceAllString(
+			nationalNumber, numberFormat.GetFormat())
+		// Check that we did not remove nor add any extra digits when we
+		// matched this formatting pattern. This usually happens after we
+		// entered the last digit during AYTF. Eg: In case of MX, we
+		// swallow mobile token (1) when formatted but AYTF should retain
+		// all the number entered and not change in order to match a
+		// format (of same leading digits and length) display in that way.
+		fullOutput := f.appendNationalNumber(formattedNumber)
+		formattedNumberDigitsOnly := normalizeDiallableCharsOnly(fullOutput)
+		if formattedNumberDigitsOnly == f.accruedInputWithoutFormatting.String() {
+			// If it's the same (i.e entered number and format is same),
+			// then it's safe to return this in formatted number as
+			// nothing is lost / added.
+			return fullOutput
+		}
+	}
+	return ""
+}
+
+// Returns the current position in the partially formatted phone number
+// of the character which was previously passed in as the parameter of
+// InputDigitAndRememberPosition. The position is counted in runes.
+func (f *AsYouTypeFormatter) GetRememberedPosition() int {
+	if !f.ableToFormat {
+		return f.originalPosition
+	}
+	accruedInputWithoutFormatting := f.accruedInputWithoutFormatting.Bytes()
+	currentOutput := []rune(f.currentOutput)
+	accruedInputIndex, currentOutputIndex := 0, 0
+	for accruedInputIndex < f.positionToRemember &&
+		currentOutputIndex < len(currentOutput) {
+		if rune(accruedInputWithoutFormatting[accruedInputIndex]) ==
+			currentOutput[currentOutputIndex] {
+			accruedInputIndex++
+		}
+		currentOutputIndex++
+	}
+	return currentOutputIndex
+}
+
+// Combines the national number with any prefix (IDD/+ and country code
+// or national prefix) that was collected. A space will be inserted
+// between them if the current formatting template indicates this to be
+// suitable.
+func (f *AsYouTypeFormatter) appendNationalNumber(nationalNumber string) string {
+	prefix := f.prefixBeforeNationalNumber.String()
+	if f.shouldAddSpaceAfterNationalPrefix && len(prefix) > 0 &&
+		prefix[len(prefix)-1] != SEPARATOR_BEFORE_NATIONAL_NUMBER {
+		// We want to add a space after the national prefix if the
+		// national prefix formatting rule indicates that this would
+		// normally be done, with the exception of the case where we
+		// already appended a space because the NDD was surprisingly long.
+		return prefix + string(SEPARATOR_BEFORE_NATIONAL_NUMBER) + nationalNumber
+	}
+	return prefix + nationalNumber
+}
+
+// Attempts to set the formatting template and returns a string which
+// contains the formatted version of the digits entered so far.
+func (f *AsYouTypeFormatter) attemptToChooseFormattingPattern() string {
+	// We start to attempt to format only when at least
+	// MIN_LEADING_DIGITS_LENGTH digits of national number (excluding
+	// national prefix) have been entered.
+	if f.nationalNumber.Len() < MIN_LEADING_DIGITS_LENGTH {
+		return f.appendNationalNumber(f.nationalNumber.String())
+	}
+	f.getAvailableFormats(f.nationalNumber.String())
+	// See if the accrued digits can be formatted properly already.
+	formattedNumber := f.attemptToFormatAccruedDigits()
+	if len(formattedNumber) > 0 {
+		return formattedNumber
+	}
+	if f.maybeCreateNewTemplate() {
+		return f.inputAccruedNationalNumber()
+	}
+	return string(f.accruedInput)
+}
+
+// Invokes inputDigitHelper on each digit of the national number accrued,
+// and returns a formatted string in the end.
+func (f *AsYouTypeFormatter) inputAccruedNationalNumber() string {
+	nationalNumber := f.nationalNumber.String()
+	if len(nationalNumber) == 0 {
+		return f.prefixBeforeNationalNumber.String()
+	}
+	tempNationalNumber := ""
+	for _, c := range nationalNumber {
+		tempNationalNumber = f.inputDigitHelper(c)
+	}
+	if f.ableToFormat {
+		return f.appendNationalNumber(tempNationalNumber)
+	}
+	return string(f.accruedInput)
+}
+
+// Returns true if the current country is a NANPA country and the
+// national number begins with the national prefix.
+func (f *AsYouTypeFormatter) isNanpaNumberWithNationalPrefix() bool {
+	// For NANPA numbers beginning with 1[2-9], treat the 1 as the
+	// national prefix. The reason is that national significant numbers
+	// in NANPA always start with [2-9] after the national prefix.
+	// Numbers beginning with 1[01] can only be short/emergency numbers,
+	// which don't need the national prefix.
+	nationalNumber := f.nationalNumber.String()
+	return f.currentMetadata.GetCountryCode() == 1 &&
+		len(nationalNumber) > 1 &&
+		nationalNumber[0] == '1' &&
+		nationalNumber[1] != '0' &&
+		nationalNumber[1] != '1'
+}
+
+// Returns the national prefix extracted, or an empty string if it is
+// not present.
+func (f *AsYouTypeFormatter) removeNationalPrefixFromNationalNumber() string {
+	nationalNumber := f.nationalNumber.String()
+	startOfNationalNumber := 0
+	if f.isNanpaNumberWithNationalPrefix() {
+		startOfNationalNumber = 1
+		f.prefixBeforeNationalNumber.WriteString("1")
+		f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+		f.isCompleteNumber = true
+	} else if len(f.currentMetadata.GetNationalPrefixForParsing()) > 0 {
+		nationalPrefixForParsing := f.util.regexForPrefix(
+			f.currentMetadata.GetNationalPrefixForParsing())
+		// Since some national prefix patterns are entirely optional,
+		// check that a national prefix could actually be extracted.
+		inds := nationalPrefixForParsing.FindStringIndex(nationalNumber)
+		if inds != nil && inds[1] > 0 {
+			// When the national prefix is detected, we use international
+			// formatting rules instead of national ones, because national
+			// formatting rules could contain local formatting rules for
+			// numbers entered without area code.
+			f.isCompleteNumber = true
+			startOfNationalNumber = inds[1]
+			f.prefixBeforeNationalNumber.WriteString(
+				nationalNumber[0:startOfNationalNumber])
+		}
+	}
+	nationalPrefix := nationalNumber[0:startOfNationalNumber]
+	f.nationalNumber.ResetWithString(nationalNumber[startOfNationalNumber:])
+	return nationalPrefix
+}
+
+// Extracts IDD and plus sign to prefixBeforeNationalNumber when they are
+// available, and places the remaining input into nationalNumber.
+// Returns true when accruedInputWithoutFormatting begins with the plus
+// sign or valid IDD for defaultCountry.
+func (f *AsYouTypeFormatter) attemptToExtractIdd() bool {
+	internationalPrefix := f.util.regexForPrefix(
+		"\\" + string(PLUS_SIGN) + "|" + f.currentMetadata.GetInternationalPrefix())
+	accruedInputWithoutFormatting := f.accruedInputWithoutFormatting.String()
+	inds := internationalPrefix.FindStringIndex(accruedInputWithoutFormatting)
+	if inds == nil {
+		return false
+	}
+	f.isCompleteNumber = true
+	startOfCountryCallingCode := inds[1]
+	f.nationalNumber.ResetWithString(
+		accruedInputWithoutFormatting[startOfCountryCallingCode:])
+	f.prefixBeforeNationalNumber.ResetWithString(
+		accruedInputWithoutFormatting[0:startOfCountryCallingCode])
+	if accruedInputWithoutFormatting[0] != PLUS_SIGN {
+		f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+	}
+	return true
+}
+
+// Extracts the country calling code from the beginning of nationalNumber
+// to prefixBeforeNationalNumber when they are available, and places the
+// remaining input into nationalNumber. Returns true when a valid country
+// calling code can be found.
+func (f *AsYouTypeFormatter) attemptToExtractCountryCallingCode() bool {
+	if f.nationalNumber.Len() == 0 {
+		return false
+	}
+	numberWithoutCountryCallingCode := builder.NewBuilder(nil)
+	countryCode := f.util.extractCountryCode(
+		builder.NewBuilderString(f.nationalNumber.String()),
+		numberWithoutCountryCallingCode)
+	if countryCode == 0 {
+		return false
+	}
+	f.nationalNumber.ResetWithString(numberWithoutCountryCallingCode.String())
+	newRegionCode := f.util.GetRegionCodeForCountryCode(countryCode)
+	if REGION_CODE_FOR_NON_GEO_ENTITY == newRegionCode {
+		f.currentMetadata = f.util.getMetadataForNonGeographicalRegion(countryCode)
+	} else if newRegionCode != f.defaultCountry {
+		f.currentMetadata = f.getMetadataForRegion(newRegionCode)
+	}
+	f.prefixBeforeNationalNumber.WriteString(strconv.Itoa(countryCode))
+	f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+	// When we have successfully extracted the IDD, the previously
+	// extracted NDD should be cleared because it is no longer valid.
+	f.extractedNationalPrefix = ""
+	return true
+}
+
+// Accrues digits and the plus sign to accruedInputWithoutFormatting for
+// later use. If nextChar contains a digit in non-ASCII format (e.g. the
+// full-width version of digits), it is first normalized to the ASCII
+// version. The return value is nextChar itself, or its normalized
+// version, if nextChar is a digit in non-ASCII format. This method
+// assumes its input is either a digit or the plus sign.
+func (f *AsYouTypeFormatter) normalizeAndAccrueDigitsAndPlusSign(
+	nextChar rune,
+	rememberPosition bool) rune {
+
+	var normalizedChar rune
+	if nextChar == PLUS_SIGN {
+		normalizedChar = nextChar
+		f.accruedInputWithoutFormatting.WriteRune(nextChar)
+	} else {
+		normalizedChar = []rune(NormalizeDigitsOnly(string(nextChar)))[0]
+		f.accruedInputWithoutFormatting.WriteRune(normalizedChar)
+		f.nationalNumber.WriteRune(normalizedChar)
+	}
+	if rememberPosition {
+		f.positionToRemember = f.accruedInputWithoutFormatting.Len()
+	}
+	return normalizedChar
+}
+
+func (f *AsYouTypeFormatter) inputDigitHelper(nextChar rune) string {
+	// Note that formattingTemplate is not guaranteed to have a value, it
+	// could be empty, e.g. when the next digit is entered after
+	// extracting an IDD or NDD.
+	for i := f.lastMatchPosition; i < len(f.formattingTemplate); i++ {
+		if f.formattingTemplate[i] == DIGIT_PLACEHOLDER {
+			f.formattingTemplate[i] = nextChar
+			f.lastMatchPosition = i
+			return string(f.formattingTemplate[0 : i+1])
+		}
+	}
+	if len(f.possibleFormats) == 1 {
+		// More digits are entered than we could handle, and there are
+		// no other valid patterns to try.
+		f.ableToFormat = false
+	} // else, we just reset the formatting pattern.
+	f.currentFormattingPattern = ""
+	return string(f.accruedInput)
+}
+
+// Returns a cached regular expression matching pattern at the start of
+// a string.
+func (u *PhoneNumberUtil) regexForPrefix(pattern string) *regexp.Regexp {
+	patP := "^(?:" + pattern + ")" // Match from string start
+	reg, ok := u.readFromRegexCache(patP)
+	if !ok {
+		reg = regexp.MustCompile(patP)
+		u.writeToRegexCache(patP, reg)
+	}
+	return reg
+}
+
+// Returns a cached regular expression matching pattern against a whole
+// string.
+func (u *PhoneNumberUtil) regexForMatch(pattern string) *regexp.Regexp {
+	patP := "^(?:" + pattern + ")$" // Strictly match
+	reg, ok := u.readFromRegexCache(patP)
+	if !ok {
+		reg = regexp.MustCompile(patP)
+		u.writeToRegexCache(patP, reg)
+	}
+	return reg
+}
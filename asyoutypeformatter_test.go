@@ -0,0 +1,136 @@
+package libphonenumber
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAsYouTypeFormatter(t *testing.T) {
+	var tests = []struct {
+		region   string
+		input    string
+		expected []string
+	}{
+		{
+			region: "US",
+			input:  "6502530000",
+			expected: []string{"6", "65", "650", "650-2", "650-25",
+				"650-253", "650-2530", "(650) 253-00", "(650) 253-000",
+				"(650) 253-0000"},
+		}, {
+			region: "US",
+			input:  "16502530000",
+			expected: []string{"1", "16", "1 65", "1 (650", "1 (650) 2",
+				"1 (650) 25", "1 (650) 253", "1 (650) 253-0",
+				"1 (650) 253-00", "1 (650) 253-000", "1 (650) 253-0000"},
+		}, {
+			region: "US",
+			input:  "+16502530000",
+			expected: []string{"+", "+1", "+1 6", "+1 65", "+1 650",
+				"+1 650-2", "+1 650-25", "+1 650-253", "+1 650-253-0",
+				"+1 650-253-00", "+1 650-253-000", "+1 650-253-0000"},
+		}, {
+			region: "US",
+			input:  "+442071234567",
+			expected: []string{"+", "+4", "+44 ", "+44 2", "+44 20",
+				"+44 20 7", "+44 20 71", "+44 20 712", "+44 20 7123",
+				"+44 20 7123 4", "+44 20 7123 45", "+44 20 7123 456",
+				"+44 20 7123 4567"},
+		}, {
+			region: "GB",
+			input:  "02071234567",
+			expected: []string{"0", "02", "020", "020 7", "020 71",
+				"020 712", "020 7123", "020 7123 4", "020 7123 45",
+				"020 7123 456", "020 7123 4567"},
+		}, {
+			region: "CH",
+			input:  "0441234567",
+			expected: []string{"0", "04", "044", "044 1", "044 12",
+				"044 123", "044 123 4", "044 123 45", "044 123 45 6",
+				"044 123 45 67"},
+		}, {
+			// Once the user enters formatting of their own, the input
+			// is returned as is.
+			region: "US",
+			input:  "650-253",
+			expected: []string{"6", "65", "650", "650-", "650-2",
+				"650-25", "650-253"},
+		}, {
+			// Full width digits are normalized once formatting starts.
+			region:   "US",
+			input:    "６５０２",
+			expected: []string{"６", "６５", "650", "650-2"},
+		}, {
+			// An unknown region still formats numbers entered with a
+			// plus sign.
+			region: "ZZ",
+			input:  "+48881231234",
+			expected: []string{"+", "+4", "+48 ", "+48 8", "+48 88",
+				"+48 881", "+48 881 2", "+48 881 23", "+48 881 231",
+				"+48 881 231 2", "+48 881 231 23", "+48 881 231 234"},
+		},
+	}
+
+	for i, test := range tests {
+		formatter := GetAsYouTypeFormatter(test.region)
+		var got []string
+		for _, c := range test.input {
+			got = append(got, formatter.InputDigit(c))
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("[test %d] InputDigit(%q) for %s = %q, want %q",
+				i, test.input, test.region, got, test.expected)
+		}
+	}
+}
+
+func TestAsYouTypeFormatterClear(t *testing.T) {
+	formatter := GetAsYouTypeFormatter("US")
+	for _, c := range "+44207" {
+		formatter.InputDigit(c)
+	}
+	formatter.Clear()
+	var got string
+	for _, c := range "6502530000" {
+		got = formatter.InputDigit(c)
+	}
+	if got != "(650) 253-0000" {
+		t.Errorf("after Clear, InputDigit = %q, want %q", got, "(650) 253-0000")
+	}
+}
+
+func TestAsYouTypeFormatterRememberPosition(t *testing.T) {
+	formatter := GetAsYouTypeFormatter("US")
+	var tests = []struct {
+		digit    rune
+		remember bool
+		output   string
+		position int
+	}{
+		{digit: '6', output: "6", position: 0},
+		{digit: '5', output: "65", position: 0},
+		{digit: '0', output: "650", position: 0},
+		{digit: '2', remember: true, output: "650-2", position: 5},
+		{digit: '5', output: "650-25", position: 5},
+		{digit: '3', output: "650-253", position: 5},
+		{digit: '0', output: "650-2530", position: 5},
+		{digit: '0', output: "(650) 253-00", position: 7},
+		{digit: '0', output: "(650) 253-000", position: 7},
+		{digit: '0', output: "(650) 253-0000", position: 7},
+	}
+	for i, test := range tests {
+		var output string
+		if test.remember {
+			output = formatter.InputDigitAndRememberPosition(test.digit)
+		} else {
+			output = formatter.InputDigit(test.digit)
+		}
+		if output != test.output {
+			t.Errorf("[test %d] output = %q, want %q", i, output, test.output)
+		}
+		if pos := formatter.GetRememberedPosition(); pos != test.position {
+			t.Errorf("[test %d] GetRememberedPosition() = %d, want %d",
+				i, pos, test.position)
+		}
+	}
+}
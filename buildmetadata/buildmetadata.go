@@ -0,0 +1,918 @@
+// Package buildmetadata builds phone number metadata from the XML files
+// maintained by upstream libphonenumber: PhoneNumberMetadata.xml,
+// ShortNumberMetadata.xml and PhoneNumberAlternateFormats.xml.
+//
+// It is a port of upstream's BuildMetadataFromXml and applies the same
+// normalizations, so the PhoneMetadataCollection it returns is the one
+// upstream would serialize: whitespace is stripped from patterns, $NP
+// and $FG are expanded in formatting rules, intlFormats that only repeat
+// the national formats are dropped, and possible lengths are inherited
+// from the general description.
+package buildmetadata
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+)
+
+type xmlPhoneNumberMetadata struct {
+	Territories []xmlTerritory `xml:"territories>territory"`
+}
+
+type xmlTerritory struct {
+	ID                                   *string           `xml:"id,attr"`
+	CountryCode                          *string           `xml:"countryCode,attr"`
+	LeadingDigits                        *string           `xml:"leadingDigits,attr"`
+	InternationalPrefix                  *string           `xml:"internationalPrefix,attr"`
+	PreferredInternationalPrefix         *string           `xml:"preferredInternationalPrefix,attr"`
+	NationalPrefix                       *string           `xml:"nationalPrefix,attr"`
+	NationalPrefixForParsing             *string           `xml:"nationalPrefixForParsing,attr"`
+	NationalPrefixTransformRule          *string           `xml:"nationalPrefixTransformRule,attr"`
+	PreferredExtnPrefix                  *string           `xml:"preferredExtnPrefix,attr"`
+	MainCountryForCode                   *string           `xml:"mainCountryForCode,attr"`
+	MobileNumberPortableRegion           *string           `xml:"mobileNumberPortableRegion,attr"`
+	NationalPrefixFormattingRule         *string           `xml:"nationalPrefixFormattingRule,attr"`
+	NationalPrefixOptionalWhenFormatting *string           `xml:"nationalPrefixOptionalWhenFormatting,attr"`
+	CarrierCodeFormattingRule            *string           `xml:"carrierCodeFormattingRule,attr"`
+	AvailableFormats                     []xmlNumberFormat `xml:"availableFormats>numberFormat"`
+
+	GeneralDesc             []xmlPhoneNumberDesc `xml:"generalDesc"`
+	NoInternationalDialling []xmlPhoneNumberDesc `xml:"noInternationalDialling"`
+	AreaCodeOptional        []xmlPhoneNumberDesc `xml:"areaCodeOptional"`
+	FixedLine               []xmlPhoneNumberDesc `xml:"fixedLine"`
+	Mobile                  []xmlPhoneNumberDesc `xml:"mobile"`
+	Pager                   []xmlPhoneNumberDesc `xml:"pager"`
+	TollFree                []xmlPhoneNumberDesc `xml:"tollFree"`
+	PremiumRate             []xmlPhoneNumberDesc `xml:"premiumRate"`
+	SharedCost              []xmlPhoneNumberDesc `xml:"sharedCost"`
+	PersonalNumber          []xmlPhoneNumberDesc `xml:"personalNumber"`
+	Voip                    []xmlPhoneNumberDesc `xml:"voip"`
+	Uan                     []xmlPhoneNumberDesc `xml:"uan"`
+	Voicemail               []xmlPhoneNumberDesc `xml:"voicemail"`
+	ShortCode               []xmlPhoneNumberDesc `xml:"shortCode"`
+	StandardRate            []xmlPhoneNumberDesc `xml:"standardRate"`
+	CarrierSpecific         []xmlPhoneNumberDesc `xml:"carrierSpecific"`
+	Emergency               []xmlPhoneNumberDesc `xml:"emergency"`
+	SmsServices             []xmlPhoneNumberDesc `xml:"smsServices"`
+}
+
+type xmlNumberFormat struct {
+	Pattern                              string   `xml:"pattern,attr"`
+	NationalPrefixFormattingRule         *string  `xml:"nationalPrefixFormattingRule,attr"`
+	NationalPrefixOptionalWhenFormatting *string  `xml:"nationalPrefixOptionalWhenFormatting,attr"`
+	CarrierCodeFormattingRule            *string  `xml:"carrierCodeFormattingRule,attr"`
+	LeadingDigits                        []string `xml:"leadingDigits"`
+	Format                               []string `xml:"format"`
+	IntlFormat                           []string `xml:"intlFormat"`
+}
+
+type xmlPhoneNumberDesc struct {
+	PossibleLengths       []xmlPossibleLengths `xml:"possibleLengths"`
+	ExampleNumber         *string              `xml:"exampleNumber"`
+	NationalNumberPattern *string              `xml:"nationalNumberPattern"`
+}
+
+type xmlPossibleLengths struct {
+	National  string  `xml:"national,attr"`
+	LocalOnly *string `xml:"localOnly,attr"`
+}
+
+var whitespacePattern = regexp.MustCompile(`\s`)
+
+// Builds the metadata in the upstream XML file at path. Whether the file
+// holds short number or alternate format metadata is inferred from its
+// name, as upstream does.
+func BuildPhoneMetadataCollectionFromFile(
+	path string) (*libphonenumber.PhoneMetadataCollection, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	name := filepath.Base(path)
+	return BuildPhoneMetadataCollection(
+		f,
+		strings.Contains(name, "ShortNumberMetadata"),
+		strings.Contains(name, "PhoneNumberAlternateFormats"))
+}
+
+// Builds the metadata in the upstream XML read from r.
+// isShortNumberMetadata must be set for ShortNumberMetadata.xml, which
+// describes short number types instead of the regular ones, and
+// isAlternateFormatsMetadata for PhoneNumberAlternateFormats.xml, which
+// only holds formats.
+func BuildPhoneMetadataCollection(
+	r io.Reader,
+	isShortNumberMetadata, isAlternateFormatsMetadata bool) (
+	*libphonenumber.PhoneMetadataCollection, error) {
+
+	var document xmlPhoneNumberMetadata
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+	metadataCollection := &libphonenumber.PhoneMetadataCollection{}
+	for i := range document.Territories {
+		territory := &document.Territories[i]
+		regionCode := ""
+		// For the main metadata file this should always be set, but for
+		// other supplementary data files the country calling code may be
+		// all that is needed.
+		if territory.ID != nil {
+			regionCode = *territory.ID
+		}
+		metadata, err := loadCountryMetadata(
+			regionCode, territory, isShortNumberMetadata, isAlternateFormatsMetadata)
+		if err != nil {
+			if len(regionCode) == 0 && territory.CountryCode != nil {
+				regionCode = *territory.CountryCode
+			}
+			return nil, fmt.Errorf("territory %s: %v", regionCode, err)
+		}
+		metadataCollection.Metadata = append(metadataCollection.Metadata, metadata)
+	}
+	return metadataCollection, nil
+}
+
+// Returns the mapping from country calling codes to the region codes
+// using them in metadataCollection, with the main country for each code
+// listed first.
+func BuildCountryCodeToRegionCodeMap(
+	metadataCollection *libphonenumber.PhoneMetadataCollection) map[int][]string {
+
+	countryCodeToRegionCodeMap := make(map[int][]string)
+	for _, metadata := range metadataCollection.GetMetadata() {
+		regionCode := metadata.GetId()
+		countryCode := int(metadata.GetCountryCode())
+		if regionCodes, ok := countryCodeToRegionCodeMap[countryCode]; ok {
+			if metadata.GetMainCountryForCode() {
+				countryCodeToRegionCodeMap[countryCode] = append(
+					[]string{regionCode}, regionCodes...)
+			} else {
+				countryCodeToRegionCodeMap[countryCode] = append(
+					regionCodes, regionCode)
+			}
+		} else {
+			// For most countries, there will be only one region code for
+			// the country calling code.
+			var regionCodes []string
+			// For alternate formats, there are no region codes at all.
+			if len(regionCode) > 0 {
+				regionCodes = append(regionCodes, regionCode)
+			}
+			countryCodeToRegionCodeMap[countryCode] = regionCodes
+		}
+	}
+	return countryCodeToRegionCodeMap
+}
+
+// Removes the example numbers from the metadata in collection, as
+// upstream's lite build does. Lite metadata parses, formats and
+// validates numbers as the full metadata does, but has no numbers for
+// GetExampleNumber and the like to return, which then return nil.
+func StripExampleNumbers(collection *libphonenumber.PhoneMetadataCollection) {
+	for _, metadata := range collection.GetMetadata() {
+		for _, desc := range phoneNumberDescs(metadata) {
+			if desc != nil {
+				desc.ExampleNumber = nil
+			}
+		}
+	}
+}
+
+// Rewrites the number descriptions in collection that are marked as
+// missing with the national number pattern "NA", as upstream releases
+// before 8.0 and some ports of the library do, the way
+// BuildPhoneMetadataCollection marks them: with no national number
+// data and [-1] for the possible lengths.
+func NormalizeMissingDescs(collection *libphonenumber.PhoneMetadataCollection) {
+	for _, metadata := range collection.GetMetadata() {
+		for _, desc := range phoneNumberDescs(metadata) {
+			if desc != nil && desc.GetNationalNumberPattern() == "NA" {
+				*desc = libphonenumber.PhoneNumberDesc{PossibleLength: []int32{-1}}
+			}
+		}
+	}
+}
+
+// Returns the number descriptions of metadata, some of which may be nil.
+func phoneNumberDescs(metadata *libphonenumber.PhoneMetadata) []*libphonenumber.PhoneNumberDesc {
+	return []*libphonenumber.PhoneNumberDesc{
+		metadata.GeneralDesc,
+		metadata.FixedLine,
+		metadata.Mobile,
+		metadata.TollFree,
+		metadata.PremiumRate,
+		metadata.SharedCost,
+		metadata.PersonalNumber,
+		metadata.Voip,
+		metadata.Pager,
+		metadata.Uan,
+		metadata.Emergency,
+		metadata.Voicemail,
+		metadata.ShortCode,
+		metadata.StandardRate,
+		metadata.CarrierSpecific,
+		metadata.SmsServices,
+		metadata.NoInternationalDialling,
+	}
+}
+
+// Checks the regular expression compiles, and returns it with all
+// whitespace removed if removeWhitespace is set. Whitespace and
+// newlines are used to lay out long patterns in the XML.
+func validateRE(regex string, removeWhitespace bool) (string, error) {
+	compressedRegex := regex
+	if removeWhitespace {
+		compressedRegex = whitespacePattern.ReplaceAllString(regex, "")
+	}
+	if _, err := regexp.Compile(compressedRegex); err != nil {
+		return "", err
+	}
+	// We don't ever expect to see | followed by a ) in our metadata -
+	// this would be an indication of a bug. If one wants to make
+	// something optional, we prefer ? to using an empty group.
+	if strings.Contains(compressedRegex, "|)") {
+		return "", fmt.Errorf("| followed by ) in %q", compressedRegex)
+	}
+	return compressedRegex, nil
+}
+
+func getNationalPrefix(territory *xmlTerritory) string {
+	if territory.NationalPrefix == nil {
+		return ""
+	}
+	return *territory.NationalPrefix
+}
+
+func loadTerritoryTagMetadata(
+	regionCode string,
+	territory *xmlTerritory,
+	nationalPrefix string) (*libphonenumber.PhoneMetadata, error) {
+
+	metadata := &libphonenumber.PhoneMetadata{Id: proto.String(regionCode)}
+	if territory.CountryCode != nil {
+		countryCode, err := strconv.Atoi(*territory.CountryCode)
+		if err != nil {
+			return nil, err
+		}
+		metadata.CountryCode = proto.Int32(int32(countryCode))
+	}
+	if territory.LeadingDigits != nil {
+		leadingDigits, err := validateRE(*territory.LeadingDigits, false)
+		if err != nil {
+			return nil, err
+		}
+		metadata.LeadingDigits = proto.String(leadingDigits)
+	}
+	if territory.InternationalPrefix != nil {
+		internationalPrefix, err := validateRE(*territory.InternationalPrefix, false)
+		if err != nil {
+			return nil, err
+		}
+		metadata.InternationalPrefix = proto.String(internationalPrefix)
+	}
+	if territory.PreferredInternationalPrefix != nil {
+		metadata.PreferredInternationalPrefix = proto.String(
+			*territory.PreferredInternationalPrefix)
+	}
+	if territory.NationalPrefixForParsing != nil {
+		nationalPrefixForParsing, err := validateRE(
+			*territory.NationalPrefixForParsing, true)
+		if err != nil {
+			return nil, err
+		}
+		metadata.NationalPrefixForParsing = proto.String(nationalPrefixForParsing)
+		if territory.NationalPrefixTransformRule != nil {
+			transformRule, err := validateRE(*territory.NationalPrefixTransformRule, false)
+			if err != nil {
+				return nil, err
+			}
+			metadata.NationalPrefixTransformRule = proto.String(transformRule)
+		}
+	}
+	if len(nationalPrefix) > 0 {
+		metadata.NationalPrefix = proto.String(nationalPrefix)
+		if metadata.NationalPrefixForParsing == nil {
+			metadata.NationalPrefixForParsing = proto.String(nationalPrefix)
+		}
+	}
+	if territory.PreferredExtnPrefix != nil {
+		metadata.PreferredExtnPrefix = proto.String(*territory.PreferredExtnPrefix)
+	}
+	if territory.MainCountryForCode != nil {
+		metadata.MainCountryForCode = proto.Bool(true)
+	}
+	if territory.MobileNumberPortableRegion != nil {
+		metadata.MobileNumberPortableRegion = proto.Bool(true)
+	}
+	return metadata, nil
+}
+
+// Extracts the pattern for international format. If there is no
+// intlFormat, default to using the national format. If the intlFormat
+// is set to "NA" the intlFormat should be ignored.
+func loadInternationalFormat(
+	metadata *libphonenumber.PhoneMetadata,
+	numberFormatElement *xmlNumberFormat,
+	nationalFormat *libphonenumber.NumberFormat) (bool, error) {
+
+	intlFormat := &libphonenumber.NumberFormat{}
+	hasExplicitIntlFormatDefined := false
+
+	if len(numberFormatElement.IntlFormat) > 1 {
+		return false, fmt.Errorf(
+			"invalid number of intlFormat patterns: %d",
+			len(numberFormatElement.IntlFormat))
+	} else if len(numberFormatElement.IntlFormat) == 0 {
+		// Default to use the same as the national pattern if none is
+		// defined.
+		proto.Merge(intlFormat, nationalFormat)
+	} else {
+		intlFormat.Pattern = proto.String(numberFormatElement.Pattern)
+		if err := setLeadingDigitsPatterns(numberFormatElement, intlFormat); err != nil {
+			return false, err
+		}
+		intlFormatPatternValue := numberFormatElement.IntlFormat[0]
+		if intlFormatPatternValue != "NA" {
+			intlFormat.Format = proto.String(intlFormatPatternValue)
+		}
+		hasExplicitIntlFormatDefined = true
+	}
+
+	if intlFormat.Format != nil {
+		metadata.IntlNumberFormat = append(metadata.IntlNumberFormat, intlFormat)
+	}
+	return hasExplicitIntlFormatDefined, nil
+}
+
+// Extracts the pattern for the national format.
+func loadNationalFormat(
+	numberFormatElement *xmlNumberFormat,
+	format *libphonenumber.NumberFormat) error {
+
+	if err := setLeadingDigitsPatterns(numberFormatElement, format); err != nil {
+		return err
+	}
+	pattern, err := validateRE(numberFormatElement.Pattern, false)
+	if err != nil {
+		return err
+	}
+	format.Pattern = proto.String(pattern)
+
+	if len(numberFormatElement.Format) != 1 {
+		return fmt.Errorf(
+			"invalid number of format patterns: %d",
+			len(numberFormatElement.Format))
+	}
+	format.Format = proto.String(numberFormatElement.Format[0])
+	return nil
+}
+
+// Extracts the available formats from the territory. If a format does
+// not contain any nationalPrefixFormattingRule, the one passed-in is
+// retained; similarly for nationalPrefixOptionalWhenFormatting and
+// carrierCodeFormattingRule. The passed-in values are taken from the
+// territory itself.
+func loadAvailableFormats(
+	metadata *libphonenumber.PhoneMetadata,
+	territory *xmlTerritory,
+	nationalPrefix string,
+	nationalPrefixFormattingRule string,
+	nationalPrefixOptionalWhenFormatting bool) error {
+
+	carrierCodeFormattingRule := ""
+	if territory.CarrierCodeFormattingRule != nil {
+		var err error
+		carrierCodeFormattingRule, err = validateRE(
+			getDomesticCarrierCodeFormattingRule(
+				*territory.CarrierCodeFormattingRule, nationalPrefix), false)
+		if err != nil {
+			return err
+		}
+	}
+	if len(territory.AvailableFormats) == 0 {
+		return nil
+	}
+
+	hasExplicitIntlFormatDefined := false
+	for i := range territory.AvailableFormats {
+		numberFormatElement := &territory.AvailableFormats[i]
+		format := &libphonenumber.NumberFormat{}
+
+		if numberFormatElement.NationalPrefixFormattingRule != nil {
+			format.NationalPrefixFormattingRule = proto.String(
+				getNationalPrefixFormattingRule(
+					*numberFormatElement.NationalPrefixFormattingRule, nationalPrefix))
+		} else if len(nationalPrefixFormattingRule) > 0 {
+			format.NationalPrefixFormattingRule = proto.String(nationalPrefixFormattingRule)
+		}
+		if numberFormatElement.NationalPrefixOptionalWhenFormatting != nil {
+			optional, err := strconv.ParseBool(
+				*numberFormatElement.NationalPrefixOptionalWhenFormatting)
+			if err != nil {
+				return err
+			}
+			format.NationalPrefixOptionalWhenFormatting = proto.Bool(optional)
+		} else if nationalPrefixOptionalWhenFormatting {
+			// Inherit from the parent field if it is not already the
+			// same as the default.
+			format.NationalPrefixOptionalWhenFormatting = proto.Bool(true)
+		}
+		if numberFormatElement.CarrierCodeFormattingRule != nil {
+			rule, err := validateRE(
+				getDomesticCarrierCodeFormattingRule(
+					*numberFormatElement.CarrierCodeFormattingRule, nationalPrefix), false)
+			if err != nil {
+				return err
+			}
+			format.DomesticCarrierCodeFormattingRule = proto.String(rule)
+		} else if len(carrierCodeFormattingRule) > 0 {
+			format.DomesticCarrierCodeFormattingRule = proto.String(carrierCodeFormattingRule)
+		}
+		if err := loadNationalFormat(numberFormatElement, format); err != nil {
+			return err
+		}
+		metadata.NumberFormat = append(metadata.NumberFormat, format)
+
+		explicit, err := loadInternationalFormat(metadata, numberFormatElement, format)
+		if err != nil {
+			return err
+		}
+		if explicit {
+			hasExplicitIntlFormatDefined = true
+		}
+	}
+	// Only a small number of regions need to specify the intlFormats in
+	// the xml. For the majority of countries the intlNumberFormat
+	// metadata is an exact copy of the national NumberFormat metadata. To
+	// minimize the size of the metadata file, we only keep
+	// intlNumberFormats that actually differ in some way to the national
+	// formats.
+	if !hasExplicitIntlFormatDefined {
+		metadata.IntlNumberFormat = nil
+	}
+	return nil
+}
+
+func setLeadingDigitsPatterns(
+	numberFormatElement *xmlNumberFormat,
+	format *libphonenumber.NumberFormat) error {
+
+	for _, leadingDigits := range numberFormatElement.LeadingDigits {
+		pattern, err := validateRE(leadingDigits, true)
+		if err != nil {
+			return err
+		}
+		format.LeadingDigitsPattern = append(format.LeadingDigitsPattern, pattern)
+	}
+	return nil
+}
+
+// Replaces $NP with the national prefix and $FG with the first group
+// ($1).
+func getNationalPrefixFormattingRule(rule, nationalPrefix string) string {
+	rule = strings.Replace(rule, "$NP", nationalPrefix, 1)
+	return strings.Replace(rule, "$FG", "$1", 1)
+}
+
+// Replaces $FG with the first group ($1) and $NP with the national
+// prefix.
+func getDomesticCarrierCodeFormattingRule(rule, nationalPrefix string) string {
+	rule = strings.Replace(rule, "$FG", "$1", 1)
+	return strings.Replace(rule, "$NP", nationalPrefix, 1)
+}
+
+// Processes a phone number description element from the XML file and
+// returns it as a PhoneNumberDesc. Possible lengths equal to those of
+// parentDesc are left out, as they are inherited at runtime; lengths
+// not covered by parentDesc are an error. If no element exists, we
+// assume there are no numbers of the type for the region, and return a
+// description with no national number data and [-1] for the possible
+// lengths. parentDesc is nil for the general description itself.
+func processPhoneNumberDescElement(
+	parentDesc *libphonenumber.PhoneNumberDesc,
+	elements []xmlPhoneNumberDesc,
+	numberType string) (*libphonenumber.PhoneNumberDesc, error) {
+
+	numberDesc := &libphonenumber.PhoneNumberDesc{}
+	if len(elements) == 0 {
+		// -1 will never match a possible phone number length, so is safe
+		// to use to ensure this never matches. We don't leave it empty,
+		// since for compression reasons, we use the empty list to mean
+		// that the generalDesc possible lengths apply.
+		numberDesc.PossibleLength = []int32{-1}
+		return numberDesc, nil
+	}
+	if len(elements) > 1 {
+		return nil, fmt.Errorf("multiple elements with type %s found", numberType)
+	}
+	element := &elements[0]
+	if parentDesc != nil {
+		// We don't do this for the general description, since these tags
+		// won't be present; instead we will calculate its values based on
+		// the values for all the other number type descriptions (see
+		// setPossibleLengthsGeneralDesc).
+		lengths := make(map[int32]bool)
+		localOnlyLengths := make(map[int32]bool)
+		if err := populatePossibleLengthSets(
+			[]xmlPhoneNumberDesc{*element}, lengths, localOnlyLengths); err != nil {
+			return nil, err
+		}
+		if err := setPossibleLengths(
+			lengths, localOnlyLengths, parentDesc, numberDesc); err != nil {
+			return nil, fmt.Errorf("%s: %v", numberType, err)
+		}
+	}
+	if element.NationalNumberPattern != nil {
+		pattern, err := validateRE(*element.NationalNumberPattern, true)
+		if err != nil {
+			return nil, err
+		}
+		numberDesc.NationalNumberPattern = proto.String(pattern)
+	}
+	if element.ExampleNumber != nil {
+		numberDesc.ExampleNumber = proto.String(*element.ExampleNumber)
+	}
+	return numberDesc, nil
+}
+
+// The elements of a territory describing one type of number, and the
+// field of PhoneMetadata they are processed into.
+type descElements struct {
+	field    **libphonenumber.PhoneNumberDesc
+	elements []xmlPhoneNumberDesc
+	name     string
+}
+
+func setRelevantDescPatterns(
+	metadata *libphonenumber.PhoneMetadata,
+	territory *xmlTerritory,
+	isShortNumberMetadata bool) error {
+
+	generalDesc, err := processPhoneNumberDescElement(
+		nil, territory.GeneralDesc, "generalDesc")
+	if err != nil {
+		return err
+	}
+	// Calculate the possible lengths for the general description. This
+	// will be based on the possible lengths of the child elements.
+	if err := setPossibleLengthsGeneralDesc(
+		generalDesc, territory, isShortNumberMetadata); err != nil {
+		return err
+	}
+	metadata.GeneralDesc = generalDesc
+
+	var descs []descElements
+	if !isShortNumberMetadata {
+		// Set fields used by regular length phone numbers.
+		descs = []descElements{
+			{&metadata.FixedLine, territory.FixedLine, "fixedLine"},
+			{&metadata.Mobile, territory.Mobile, "mobile"},
+			{&metadata.SharedCost, territory.SharedCost, "sharedCost"},
+			{&metadata.Voip, territory.Voip, "voip"},
+			{&metadata.PersonalNumber, territory.PersonalNumber, "personalNumber"},
+			{&metadata.Pager, territory.Pager, "pager"},
+			{&metadata.Uan, territory.Uan, "uan"},
+			{&metadata.Voicemail, territory.Voicemail, "voicemail"},
+			{&metadata.NoInternationalDialling, territory.NoInternationalDialling,
+				"noInternationalDialling"},
+			{&metadata.TollFree, territory.TollFree, "tollFree"},
+			{&metadata.PremiumRate, territory.PremiumRate, "premiumRate"},
+		}
+	} else {
+		// Set fields used by short numbers.
+		descs = []descElements{
+			{&metadata.StandardRate, territory.StandardRate, "standardRate"},
+			{&metadata.ShortCode, territory.ShortCode, "shortCode"},
+			{&metadata.CarrierSpecific, territory.CarrierSpecific, "carrierSpecific"},
+			{&metadata.Emergency, territory.Emergency, "emergency"},
+			{&metadata.TollFree, territory.TollFree, "tollFree"},
+			{&metadata.PremiumRate, territory.PremiumRate, "premiumRate"},
+			{&metadata.SmsServices, territory.SmsServices, "smsServices"},
+		}
+	}
+	for _, desc := range descs {
+		numberDesc, err := processPhoneNumberDescElement(
+			generalDesc, desc.elements, desc.name)
+		if err != nil {
+			return err
+		}
+		*desc.field = numberDesc
+	}
+
+	if !isShortNumberMetadata {
+		mobileAndFixedAreSame := metadata.GetMobile().GetNationalNumberPattern() ==
+			metadata.GetFixedLine().GetNationalNumberPattern()
+		if mobileAndFixedAreSame {
+			// Set this if it is not the same as the default.
+			metadata.SameMobileAndFixedLinePattern = proto.Bool(true)
+		}
+	}
+	return nil
+}
+
+// Parses a possible length string such as "4,6,[8-10]" into the set of
+// lengths it covers.
+func parsePossibleLengthStringToSet(possibleLengthString string) (map[int32]bool, error) {
+	if len(possibleLengthString) == 0 {
+		return nil, fmt.Errorf("empty possibleLength string found")
+	}
+	lengthSet := make(map[int32]bool)
+	add := func(length int) error {
+		if lengthSet[int32(length)] {
+			return fmt.Errorf(
+				"duplicate length element found (%d) in possibleLength string %s",
+				length, possibleLengthString)
+		}
+		lengthSet[int32(length)] = true
+		return nil
+	}
+	for _, lengthSubstring := range strings.Split(possibleLengthString, ",") {
+		if len(lengthSubstring) == 0 {
+			return nil, fmt.Errorf(
+				"leading, trailing or adjacent commas in possible length string %s, "+
+					"these should only separate numbers or ranges", possibleLengthString)
+		} else if lengthSubstring[0] == '[' {
+			if lengthSubstring[len(lengthSubstring)-1] != ']' {
+				return nil, fmt.Errorf(
+					"missing end of range character in possible length string %s",
+					possibleLengthString)
+			}
+			// Strip the leading and trailing [], and split on the -.
+			minMax := strings.Split(lengthSubstring[1:len(lengthSubstring)-1], "-")
+			if len(minMax) != 2 {
+				return nil, fmt.Errorf(
+					"ranges must have exactly one - character: missing for %s",
+					possibleLengthString)
+			}
+			min, err := strconv.Atoi(minMax[0])
+			if err != nil {
+				return nil, err
+			}
+			max, err := strconv.Atoi(minMax[1])
+			if err != nil {
+				return nil, err
+			}
+			// We don't even accept [6-7] since we prefer the shorter 6,7
+			// variant; for a range to be in use the hyphen needs to
+			// replace at least one digit.
+			if max-min < 2 {
+				return nil, fmt.Errorf(
+					"the first number in a range should be two or more digits "+
+						"lower than the second. Culprit possibleLength string: %s",
+					possibleLengthString)
+			}
+			for j := min; j <= max; j++ {
+				if err := add(j); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			length, err := strconv.Atoi(lengthSubstring)
+			if err != nil {
+				return nil, err
+			}
+			if err := add(length); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return lengthSet, nil
+}
+
+// Reads the possible lengths present in descs and adds them to two
+// sets: one for full-length numbers, one for local numbers.
+func populatePossibleLengthSets(
+	descs []xmlPhoneNumberDesc,
+	lengths, localOnlyLengths map[int32]bool) error {
+
+	for _, desc := range descs {
+		for _, possibleLengths := range desc.PossibleLengths {
+			// We don't add to the phone metadata yet, since we want to
+			// sort length elements found under different nodes first,
+			// make sure there are no duplicates between them and that
+			// the localOnly lengths don't overlap with the others.
+			thisElementLengths, err := parsePossibleLengthStringToSet(
+				possibleLengths.National)
+			if err != nil {
+				return err
+			}
+			if possibleLengths.LocalOnly != nil {
+				thisElementLocalOnlyLengths, err := parsePossibleLengthStringToSet(
+					*possibleLengths.LocalOnly)
+				if err != nil {
+					return err
+				}
+				for length := range thisElementLocalOnlyLengths {
+					if thisElementLengths[length] {
+						return fmt.Errorf(
+							"possible length(s) found specified as a normal and "+
+								"local-only length: %d", length)
+					}
+					// We check again when we set these lengths on the
+					// metadata itself in setPossibleLengths that the
+					// elements in localOnly are not also in lengths.
+					localOnlyLengths[length] = true
+				}
+			}
+			// It is okay if at this time we have duplicates, because the
+			// same length might be possible for e.g. fixed-line and for
+			// mobile numbers.
+			for length := range thisElementLengths {
+				lengths[length] = true
+			}
+		}
+	}
+	return nil
+}
+
+// Sets possible lengths in the general description, derived from
+// certain child elements.
+func setPossibleLengthsGeneralDesc(
+	generalDesc *libphonenumber.PhoneNumberDesc,
+	territory *xmlTerritory,
+	isShortNumberMetadata bool) error {
+
+	lengths := make(map[int32]bool)
+	localOnlyLengths := make(map[int32]bool)
+	// The general description node should *always* be present if
+	// metadata for other types is present, aside from in some unit
+	// tests. (However, for e.g. formatting metadata in
+	// PhoneNumberAlternateFormats, no PhoneNumberDesc elements are
+	// present).
+	if err := populatePossibleLengthSets(
+		territory.GeneralDesc, lengths, localOnlyLengths); err != nil {
+		return err
+	}
+	if len(lengths) > 0 || len(localOnlyLengths) > 0 {
+		// We shouldn't have anything specified at the "general desc"
+		// level: we are going to calculate this ourselves from child
+		// elements.
+		return fmt.Errorf("found possible lengths specified at general desc: " +
+			"this should be derived from child elements")
+	}
+	if !isShortNumberMetadata {
+		// noInternationalDialling and areaCodeOptional do not describe
+		// types of numbers, so their lengths are left out.
+		for _, descs := range [][]xmlPhoneNumberDesc{
+			territory.FixedLine,
+			territory.Mobile,
+			territory.Pager,
+			territory.TollFree,
+			territory.PremiumRate,
+			territory.SharedCost,
+			territory.PersonalNumber,
+			territory.Voip,
+			territory.Uan,
+			territory.Voicemail,
+		} {
+			if err := populatePossibleLengthSets(
+				descs, lengths, localOnlyLengths); err != nil {
+				return err
+			}
+		}
+	} else {
+		// For short number metadata, we want to copy the lengths from
+		// the "short code" section only. This is because it's the more
+		// detailed validation pattern, it's not a sub-type of short
+		// codes. The other lengths will be checked later to see that
+		// they are a sub-set of these possible lengths.
+		if err := populatePossibleLengthSets(
+			territory.ShortCode, lengths, localOnlyLengths); err != nil {
+			return err
+		}
+		if len(localOnlyLengths) > 0 {
+			return fmt.Errorf("found local-only lengths in short-number metadata")
+		}
+	}
+	return setPossibleLengths(lengths, localOnlyLengths, nil, generalDesc)
+}
+
+// Returns the members of set in increasing order.
+func sortedLengths(set map[int32]bool) []int32 {
+	lengths := make([]int32, 0, len(set))
+	for length := range set {
+		lengths = append(lengths, length)
+	}
+	sort.Slice(lengths, func(i, j int) bool { return lengths[i] < lengths[j] })
+	return lengths
+}
+
+func containsLength(lengths []int32, length int32) bool {
+	for _, l := range lengths {
+		if l == length {
+			return true
+		}
+	}
+	return false
+}
+
+// Sets the possible length fields of desc from the sets passed in.
+// Checks that the lengths are covered by parentDesc if one is present,
+// and if the lengths are exactly the same as the parent's, they are
+// not filled in for efficiency reasons.
+func setPossibleLengths(
+	lengths, localOnlyLengths map[int32]bool,
+	parentDesc, desc *libphonenumber.PhoneNumberDesc) error {
+
+	desc.PossibleLength = nil
+	desc.PossibleLengthLocalOnly = nil
+	sorted := sortedLengths(lengths)
+	// Only add the lengths to this sub-type if they aren't exactly the
+	// same as the possible lengths in the general desc (for metadata
+	// size reasons).
+	if parentDesc == nil || !equalLengths(sorted, parentDesc.GetPossibleLength()) {
+		for _, length := range sorted {
+			if parentDesc != nil && !containsLength(parentDesc.GetPossibleLength(), length) {
+				// We shouldn't have possible lengths defined in a child
+				// element that are not covered by the general
+				// description.
+				return fmt.Errorf(
+					"out-of-range possible length found (%d), parent lengths %v",
+					length, parentDesc.GetPossibleLength())
+			}
+			desc.PossibleLength = append(desc.PossibleLength, length)
+		}
+	}
+	// We check that the local-only length isn't also a normal possible
+	// length (only relevant for the general-desc, since within elements
+	// such as fixed-line we would have returned an error already) before
+	// adding it to the collection of possible local-only lengths.
+	for _, length := range sortedLengths(localOnlyLengths) {
+		if lengths[length] {
+			continue
+		}
+		// We check it is covered by either of the possible length sets of
+		// the parent PhoneNumberDesc, because for example 7 might be a
+		// valid localOnly length for mobile, but a valid national length
+		// for fixedLine, so the generalDesc would have the 7 removed from
+		// localOnly.
+		if parentDesc != nil &&
+			!containsLength(parentDesc.GetPossibleLengthLocalOnly(), length) &&
+			!containsLength(parentDesc.GetPossibleLength(), length) {
+			return fmt.Errorf(
+				"out-of-range local-only possible length found (%d), parent length %v",
+				length, parentDesc.GetPossibleLengthLocalOnly())
+		}
+		desc.PossibleLengthLocalOnly = append(desc.PossibleLengthLocalOnly, length)
+	}
+	return nil
+}
+
+func equalLengths(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func loadCountryMetadata(
+	regionCode string,
+	territory *xmlTerritory,
+	isShortNumberMetadata, isAlternateFormatsMetadata bool) (
+	*libphonenumber.PhoneMetadata, error) {
+
+	nationalPrefix := getNationalPrefix(territory)
+	metadata, err := loadTerritoryTagMetadata(regionCode, territory, nationalPrefix)
+	if err != nil {
+		return nil, err
+	}
+	nationalPrefixFormattingRule := ""
+	if territory.NationalPrefixFormattingRule != nil {
+		nationalPrefixFormattingRule = getNationalPrefixFormattingRule(
+			*territory.NationalPrefixFormattingRule, nationalPrefix)
+	}
+	if err := loadAvailableFormats(
+		metadata,
+		territory,
+		nationalPrefix,
+		nationalPrefixFormattingRule,
+		territory.NationalPrefixOptionalWhenFormatting != nil); err != nil {
+		return nil, err
+	}
+	if !isAlternateFormatsMetadata {
+		// The alternate formats metadata does not need most of the
+		// patterns to be set.
+		if err := setRelevantDescPatterns(
+			metadata, territory, isShortNumberMetadata); err != nil {
+			return nil, err
+		}
+	}
+	return metadata, nil
+}
@@ -0,0 +1,468 @@
+package buildmetadata
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+)
+
+const testPhoneNumberMetadata = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- A cut-down PhoneNumberMetadata.xml in the upstream format. -->
+<phoneNumberMetadata>
+  <territories>
+    <!-- Switzerland (CH) -->
+    <territory id="CH" countryCode="41" internationalPrefix="00"
+               nationalPrefix="0" nationalPrefixFormattingRule="$NP$FG"
+               mobileNumberPortableRegion="true">
+      <availableFormats>
+        <numberFormat pattern="(\d{3})(\d{3})(\d{3})">
+          <leadingDigits>8[047]|90</leadingDigits>
+          <format>$1 $2 $3</format>
+        </numberFormat>
+        <numberFormat pattern="(\d{2})(\d{3})(\d{2})(\d{2})">
+          <leadingDigits>
+            [2-79]|
+            81
+          </leadingDigits>
+          <format>$1 $2 $3 $4</format>
+        </numberFormat>
+      </availableFormats>
+      <generalDesc>
+        <nationalNumberPattern>
+          8\d{11}|
+          [2-9]\d{8}
+        </nationalNumberPattern>
+      </generalDesc>
+      <noInternationalDialling>
+        <possibleLengths national="12"/>
+        <nationalNumberPattern>860\d{9}</nationalNumberPattern>
+      </noInternationalDialling>
+      <fixedLine>
+        <possibleLengths national="9,12"/>
+        <exampleNumber>212345678</exampleNumber>
+        <nationalNumberPattern>
+          (?:
+            2[12467]|
+            3[1-4]|
+            4[134]
+          )[0-9]\d{6}
+        </nationalNumberPattern>
+      </fixedLine>
+      <mobile>
+        <possibleLengths national="9"/>
+        <exampleNumber>781234567</exampleNumber>
+        <nationalNumberPattern>7[5-9]\d{7}</nationalNumberPattern>
+      </mobile>
+      <tollFree>
+        <possibleLengths national="9"/>
+        <exampleNumber>800123456</exampleNumber>
+        <nationalNumberPattern>800\d{6}</nationalNumberPattern>
+      </tollFree>
+    </territory>
+    <!-- United States (US) -->
+    <territory id="US" countryCode="1" internationalPrefix="011"
+               preferredExtnPrefix=" extn. " nationalPrefix="1"
+               mainCountryForCode="true"
+               nationalPrefixOptionalWhenFormatting="true"
+               carrierCodeFormattingRule="$NP $CC $FG">
+      <availableFormats>
+        <numberFormat pattern="(\d{3})(\d{4})">
+          <format>$1-$2</format>
+          <intlFormat>NA</intlFormat>
+        </numberFormat>
+        <numberFormat pattern="(\d{3})(\d{3})(\d{4})"
+                      nationalPrefixFormattingRule="($FG)">
+          <leadingDigits>[2-9]</leadingDigits>
+          <format>($1) $2-$3</format>
+          <intlFormat>$1-$2-$3</intlFormat>
+        </numberFormat>
+      </availableFormats>
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{9}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="10" localOnly="7"/>
+        <exampleNumber>2015550123</exampleNumber>
+        <nationalNumberPattern>[2-9]\d{9}</nationalNumberPattern>
+      </fixedLine>
+      <mobile>
+        <possibleLengths national="10" localOnly="7"/>
+        <exampleNumber>2015550123</exampleNumber>
+        <nationalNumberPattern>[2-9]\d{9}</nationalNumberPattern>
+      </mobile>
+    </territory>
+    <!-- Canada (CA) -->
+    <territory id="CA" countryCode="1" internationalPrefix="011"
+               nationalPrefix="1">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{9}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="[7-10]"/>
+        <nationalNumberPattern>[2-9]\d{9}</nationalNumberPattern>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>
+`
+
+func buildTestMetadata(t *testing.T) map[string]*libphonenumber.PhoneMetadata {
+	collection, err := BuildPhoneMetadataCollection(
+		strings.NewReader(testPhoneNumberMetadata), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := make(map[string]*libphonenumber.PhoneMetadata)
+	for _, meta := range collection.GetMetadata() {
+		metadata[meta.GetId()] = meta
+	}
+	return metadata
+}
+
+func TestBuildPhoneMetadataCollection(t *testing.T) {
+	metadata := buildTestMetadata(t)
+	if len(metadata) != 3 {
+		t.Fatalf("got metadata for %d regions, want 3", len(metadata))
+	}
+
+	ch := metadata["CH"]
+	if ch.GetCountryCode() != 41 {
+		t.Errorf("CH country code = %d, want 41", ch.GetCountryCode())
+	}
+	if ch.GetNationalPrefixForParsing() != "0" {
+		t.Errorf("CH national prefix for parsing = %q, want the national prefix",
+			ch.GetNationalPrefixForParsing())
+	}
+	if !ch.GetMobileNumberPortableRegion() || ch.GetMainCountryForCode() {
+		t.Error("CH attributes were not carried over")
+	}
+	if ch.GetSameMobileAndFixedLinePattern() {
+		t.Error("CH has the same mobile and fixed-line pattern")
+	}
+
+	us := metadata["US"]
+	if !us.GetMainCountryForCode() {
+		t.Error("US is not the main country for its code")
+	}
+	if us.GetPreferredExtnPrefix() != " extn. " {
+		t.Errorf("US preferred extension prefix = %q", us.GetPreferredExtnPrefix())
+	}
+	if !us.GetSameMobileAndFixedLinePattern() {
+		t.Error("US does not have the same mobile and fixed-line pattern")
+	}
+}
+
+func TestPatternWhitespaceIsStripped(t *testing.T) {
+	ch := buildTestMetadata(t)["CH"]
+	var tests = []struct {
+		got, want string
+	}{
+		{ch.GetGeneralDesc().GetNationalNumberPattern(), `8\d{11}|[2-9]\d{8}`},
+		{ch.GetFixedLine().GetNationalNumberPattern(), `(?:2[12467]|3[1-4]|4[134])[0-9]\d{6}`},
+		{ch.GetNumberFormat()[1].GetLeadingDigitsPattern()[0], `[2-79]|81`},
+		{ch.GetNumberFormat()[1].GetPattern(), `(\d{2})(\d{3})(\d{2})(\d{2})`},
+	}
+	for i, test := range tests {
+		if test.got != test.want {
+			t.Errorf("[test %d] got %q, want %q", i, test.got, test.want)
+		}
+	}
+}
+
+func TestFormattingRulesAreExpanded(t *testing.T) {
+	metadata := buildTestMetadata(t)
+	ch, us := metadata["CH"], metadata["US"]
+
+	// The territory rule applies to every format.
+	for i, format := range ch.GetNumberFormat() {
+		if got := format.GetNationalPrefixFormattingRule(); got != "0$1" {
+			t.Errorf("CH format %d national prefix formatting rule = %q, want 0$1", i, got)
+		}
+		if format.NationalPrefixOptionalWhenFormatting != nil {
+			t.Errorf("CH format %d has nationalPrefixOptionalWhenFormatting set", i)
+		}
+	}
+	// Formats can override it.
+	if got := us.GetNumberFormat()[0].GetNationalPrefixFormattingRule(); got != "" {
+		t.Errorf("US format 0 national prefix formatting rule = %q, want none", got)
+	}
+	if got := us.GetNumberFormat()[1].GetNationalPrefixFormattingRule(); got != "($1)" {
+		t.Errorf("US format 1 national prefix formatting rule = %q, want ($1)", got)
+	}
+	for i, format := range us.GetNumberFormat() {
+		if !format.GetNationalPrefixOptionalWhenFormatting() {
+			t.Errorf("US format %d does not inherit nationalPrefixOptionalWhenFormatting", i)
+		}
+		if got := format.GetDomesticCarrierCodeFormattingRule(); got != "1 $CC $1" {
+			t.Errorf("US format %d carrier code formatting rule = %q, want 1 $CC $1", i, got)
+		}
+	}
+}
+
+func TestIntlFormats(t *testing.T) {
+	metadata := buildTestMetadata(t)
+
+	// Without any intlFormat the national formats are used as they are.
+	if n := len(metadata["CH"].GetIntlNumberFormat()); n != 0 {
+		t.Errorf("CH has %d intl formats, want 0", n)
+	}
+	// NA formats are left out, others are kept.
+	want := &libphonenumber.NumberFormat{
+		Pattern:              proto.String(`(\d{3})(\d{3})(\d{4})`),
+		Format:               proto.String("$1-$2-$3"),
+		LeadingDigitsPattern: []string{"[2-9]"},
+	}
+	intlFormats := metadata["US"].GetIntlNumberFormat()
+	if len(intlFormats) != 1 || !proto.Equal(intlFormats[0], want) {
+		t.Errorf("US intl formats = %v, want [%v]", intlFormats, want)
+	}
+}
+
+func TestPossibleLengthInheritance(t *testing.T) {
+	metadata := buildTestMetadata(t)
+	ch, us, ca := metadata["CH"], metadata["US"], metadata["CA"]
+
+	var tests = []struct {
+		name                    string
+		desc                    *libphonenumber.PhoneNumberDesc
+		possibleLength          []int32
+		possibleLengthLocalOnly []int32
+	}{
+		// Derived from the types, leaving out noInternationalDialling.
+		{"CH generalDesc", ch.GetGeneralDesc(), []int32{9, 12}, nil},
+		// The same as the general description, so inherited.
+		{"CH fixedLine", ch.GetFixedLine(), nil, nil},
+		{"CH mobile", ch.GetMobile(), []int32{9}, nil},
+		{"CH noInternationalDialling", ch.GetNoInternationalDialling(), []int32{12}, nil},
+		// Missing types never match.
+		{"CH voip", ch.GetVoip(), []int32{-1}, nil},
+		{"US generalDesc", us.GetGeneralDesc(), []int32{10}, []int32{7}},
+		{"US fixedLine", us.GetFixedLine(), nil, []int32{7}},
+		// Ranges are expanded.
+		{"CA generalDesc", ca.GetGeneralDesc(), []int32{7, 8, 9, 10}, nil},
+	}
+	for _, test := range tests {
+		if got := test.desc.GetPossibleLength(); !reflect.DeepEqual(got, test.possibleLength) {
+			t.Errorf("%s possible lengths = %v, want %v", test.name, got, test.possibleLength)
+		}
+		if got := test.desc.GetPossibleLengthLocalOnly(); !reflect.DeepEqual(got, test.possibleLengthLocalOnly) {
+			t.Errorf("%s local-only possible lengths = %v, want %v",
+				test.name, got, test.possibleLengthLocalOnly)
+		}
+	}
+}
+
+func TestBuildShortNumberMetadata(t *testing.T) {
+	const shortNumberMetadata = `<phoneNumberMetadata>
+  <territories>
+    <territory id="US">
+      <generalDesc>
+        <nationalNumberPattern>[1-9]\d{2,5}</nationalNumberPattern>
+      </generalDesc>
+      <shortCode>
+        <possibleLengths national="3,5,6"/>
+        <nationalNumberPattern>
+          112|
+          611|
+          9(?:11|99)|
+          [2-9]\d{4,5}
+        </nationalNumberPattern>
+      </shortCode>
+      <emergency>
+        <possibleLengths national="3"/>
+        <exampleNumber>911</exampleNumber>
+        <nationalNumberPattern>112|911</nationalNumberPattern>
+      </emergency>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+
+	collection, err := BuildPhoneMetadataCollection(
+		strings.NewReader(shortNumberMetadata), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	us := collection.GetMetadata()[0]
+	if got := us.GetGeneralDesc().GetPossibleLength(); !reflect.DeepEqual(got, []int32{3, 5, 6}) {
+		t.Errorf("general desc possible lengths = %v, want [3 5 6]", got)
+	}
+	if got := us.GetShortCode().GetNationalNumberPattern(); got != `112|611|9(?:11|99)|[2-9]\d{4,5}` {
+		t.Errorf("short code pattern = %q", got)
+	}
+	if got := us.GetEmergency().GetPossibleLength(); !reflect.DeepEqual(got, []int32{3}) {
+		t.Errorf("emergency possible lengths = %v, want [3]", got)
+	}
+	if got := us.GetSmsServices().GetPossibleLength(); !reflect.DeepEqual(got, []int32{-1}) {
+		t.Errorf("SMS services possible lengths = %v, want [-1]", got)
+	}
+	if us.FixedLine != nil || us.Mobile != nil {
+		t.Error("short number metadata has regular number types")
+	}
+}
+
+func TestBuildAlternateFormatsMetadata(t *testing.T) {
+	const alternateFormats = `<phoneNumberMetadata>
+  <territories>
+    <territory countryCode="49">
+      <availableFormats>
+        <numberFormat pattern="(\d{3})(\d{4,11})">
+          <leadingDigits>[2-9]</leadingDigits>
+          <format>$1 $2</format>
+        </numberFormat>
+      </availableFormats>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+
+	collection, err := BuildPhoneMetadataCollection(
+		strings.NewReader(alternateFormats), false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	de := collection.GetMetadata()[0]
+	if de.Id == nil || de.GetId() != "" || de.GetCountryCode() != 49 {
+		t.Errorf("got id %q and country code %d, want \"\" and 49",
+			de.GetId(), de.GetCountryCode())
+	}
+	if len(de.GetNumberFormat()) != 1 || de.GeneralDesc != nil {
+		t.Errorf("got %d formats and general desc %v, want 1 format only",
+			len(de.GetNumberFormat()), de.GeneralDesc)
+	}
+	if _, err := proto.Marshal(collection); err != nil {
+		t.Errorf("the alternate formats do not serialize: %v", err)
+	}
+}
+
+func TestBuildPhoneMetadataCollectionErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		override string
+		with     string
+	}{
+		{
+			"lengths on the general desc",
+			`<generalDesc>`,
+			`<generalDesc><possibleLengths national="9"/>`,
+		}, {
+			"length not covered by the general desc",
+			`<possibleLengths national="9,12"/>`,
+			`<possibleLengths national="9,11"/>`,
+		}, {
+			"local-only length also a national length",
+			`<possibleLengths national="9"/>
+        <exampleNumber>781234567</exampleNumber>`,
+			`<possibleLengths national="9" localOnly="9"/>`,
+		}, {
+			"range too short",
+			`<possibleLengths national="[7-10]"/>`,
+			`<possibleLengths national="[7-8]"/>`,
+		}, {
+			"duplicate length",
+			`<possibleLengths national="[7-10]"/>`,
+			`<possibleLengths national="7,[7-10]"/>`,
+		}, {
+			"invalid pattern",
+			`<nationalNumberPattern>7[5-9]\d{7}</nationalNumberPattern>`,
+			`<nationalNumberPattern>7[5-9\d{7}</nationalNumberPattern>`,
+		}, {
+			"empty alternative",
+			`<nationalNumberPattern>7[5-9]\d{7}</nationalNumberPattern>`,
+			`<nationalNumberPattern>7(?:[5-9]|)\d{7}</nationalNumberPattern>`,
+		}, {
+			"two types of the same kind",
+			`<mobile>`,
+			`<mobile><nationalNumberPattern>7\d{8}</nationalNumberPattern></mobile><mobile>`,
+		}, {
+			"missing format",
+			`<format>$1 $2 $3</format>`,
+			``,
+		},
+	}
+	for _, test := range tests {
+		if !strings.Contains(testPhoneNumberMetadata, test.override) {
+			t.Fatalf("[%s] %q is not in the test metadata", test.name, test.override)
+		}
+		xml := strings.Replace(testPhoneNumberMetadata, test.override, test.with, 1)
+		_, err := BuildPhoneMetadataCollection(strings.NewReader(xml), false, false)
+		if err == nil {
+			t.Errorf("[%s] no error", test.name)
+		}
+	}
+}
+
+func TestBuildCountryCodeToRegionCodeMap(t *testing.T) {
+	collection, err := BuildPhoneMetadataCollection(
+		strings.NewReader(testPhoneNumberMetadata), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int][]string{
+		1:  {"US", "CA"},
+		41: {"CH"},
+	}
+	if got := BuildCountryCodeToRegionCodeMap(collection); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildCountryCodeToRegionCodeMap() = %v, want %v", got, want)
+	}
+}
+
+func TestStripExampleNumbers(t *testing.T) {
+	collection, err := BuildPhoneMetadataCollection(
+		strings.NewReader(testPhoneNumberMetadata), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if collection.GetMetadata()[0].GetFixedLine().ExampleNumber == nil {
+		t.Fatal("the test metadata has no example numbers")
+	}
+	full := proto.Clone(collection).(*libphonenumber.PhoneMetadataCollection)
+
+	StripExampleNumbers(collection)
+	for i, meta := range collection.GetMetadata() {
+		for _, desc := range []*libphonenumber.PhoneNumberDesc{
+			meta.GetGeneralDesc(), meta.GetFixedLine(), meta.GetMobile(),
+			meta.GetTollFree(), meta.GetPremiumRate(), meta.GetVoip(),
+		} {
+			if desc.ExampleNumber != nil {
+				t.Errorf("%s: example number %q was kept",
+					meta.GetId(), desc.GetExampleNumber())
+			}
+		}
+		// Everything else is kept.
+		fullMeta := full.GetMetadata()[i]
+		if meta.GetFixedLine().GetNationalNumberPattern() !=
+			fullMeta.GetFixedLine().GetNationalNumberPattern() ||
+			len(meta.GetNumberFormat()) != len(fullMeta.GetNumberFormat()) {
+			t.Errorf("%s: more than the example numbers was stripped", meta.GetId())
+		}
+	}
+}
+
+func TestNormalizeMissingDescs(t *testing.T) {
+	want, err := BuildPhoneMetadataCollection(
+		strings.NewReader(testPhoneNumberMetadata), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Mark the missing descriptions the way older metadata does.
+	collection := proto.Clone(want).(*libphonenumber.PhoneMetadataCollection)
+	missing := 0
+	for _, meta := range collection.GetMetadata() {
+		for _, desc := range phoneNumberDescs(meta) {
+			if lengths := desc.GetPossibleLength(); len(lengths) == 1 && lengths[0] == -1 {
+				*desc = libphonenumber.PhoneNumberDesc{NationalNumberPattern: proto.String("NA")}
+				missing++
+			}
+		}
+	}
+	if missing == 0 {
+		t.Fatal("the test metadata has no missing descriptions")
+	}
+
+	NormalizeMissingDescs(collection)
+	if !proto.Equal(collection, want) {
+		t.Errorf("NormalizeMissingDescs() =\n%v\nwant\n%v", collection, want)
+	}
+}
@@ -0,0 +1,131 @@
+// Package carrier provides the name of the carrier a phone number was
+// originally allocated to, from offline data embedded in the package.
+//
+// The data is upstream libphonenumber's carrier data: one directory per
+// language under data/, holding one prefix file per country calling
+// code. Only mobile numbers are mapped to carriers.
+//
+// A Mapper tells the region and type of numbers with the metadata of the
+// PhoneNumberUtil it is given; the package-level functions use the
+// default PhoneNumberUtil.
+package carrier
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/ttacon/libphonenumber"
+	"github.com/ttacon/libphonenumber/internal/prefixmapper"
+)
+
+//go:embed data
+var embeddedData embed.FS
+
+var prefixFileReader = newPrefixFileReader()
+
+func newPrefixFileReader() *prefixmapper.PrefixFileReader {
+	data, err := fs.Sub(embeddedData, "data")
+	if err != nil {
+		// better to die on start up
+		panic(err)
+	}
+	return prefixmapper.NewPrefixFileReader(data)
+}
+
+// A Mapper maps mobile numbers to the carriers they were allocated to,
+// using the metadata of a PhoneNumberUtil to tell their region and
+// type. It is safe for concurrent use.
+type Mapper struct {
+	util *libphonenumber.PhoneNumberUtil
+}
+
+// The Mapper used by the package-level functions.
+var defaultMapper = NewMapper(libphonenumber.GetDefaultPhoneNumberUtil())
+
+// Returns a Mapper using the metadata of util.
+func NewMapper(util *libphonenumber.PhoneNumberUtil) *Mapper {
+	return &Mapper{util: util}
+}
+
+// Returns a carrier name for the given phone number, in the language of
+// the locale, such as "en" or "zh-Hant". The carrier name is the one the
+// number was originally allocated to, however if the country supports
+// mobile number portability the number might not belong to the returned
+// carrier anymore. If no mapping is found an empty string is returned.
+//
+// This method assumes the validity of the number passed in has already
+// been checked.
+func (m *Mapper) GetNameForValidNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	language, script, region := prefixmapper.ParseLocale(locale)
+	return prefixFileReader.GetDescriptionForNumber(number, language, script, region)
+}
+
+// Returns a carrier name for the given phone number, in the language of
+// the locale. The carrier name is the one the number was originally
+// allocated to, however if the country supports mobile number
+// portability the number might not belong to the returned carrier
+// anymore. If no mapping is found an empty string is returned.
+//
+// This function only returns carrier names for mobile, fixed line or
+// mobile, and pager numbers; for any other number it returns an empty
+// string.
+func (m *Mapper) GetNameForNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	numberType := m.util.GetNumberType(number)
+	if isMobile(numberType) {
+		return m.GetNameForValidNumber(number, locale)
+	}
+	return ""
+}
+
+// Gets the name of the carrier for the given phone number only when it
+// is 'safe' to display to users. A carrier name is considered safe if
+// the number is valid and for a region that doesn't support mobile
+// number portability. Carrier names can be misleading in regions with
+// portability, since the number may have moved to another carrier since
+// it was allocated, so an empty string is returned there.
+func (m *Mapper) GetSafeDisplayName(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	if m.util.IsMobileNumberPortableRegion(m.util.GetRegionCodeForNumber(number)) {
+		return ""
+	}
+	return m.GetNameForNumber(number, locale)
+}
+
+// Checks if the supplied number type supports carrier lookup.
+func isMobile(numberType libphonenumber.PhoneNumberType) bool {
+	return numberType == libphonenumber.MOBILE ||
+		numberType == libphonenumber.FIXED_LINE_OR_MOBILE ||
+		numberType == libphonenumber.PAGER
+}
+
+// As Mapper.GetNameForValidNumber, using the default PhoneNumberUtil.
+func GetNameForValidNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	return defaultMapper.GetNameForValidNumber(number, locale)
+}
+
+// As Mapper.GetNameForNumber, using the default PhoneNumberUtil.
+func GetNameForNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	return defaultMapper.GetNameForNumber(number, locale)
+}
+
+// As Mapper.GetSafeDisplayName, using the default PhoneNumberUtil.
+func GetSafeDisplayName(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	return defaultMapper.GetSafeDisplayName(number, locale)
+}
@@ -0,0 +1,76 @@
+package carrier
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+)
+
+func TestGetNameForNumber(t *testing.T) {
+	var tests = []struct {
+		num    string
+		locale string
+		name   string
+		safe   string
+	}{
+		// Germany and Switzerland have mobile number portability, so
+		// the names are not safe to display.
+		{num: "+4915123456789", locale: "en", name: "T-Mobile", safe: ""},
+		{num: "+491721234567", locale: "en", name: "Vodafone", safe: ""},
+		{num: "+41791234567", locale: "en", name: "Swisscom", safe: ""},
+		// No German data, so English is used.
+		{num: "+41781234567", locale: "de_CH", name: "Salt", safe: ""},
+		// The UAE doesn't have mobile number portability.
+		{num: "+971501234567", locale: "en", name: "Etisalat", safe: "Etisalat"},
+		{num: "+971551234567", locale: "en", name: "du", safe: "du"},
+		// Fixed line numbers are not mapped to carriers.
+		{num: "+41446681800", locale: "en", name: "", safe: ""},
+		{num: "+97142345678", locale: "en", name: "", safe: ""},
+		{num: "+447912345678", locale: "en", name: "O2", safe: ""},
+		// Chinese names, in simplified or traditional characters
+		// depending on the region.
+		{num: "+8613800138000", locale: "zh", name: "中国移动", safe: "中国移动"},
+		{num: "+8613800138000", locale: "zh_TW", name: "中國移動", safe: "中國移動"},
+		{num: "+8613800138000", locale: "en", name: "China Mobile", safe: "China Mobile"},
+		// No data for this prefix.
+		{num: "+3197012345678", locale: "en", name: "", safe: ""},
+	}
+	for i, test := range tests {
+		num, err := libphonenumber.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %v", i, test.num, err)
+			continue
+		}
+		if got := GetNameForNumber(num, test.locale); got != test.name {
+			t.Errorf("[test %d] GetNameForNumber(%s, %s) = %q, want %q",
+				i, test.num, test.locale, got, test.name)
+		}
+		if got := GetSafeDisplayName(num, test.locale); got != test.safe {
+			t.Errorf("[test %d] GetSafeDisplayName(%s, %s) = %q, want %q",
+				i, test.num, test.locale, got, test.safe)
+		}
+	}
+}
+
+func TestMapperUsesItsPhoneNumberUtil(t *testing.T) {
+	u := libphonenumber.NewPhoneNumberUtil()
+	err := u.SetRegionMetadataOverride(&libphonenumber.PhoneMetadata{
+		Id:                         proto.String("AE"),
+		MobileNumberPortableRegion: proto.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	num, err := libphonenumber.Parse("+971501234567", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NewMapper(u).GetSafeDisplayName(num, "en"); got != "" {
+		t.Errorf("GetSafeDisplayName() = %q where numbers are portable, want \"\"", got)
+	}
+	if got := GetSafeDisplayName(num, "en"); got != "Etisalat" {
+		t.Errorf("GetSafeDisplayName() = %q with the default PhoneNumberUtil, want %q",
+			got, "Etisalat")
+	}
+}
@@ -0,0 +1,618 @@
+// Command metagen generates the metadata sources of the libphonenumber
+// package from upstream libphonenumber's resources:
+//
+//	metagen.go                 from PhoneNumberMetadata.xml
+//	countrycodetoregionmap.go  from PhoneNumberMetadata.xml
+//	shortmetagen.go            from ShortNumberMetadata.xml
+//	alternateformatgen.go      from PhoneNumberAlternateFormats.xml
+//	countryCodeToTimeZones.go  from timezones/map_data.txt
+//
+// Only the files whose inputs are given are written.
+//
+// With -regions or -calling-codes, the files are limited to the regions
+// listed and to all the regions of the country calling codes listed,
+// for programs that only need a few countries and want smaller
+// binaries. The metadata, the country calling code to region mapping
+// and the short number metadata then only cover those regions, and the
+// alternate formats and time zones only their country calling codes;
+// GetSupportedRegions and CountryCodeToRegion follow. Non-geographical
+// entities, such as 800, are only kept if their country calling code is
+// listed. Limiting needs -metadata, as it is what maps regions to
+// country calling codes, and -short, -alternate and -timezones, so that
+// none of the files is left covering every region. The geocoding and
+// carrier data of the geocoding and carrier packages is not limited.
+// For example:
+//
+//	go run ./cmd/metagen -regions CH,LI -calling-codes 1 -metadata ... -short ... -alternate ... -timezones ...
+//
+// With -lite, the example numbers are left out of the metadata and the
+// short number metadata, as in upstream's lite build. This makes the
+// metadata smaller, both in the binary and on the heap, without
+// changing how numbers are parsed, formatted and validated;
+// GetExampleNumber and the like return nil.
+//
+// The metadata records the upstream release and commit it was generated
+// from, and its content hash, for MetadataVersion to report. The
+// release and commit are taken from the upstream checkout holding
+// -metadata, unless given with -upstream-version and -upstream-commit.
+// With -bin, the metadata is also written in the format read by
+// LoadMetadata, so that programs can pick it up without a new build.
+// -metadata and -short also accept metadata in that format, gzip
+// compressed or not, in place of upstream's XML; the provenance it
+// records is dropped in favour of the one given on the command line,
+// and number descriptions marked as missing with the pattern "NA", as
+// in older metadata, are marked as upstream's XML builder does.
+//
+// The output only depends on the inputs, so regenerating from the same
+// resources gives the same files. With -compress, the metadata blobs
+// are gzip compressed; the library detects this when it loads them.
+//
+// It is run by go generate from the root of the repository, once
+// upstream's resources are in google_libphonenumber/resources (see
+// "make distupdate"):
+//
+//	go generate github.com/ttacon/libphonenumber
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+	"github.com/ttacon/libphonenumber/buildmetadata"
+)
+
+const generatedHeader = "// Code generated by cmd/metagen. DO NOT EDIT.\n\npackage libphonenumber\n"
+
+var (
+	metadataPath  = flag.String("metadata", "", "path to PhoneNumberMetadata.xml")
+	shortPath     = flag.String("short", "", "path to ShortNumberMetadata.xml")
+	alternatePath = flag.String("alternate", "", "path to PhoneNumberAlternateFormats.xml")
+	timezonesPath = flag.String("timezones", "", "path to timezones/map_data.txt")
+	outDir        = flag.String("out", ".", "directory to write the generated files to")
+	compress      = flag.Bool("compress", false, "gzip the metadata blobs")
+	lite          = flag.Bool("lite", false, "leave the example numbers out of the metadata")
+	regions       = flag.String("regions", "", "comma-separated region codes to limit the output to")
+	callingCodes  = flag.String("calling-codes", "", "comma-separated country calling codes to limit the output to, with all their regions")
+
+	upstreamVersion = flag.String("upstream-version", "", "upstream release the metadata comes from (default: read from the upstream checkout)")
+	upstreamCommit  = flag.String("upstream-commit", "", "upstream commit the metadata comes from (default: read from the upstream checkout)")
+	binPath         = flag.String("bin", "", "path to also write the metadata to, for LoadMetadata")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("metagen: ")
+	flag.Parse()
+	if flag.NArg() > 0 || len(*metadataPath)+len(*shortPath)+
+		len(*alternatePath)+len(*timezonesPath) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var collection *libphonenumber.PhoneMetadataCollection
+	if len(*metadataPath) > 0 {
+		var err error
+		collection, err = readMetadataCollection(*metadataPath)
+		if err != nil {
+			return fmt.Errorf("%s: %v", *metadataPath, err)
+		}
+	}
+	filter, err := newRegionFilter(*regions, *callingCodes, collection)
+	if err != nil {
+		return err
+	}
+
+	if collection != nil {
+		collection = filterCollection(collection, filter.keepsMetadata)
+		if *lite {
+			buildmetadata.StripExampleNumbers(collection)
+		}
+		version, commit := detectUpstreamVersion(*metadataPath)
+		if len(*upstreamVersion) > 0 {
+			version = *upstreamVersion
+		}
+		if len(*upstreamCommit) > 0 {
+			commit = *upstreamCommit
+		}
+		data, err := libphonenumber.MarshalMetadata(collection, version, commit)
+		if err != nil {
+			return err
+		}
+		if err := writeBlob("metagen.go", "metaData", data); err != nil {
+			return err
+		}
+		if len(*binPath) > 0 {
+			if *compress {
+				if data, err = gzipBytes(data); err != nil {
+					return err
+				}
+			}
+			if err := os.WriteFile(*binPath, data, 0644); err != nil {
+				return err
+			}
+		}
+		src, err := generateCountryCodeToRegion(
+			buildmetadata.BuildCountryCodeToRegionCodeMap(collection))
+		if err != nil {
+			return err
+		}
+		if err := writeFile("countrycodetoregionmap.go", src); err != nil {
+			return err
+		}
+	}
+	for _, blob := range []struct {
+		path, fileName, varName string
+		keep                    func(*libphonenumber.PhoneMetadata) bool
+	}{
+		{*shortPath, "shortmetagen.go", "shortMetaData", filter.keepsRegion},
+		{*alternatePath, "alternateformatgen.go", "alternateFormatsData", filter.keepsCountryCode},
+	} {
+		if len(blob.path) == 0 {
+			continue
+		}
+		collection, err := readMetadataCollection(blob.path)
+		if err != nil {
+			return fmt.Errorf("%s: %v", blob.path, err)
+		}
+		collection = filterCollection(collection, blob.keep)
+		if *lite {
+			buildmetadata.StripExampleNumbers(collection)
+		}
+		if err := writeMetadataBlob(blob.fileName, blob.varName, collection); err != nil {
+			return err
+		}
+	}
+	if len(*timezonesPath) > 0 {
+		f, err := os.Open(*timezonesPath)
+		if err != nil {
+			return err
+		}
+		timezones, err := parseTimeZones(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", *timezonesPath, err)
+		}
+		for prefix := range timezones {
+			if !filter.keepsPrefix(prefix) {
+				delete(timezones, prefix)
+			}
+		}
+		src, err := generateCountryCodeToTimeZones(timezones)
+		if err != nil {
+			return err
+		}
+		if err := writeFile("countryCodeToTimeZones.go", src); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A regionFilter limits the generated files to some regions and their
+// country calling codes. A nil filter keeps everything.
+type regionFilter struct {
+	regions      map[string]bool
+	countryCodes map[int]bool
+}
+
+// Returns the filter for the comma-separated lists of region codes and
+// country calling codes, as given to -regions and -calling-codes, or
+// nil if both are empty. The filter keeps the regions listed and all
+// the regions of the country calling codes listed, along with the
+// country calling codes of all the regions it keeps. Every region and
+// country calling code listed must be in collection.
+func newRegionFilter(
+	regionList, countryCodeList string,
+	collection *libphonenumber.PhoneMetadataCollection) (*regionFilter, error) {
+
+	if len(regionList) == 0 && len(countryCodeList) == 0 {
+		return nil, nil
+	}
+	if collection == nil || len(*shortPath) == 0 ||
+		len(*alternatePath) == 0 || len(*timezonesPath) == 0 {
+		return nil, fmt.Errorf(
+			"-regions and -calling-codes need -metadata, -short, -alternate and -timezones")
+	}
+	filter := &regionFilter{
+		regions:      make(map[string]bool),
+		countryCodes: make(map[int]bool),
+	}
+	wantedRegions := make(map[string]bool)
+	for _, region := range splitList(regionList) {
+		wantedRegions[strings.ToUpper(region)] = true
+	}
+	wantedCountryCodes := make(map[int]bool)
+	for _, countryCode := range splitList(countryCodeList) {
+		code, err := strconv.Atoi(countryCode)
+		if err != nil {
+			return nil, fmt.Errorf("invalid country calling code %q", countryCode)
+		}
+		wantedCountryCodes[code] = true
+	}
+
+	for _, meta := range collection.GetMetadata() {
+		countryCode := int(meta.GetCountryCode())
+		if wantedRegions[meta.GetId()] || wantedCountryCodes[countryCode] {
+			filter.regions[meta.GetId()] = true
+			filter.countryCodes[countryCode] = true
+		}
+	}
+	for region := range wantedRegions {
+		if !filter.regions[region] {
+			return nil, fmt.Errorf("no metadata for region %s", region)
+		}
+	}
+	for countryCode := range wantedCountryCodes {
+		if !filter.countryCodes[countryCode] {
+			return nil, fmt.Errorf("no metadata for country calling code %d", countryCode)
+		}
+	}
+	return filter, nil
+}
+
+// Splits a comma-separated list, ignoring spaces and empty items.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Reports whether the filter keeps the metadata of a region or, for the
+// non-geographical entity, of a country calling code.
+func (f *regionFilter) keepsMetadata(meta *libphonenumber.PhoneMetadata) bool {
+	if meta.GetId() == libphonenumber.REGION_CODE_FOR_NON_GEO_ENTITY {
+		return f.keepsCountryCode(meta)
+	}
+	return f.keepsRegion(meta)
+}
+
+// Reports whether the filter keeps the region of meta.
+func (f *regionFilter) keepsRegion(meta *libphonenumber.PhoneMetadata) bool {
+	return f == nil || f.regions[meta.GetId()]
+}
+
+// Reports whether the filter keeps the country calling code of meta.
+func (f *regionFilter) keepsCountryCode(meta *libphonenumber.PhoneMetadata) bool {
+	return f == nil || f.countryCodes[int(meta.GetCountryCode())]
+}
+
+// Reports whether the filter keeps a time zone prefix, made of a country
+// calling code and leading digits of the national number. As no country
+// calling code is a prefix of another, the prefix belongs to the code
+// it starts with.
+func (f *regionFilter) keepsPrefix(prefix int) bool {
+	if f == nil {
+		return true
+	}
+	digits := strconv.Itoa(prefix)
+	for countryCode := range f.countryCodes {
+		if strings.HasPrefix(digits, strconv.Itoa(countryCode)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reads the metadata at path, which is either upstream's XML or
+// metadata in the format read by LoadMetadata, such as the output of
+// -bin, gzip compressed or not.
+func readMetadataCollection(path string) (*libphonenumber.PhoneMetadataCollection, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '<' {
+		return buildmetadata.BuildPhoneMetadataCollectionFromFile(path)
+	}
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+	collection := &libphonenumber.PhoneMetadataCollection{}
+	if err := proto.Unmarshal(data, collection); err != nil {
+		return nil, err
+	}
+	// Drop the provenance recorded in the metadata, if any; the output
+	// records its own.
+	collection.XXX_unrecognized = nil
+	buildmetadata.NormalizeMissingDescs(collection)
+	return collection, nil
+}
+
+// Returns the collection holding the metadata of collection that keep
+// reports true for, in the same order.
+func filterCollection(
+	collection *libphonenumber.PhoneMetadataCollection,
+	keep func(*libphonenumber.PhoneMetadata) bool) *libphonenumber.PhoneMetadataCollection {
+
+	filtered := &libphonenumber.PhoneMetadataCollection{}
+	for _, meta := range collection.GetMetadata() {
+		if keep(meta) {
+			filtered.Metadata = append(filtered.Metadata, meta)
+		}
+	}
+	return filtered
+}
+
+func writeFile(name string, src []byte) error {
+	return os.WriteFile(filepath.Join(*outDir, name), src, 0644)
+}
+
+// Serializes collection and writes it as the byte slice varName to the
+// file name.
+func writeMetadataBlob(
+	name, varName string,
+	collection *libphonenumber.PhoneMetadataCollection) error {
+
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		return err
+	}
+	return writeBlob(name, varName, data)
+}
+
+// Writes data, compressing it if asked to, as the byte slice varName to
+// the file name.
+func writeBlob(name, varName string, data []byte) error {
+	if *compress {
+		var err error
+		if data, err = gzipBytes(data); err != nil {
+			return err
+		}
+	}
+	src, err := generateBlob(varName, data)
+	if err != nil {
+		return err
+	}
+	return writeFile(name, src)
+}
+
+// Returns the upstream release and commit of the libphonenumber
+// checkout holding path, as far as they can be told: the release is the
+// latest one listed in release_notes.txt, the commit the one checked
+// out. Either is empty if it can't be found.
+func detectUpstreamVersion(path string) (version, commit string) {
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return "", ""
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+	if notes, err := os.ReadFile(filepath.Join(dir, "release_notes.txt")); err == nil {
+		version = releasePattern.FindString(string(notes))
+	}
+	return version, readGitHead(filepath.Join(dir, ".git"))
+}
+
+// Matches a release in upstream's release notes, such as "v8.13.27".
+var releasePattern = regexp.MustCompile(`\bv\d+\.\d+\.\d+\b`)
+
+// Returns the commit checked out in the git directory gitDir, or empty
+// if it can't be read.
+func readGitHead(gitDir string) string {
+	head, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+	ref, ok := strings.CutPrefix(strings.TrimSpace(string(head)), "ref: ")
+	if !ok {
+		// A detached HEAD holds the commit itself.
+		return strings.TrimSpace(string(head))
+	}
+	if commit, err := os.ReadFile(filepath.Join(gitDir, ref)); err == nil {
+		return strings.TrimSpace(string(commit))
+	}
+	// The ref may only be in packed-refs, as after a shallow clone.
+	packedRefs, err := os.ReadFile(filepath.Join(gitDir, "packed-refs"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(packedRefs), "\n") {
+		if commit, name, ok := strings.Cut(strings.TrimSpace(line), " "); ok && name == ref {
+			return commit
+		}
+	}
+	return ""
+}
+
+// Compresses data. The gzip header carries no name or modification
+// time, so the same data always compresses to the same bytes.
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Returns the source of a file declaring data as the byte slice
+// varName.
+func generateBlob(varName string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\nvar %s = []byte{\n", varName)
+	for i, b := range data {
+		if i%13 == 0 {
+			buf.WriteString("\t")
+		}
+		fmt.Fprintf(&buf, "0x%02X,", b)
+		if i%13 == 12 || i == len(data)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// Returns the source of countrycodetoregionmap.go for the mapping.
+func generateCountryCodeToRegion(countryCodeToRegion map[int][]string) ([]byte, error) {
+	countryCodes := make([]int, 0, len(countryCodeToRegion))
+	for countryCode := range countryCodeToRegion {
+		countryCodes = append(countryCodes, countryCode)
+	}
+	sort.Ints(countryCodes)
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString(`
+// CountryCodeToRegion maps country calling codes to their region codes,
+// with the main region of each code first. It starts out with the
+// mapping compiled into the library, and follows the metadata of the
+// package-level functions: LoadMetadata, SetRegionMetadataOverride and
+// the like update it in place. It must not be modified, nor read while
+// they run; GetCountryCodeToRegionMap returns a copy that can be used
+// at any time.
+var CountryCodeToRegion = map[int][]string{
+`)
+	for _, countryCode := range countryCodes {
+		regions := countryCodeToRegion[countryCode]
+		quoted := make([]string, len(regions))
+		for i, region := range regions {
+			quoted[i] = strconv.Quote(region)
+		}
+		// Long lists, such as the NANPA regions, are wrapped ten to a
+		// line.
+		const regionsPerLine = 10
+		if len(quoted) <= regionsPerLine {
+			fmt.Fprintf(&buf, "\t%d: []string{%s},\n",
+				countryCode, strings.Join(quoted, ", "))
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%d: []string{\n", countryCode)
+		for len(quoted) > 0 {
+			n := regionsPerLine
+			if len(quoted) < n {
+				n = len(quoted)
+			}
+			fmt.Fprintf(&buf, "\t\t%s,\n", strings.Join(quoted[:n], ", "))
+			quoted = quoted[n:]
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// Parses upstream's timezones/map_data.txt, which maps prefixes made of
+// a country calling code and leading digits of the national number to
+// time zones separated by '&', one prefix per line:
+//
+//	1201|America/New_York
+//	1208|America/Denver&America/Los_Angeles
+func parseTimeZones(r io.Reader) (map[int][]string, error) {
+	timezones := make(map[int][]string)
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		i := strings.IndexByte(line, '|')
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: missing prefix", lineNumber)
+		}
+		prefix, err := strconv.Atoi(line[:i])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid prefix %q", lineNumber, line[:i])
+		}
+		if _, ok := timezones[prefix]; ok {
+			return nil, fmt.Errorf("line %d: duplicate prefix %d", lineNumber, prefix)
+		}
+		timezones[prefix] = strings.Split(line[i+1:], "&")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return timezones, nil
+}
+
+// Returns the source of countryCodeToTimeZones.go for the mapping.
+func generateCountryCodeToTimeZones(timezones map[int][]string) ([]byte, error) {
+	// The prefixes are listed in the order of map_data.txt, i.e. sorted
+	// as strings.
+	prefixes := make([]string, 0, len(timezones))
+	maxPrefixLength := 0
+	for prefix := range timezones {
+		prefixes = append(prefixes, strconv.Itoa(prefix))
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		if len(prefix) > maxPrefixLength {
+			maxPrefixLength = len(prefix)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\nconst MAX_REGION_CODE_LENGTH = %d\n", maxPrefixLength)
+	buf.WriteString(`
+// This structure maps telephone number digits to a particular timezone,
+// because a timezones could represent many prefixes, there could be many
+// entries for the same timezone.
+// For example this is the case for America/New_York.
+
+var CountryCodeToTimeZones = map[int][]string{
+`)
+	for _, prefix := range prefixes {
+		key, _ := strconv.Atoi(prefix)
+		zones := timezones[key]
+		if len(zones) == 1 {
+			fmt.Fprintf(&buf, "\t%s: []string{%s},\n", prefix, strconv.Quote(zones[0]))
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%s: []string{\n", prefix)
+		for _, zone := range zones {
+			fmt.Fprintf(&buf, "\t\t%s,\n", strconv.Quote(zone))
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,455 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ttacon/libphonenumber"
+)
+
+// Returns the part of src from the first line starting with from.
+func sourceFrom(t *testing.T, src []byte, from string) string {
+	i := bytes.Index(src, []byte("\n"+from))
+	if i < 0 {
+		t.Fatalf("%q not found in:\n%s", from, src)
+	}
+	return string(src[i+1:])
+}
+
+func TestGenerateCountryCodeToRegion(t *testing.T) {
+	src, err := generateCountryCodeToRegion(libphonenumber.CountryCodeToRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("../../countrycodetoregionmap.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, want) {
+		t.Errorf("generated source differs from countrycodetoregionmap.go:\n%s", src)
+	}
+}
+
+func TestGenerateCountryCodeToTimeZones(t *testing.T) {
+	// Write the checked-in map back out as map_data.txt.
+	var mapData strings.Builder
+	mapData.WriteString("# Copyright (C) 2012 The Libphonenumber Authors\n\n")
+	var prefixes []int
+	for prefix := range libphonenumber.CountryCodeToTimeZones {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Ints(prefixes)
+	for _, prefix := range prefixes {
+		fmt.Fprintf(&mapData, "%d|%s\n", prefix,
+			strings.Join(libphonenumber.CountryCodeToTimeZones[prefix], "&"))
+	}
+
+	timezones, err := parseTimeZones(strings.NewReader(mapData.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(timezones, libphonenumber.CountryCodeToTimeZones) {
+		t.Fatal("parsed time zones differ from CountryCodeToTimeZones")
+	}
+	src, err := generateCountryCodeToTimeZones(timezones)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("../../countryCodeToTimeZones.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, want) {
+		t.Errorf("generated source differs from countryCodeToTimeZones.go:\n%s", src)
+	}
+
+	if _, err := parseTimeZones(strings.NewReader("1201|America/New_York\n1201|America/Chicago\n")); err == nil {
+		t.Error("no error for a duplicate prefix")
+	}
+	if _, err := parseTimeZones(strings.NewReader("12x|America/New_York\n")); err == nil {
+		t.Error("no error for an invalid prefix")
+	}
+}
+
+func TestGenerateBlob(t *testing.T) {
+	data := []byte{
+		0x0A, 0xE9, 0x01, 0x0A, 0x1D, 0x12, 0x17, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31,
+		0x35, 0x38,
+	}
+	src, err := generateBlob("metaData", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `var metaData = []byte{
+	0x0A, 0xE9, 0x01, 0x0A, 0x1D, 0x12, 0x17, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31,
+	0x35, 0x38,
+}
+`
+	if got := sourceFrom(t, src, "var metaData"); got != want {
+		t.Errorf("generateBlob() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGzipBytesIsDeterministic(t *testing.T) {
+	data := bytes.Repeat([]byte("PhoneMetadataCollection"), 100)
+	first, err := gzipBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := gzipBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("compressing the same data twice gave different bytes")
+	}
+	if first[0] != 0x1f || first[1] != 0x8b {
+		t.Errorf("compressed data starts with %#x %#x, want the gzip magic number",
+			first[0], first[1])
+	}
+}
+
+func TestRun(t *testing.T) {
+	const phoneNumberMetadata = `<phoneNumberMetadata>
+  <territories>
+    <territory id="CH" countryCode="41" internationalPrefix="00" nationalPrefix="0">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{8}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="9"/>
+        <nationalNumberPattern>
+          2[12467]\d{7}
+        </nationalNumberPattern>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+	const mapData = "41|Europe/Zurich\n"
+
+	dir := t.TempDir()
+	if err := os.WriteFile(dir+"/PhoneNumberMetadata.xml", []byte(phoneNumberMetadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dir+"/map_data.txt", []byte(mapData), 0644); err != nil {
+		t.Fatal(err)
+	}
+	*metadataPath = dir + "/PhoneNumberMetadata.xml"
+	*timezonesPath = dir + "/map_data.txt"
+	*outDir = dir
+	defer func() {
+		*metadataPath, *timezonesPath, *outDir, *compress = "", "", ".", false
+	}()
+
+	// Generate every file twice, with and without compression, to
+	// check the output does not change between runs.
+	generated := make(map[string][]byte)
+	for _, compressed := range []bool{false, true, false, true} {
+		*compress = compressed
+		if err := run(); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{
+			"metagen.go", "countrycodetoregionmap.go", "countryCodeToTimeZones.go",
+		} {
+			src, err := os.ReadFile(dir + "/" + name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := fmt.Sprint(name, compressed)
+			if previous, ok := generated[key]; ok && !bytes.Equal(previous, src) {
+				t.Errorf("%s (compressed: %v) differs between runs", name, compressed)
+			}
+			generated[key] = src
+		}
+	}
+
+	if got := string(generated["countrycodetoregionmap.gofalse"]); !strings.Contains(got, `41: []string{"CH"},`) {
+		t.Errorf("countrycodetoregionmap.go =\n%s", got)
+	}
+	if got := string(generated["countryCodeToTimeZones.gofalse"]); !strings.Contains(got, `41: []string{"Europe/Zurich"},`) {
+		t.Errorf("countryCodeToTimeZones.go =\n%s", got)
+	}
+	if got := sourceFrom(t, generated["metagen.gotrue"], "var metaData"); !strings.HasPrefix(got, "var metaData = []byte{\n\t0x1F, 0x8B,") {
+		t.Errorf("compressed metagen.go does not hold gzip data:\n%s", got)
+	}
+	if bytes.Equal(generated["metagen.gofalse"], generated["metagen.gotrue"]) {
+		t.Error("-compress did not change metagen.go")
+	}
+}
+
+func TestRunWithRegions(t *testing.T) {
+	const phoneNumberMetadata = `<phoneNumberMetadata>
+  <territories>
+    <territory id="CH" countryCode="41" internationalPrefix="00" nationalPrefix="0">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{8}</nationalNumberPattern>
+      </generalDesc>
+    </territory>
+    <territory id="LI" countryCode="423" internationalPrefix="00" nationalPrefix="0">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{6}</nationalNumberPattern>
+      </generalDesc>
+    </territory>
+    <territory id="001" countryCode="800">
+      <generalDesc>
+        <nationalNumberPattern>\d{8}</nationalNumberPattern>
+      </generalDesc>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+	const mapData = "41|Europe/Zurich\n423|Europe/Vaduz\n800|Etc/Unknown\n"
+
+	dir := t.TempDir()
+	if err := os.WriteFile(dir+"/PhoneNumberMetadata.xml", []byte(phoneNumberMetadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dir+"/map_data.txt", []byte(mapData), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The territories serve as short number metadata and alternate
+	// formats too.
+	*metadataPath = dir + "/PhoneNumberMetadata.xml"
+	*shortPath = dir + "/PhoneNumberMetadata.xml"
+	*alternatePath = dir + "/PhoneNumberMetadata.xml"
+	*timezonesPath = dir + "/map_data.txt"
+	*outDir = dir
+	defer func() {
+		*metadataPath, *shortPath, *alternatePath, *timezonesPath = "", "", "", ""
+		*outDir = "."
+		*regions, *callingCodes = "", ""
+	}()
+
+	var tests = []struct {
+		regions, callingCodes string
+		kept, dropped         []string
+	}{
+		{"ch", "", []string{"41:"}, []string{"423:", "800:"}},
+		{"", "423, 800", []string{"423:", "800:"}, []string{"41:"}},
+		{"", "", []string{"41:", "423:", "800:"}, nil},
+	}
+	for i, test := range tests {
+		*regions, *callingCodes = test.regions, test.callingCodes
+		if err := run(); err != nil {
+			t.Fatalf("[test %d] %v", i, err)
+		}
+		regionMap, err := os.ReadFile(dir + "/countrycodetoregionmap.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		timezones, err := os.ReadFile(dir + "/countryCodeToTimeZones.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"shortmetagen.go", "alternateformatgen.go"} {
+			if _, err := os.Stat(dir + "/" + name); err != nil {
+				t.Errorf("[test %d] %v", i, err)
+			}
+		}
+		for _, src := range []string{string(regionMap), string(timezones)} {
+			for _, kept := range test.kept {
+				if !strings.Contains(src, "\t"+kept) {
+					t.Errorf("[test %d] %q missing from:\n%s", i, kept, src)
+				}
+			}
+			for _, dropped := range test.dropped {
+				if strings.Contains(src, "\t"+dropped) {
+					t.Errorf("[test %d] %q not filtered out of:\n%s", i, dropped, src)
+				}
+			}
+		}
+	}
+
+	for _, test := range []struct{ regions, callingCodes string }{
+		{"XX", ""},
+		{"", "44"},
+		{"", "4x"},
+	} {
+		*regions, *callingCodes = test.regions, test.callingCodes
+		if err := run(); err == nil {
+			t.Errorf("no error for -regions %q -calling-codes %q",
+				test.regions, test.callingCodes)
+		}
+	}
+	// Without any of the inputs, a generated file would be left
+	// covering every region.
+	*regions, *callingCodes = "CH", ""
+	for _, input := range []*string{metadataPath, shortPath, alternatePath, timezonesPath} {
+		path := *input
+		*input = ""
+		if err := run(); err == nil {
+			t.Errorf("no error for -regions with -metadata %q -short %q -alternate %q -timezones %q",
+				*metadataPath, *shortPath, *alternatePath, *timezonesPath)
+		}
+		*input = path
+	}
+}
+
+func TestDetectUpstreamVersion(t *testing.T) {
+	const commit = "3c3d2a1b4e5f60718293a4b5c6d7e8f901234567"
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		".git/HEAD":                         "ref: refs/heads/master\n",
+		".git/refs/heads/master":            commit + "\n",
+		"release_notes.txt":                 "Jan 12, 2024: v8.13.28\nMetadata changes:\n\nDec 20, 2023: v8.13.27\n",
+		"resources/PhoneNumberMetadata.xml": "<phoneNumberMetadata/>",
+	} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	metadata := filepath.Join(dir, "resources/PhoneNumberMetadata.xml")
+	if version, got := detectUpstreamVersion(metadata); version != "v8.13.28" || got != commit {
+		t.Errorf("detectUpstreamVersion() = %q, %q, want %q, %q",
+			version, got, "v8.13.28", commit)
+	}
+
+	// A ref only found in packed-refs.
+	if err := os.Remove(filepath.Join(dir, ".git/refs/heads/master")); err != nil {
+		t.Fatal(err)
+	}
+	packedRefs := "# pack-refs with: peeled fully-peeled sorted\n" + commit + " refs/heads/master\n"
+	if err := os.WriteFile(filepath.Join(dir, ".git/packed-refs"), []byte(packedRefs), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, got := detectUpstreamVersion(metadata); got != commit {
+		t.Errorf("commit from packed-refs = %q, want %q", got, commit)
+	}
+
+	// A detached HEAD.
+	if err := os.WriteFile(filepath.Join(dir, ".git/HEAD"), []byte(commit+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, got := detectUpstreamVersion(metadata); got != commit {
+		t.Errorf("commit from detached HEAD = %q, want %q", got, commit)
+	}
+}
+
+func TestRunWithBin(t *testing.T) {
+	const phoneNumberMetadata = `<phoneNumberMetadata>
+  <territories>
+    <territory id="CH" countryCode="41" internationalPrefix="00" nationalPrefix="0">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{8}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="9"/>
+        <nationalNumberPattern>
+          2[12467]\d{7}
+        </nationalNumberPattern>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+
+	dir := t.TempDir()
+	if err := os.WriteFile(dir+"/PhoneNumberMetadata.xml", []byte(phoneNumberMetadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+	*metadataPath = dir + "/PhoneNumberMetadata.xml"
+	*outDir = dir
+	*binPath = dir + "/PhoneNumberMetadata.bin"
+	*upstreamVersion, *upstreamCommit = "v8.13.28", "3c3d2a1b"
+	defer func() {
+		*metadataPath, *outDir, *binPath, *compress = "", ".", "", false
+		*upstreamVersion, *upstreamCommit = "", ""
+	}()
+
+	var hashes []string
+	for _, compressed := range []bool{false, true} {
+		*compress = compressed
+		if err := run(); err != nil {
+			t.Fatal(err)
+		}
+		u := libphonenumber.NewPhoneNumberUtil()
+		if err := u.LoadMetadataFromFile(*binPath); err != nil {
+			t.Fatal(err)
+		}
+		version := u.MetadataVersion()
+		if version.UpstreamVersion != "v8.13.28" || version.UpstreamCommit != "3c3d2a1b" ||
+			!strings.HasPrefix(version.ContentHash, "sha256:") {
+			t.Errorf("MetadataVersion() = %+v (compressed: %v)", version, compressed)
+		}
+		hashes = append(hashes, version.ContentHash)
+		if regions := u.GetSupportedRegions(); len(regions) != 1 {
+			t.Errorf("loaded metadata has regions %v, want CH", regions)
+		}
+	}
+	if hashes[0] != hashes[1] {
+		t.Error("compressing the metadata changed its content hash")
+	}
+}
+
+func TestRunWithBinaryMetadata(t *testing.T) {
+	const phoneNumberMetadata = `<phoneNumberMetadata>
+  <territories>
+    <territory id="CH" countryCode="41" internationalPrefix="00" nationalPrefix="0">
+      <generalDesc>
+        <nationalNumberPattern>[2-9]\d{8}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="9"/>
+        <nationalNumberPattern>
+          2[12467]\d{7}
+        </nationalNumberPattern>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+
+	dir := t.TempDir()
+	if err := os.WriteFile(dir+"/PhoneNumberMetadata.xml", []byte(phoneNumberMetadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+	*metadataPath = dir + "/PhoneNumberMetadata.xml"
+	*outDir = dir
+	*upstreamVersion, *upstreamCommit = "v8.13.28", "3c3d2a1b"
+	defer func() {
+		*metadataPath, *outDir, *binPath, *compress = "", ".", "", false
+		*upstreamVersion, *upstreamCommit = "", ""
+	}()
+
+	// Generate from the XML, then again from the metadata written with
+	// -bin, both plain and gzip compressed.
+	if err := run(); err != nil {
+		t.Fatal(err)
+	}
+	fromXML, err := os.ReadFile(dir + "/metagen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, compressed := range []bool{false, true} {
+		*metadataPath, *binPath, *compress = dir+"/PhoneNumberMetadata.xml", dir+"/PhoneNumberMetadata.bin", compressed
+		if err := run(); err != nil {
+			t.Fatal(err)
+		}
+		*metadataPath, *binPath, *compress = dir+"/PhoneNumberMetadata.bin", "", false
+		if err := run(); err != nil {
+			t.Fatal(err)
+		}
+		fromBin, err := os.ReadFile(dir + "/metagen.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(fromXML, fromBin) {
+			t.Errorf("metagen.go from -bin output (compressed: %v) differs from the one from XML", compressed)
+		}
+	}
+
+	if err := os.WriteFile(dir+"/garbage.bin", []byte("not metadata"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	*metadataPath = dir + "/garbage.bin"
+	if err := run(); err == nil {
+		t.Error("run() with garbage metadata succeeded")
+	}
+}
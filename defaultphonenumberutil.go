@@ -0,0 +1,493 @@
+package libphonenumber
+
+import (
+	"io"
+
+	"github.com/ttacon/builder"
+)
+
+// The functions below use the default PhoneNumberUtil, which starts out
+// with the metadata compiled into the library.
+
+// Returns the default PhoneNumberUtil, for packages such as geocoding
+// and carrier to use when no other PhoneNumberUtil is given.
+func GetDefaultPhoneNumberUtil() *PhoneNumberUtil {
+	return defaultPhoneNumberUtil
+}
+
+// As PhoneNumberUtil.GetLengthOfGeographicalAreaCode, using the default
+// PhoneNumberUtil.
+func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
+	return defaultPhoneNumberUtil.GetLengthOfGeographicalAreaCode(number)
+}
+
+// As PhoneNumberUtil.GetLengthOfNationalDestinationCode, using the
+// default PhoneNumberUtil.
+func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
+	return defaultPhoneNumberUtil.GetLengthOfNationalDestinationCode(number)
+}
+
+// As PhoneNumberUtil.GetSupportedRegions, using the default
+// PhoneNumberUtil.
+func GetSupportedRegions() map[string]struct{} {
+	return defaultPhoneNumberUtil.GetSupportedRegions()
+}
+
+// As PhoneNumberUtil.GetSupportedGlobalNetworkCallingCodes, using the
+// default PhoneNumberUtil.
+func GetSupportedGlobalNetworkCallingCodes() map[int]struct{} {
+	return defaultPhoneNumberUtil.GetSupportedGlobalNetworkCallingCodes()
+}
+
+// As PhoneNumberUtil.GetSupportedTypesForRegion, using the default
+// PhoneNumberUtil.
+func GetSupportedTypesForRegion(regionCode string) map[PhoneNumberType]struct{} {
+	return defaultPhoneNumberUtil.GetSupportedTypesForRegion(regionCode)
+}
+
+// As PhoneNumberUtil.GetSupportedTypesForNonGeoEntity, using the
+// default PhoneNumberUtil.
+func GetSupportedTypesForNonGeoEntity(
+	countryCallingCode int) map[PhoneNumberType]struct{} {
+
+	return defaultPhoneNumberUtil.GetSupportedTypesForNonGeoEntity(countryCallingCode)
+}
+
+// As PhoneNumberUtil.Format, using the default PhoneNumberUtil.
+func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
+	return defaultPhoneNumberUtil.Format(number, numberFormat)
+}
+
+// As PhoneNumberUtil.FormatWithBuf, using the default PhoneNumberUtil.
+func FormatWithBuf(
+	number *PhoneNumber,
+	numberFormat PhoneNumberFormat,
+	formattedNumber *builder.Builder) {
+
+	defaultPhoneNumberUtil.FormatWithBuf(number, numberFormat, formattedNumber)
+}
+
+// As PhoneNumberUtil.FormatByPattern, using the default
+// PhoneNumberUtil.
+func FormatByPattern(number *PhoneNumber,
+	numberFormat PhoneNumberFormat,
+	userDefinedFormats []*NumberFormat) string {
+
+	return defaultPhoneNumberUtil.FormatByPattern(
+		number, numberFormat, userDefinedFormats)
+}
+
+// As PhoneNumberUtil.FormatNationalNumberWithCarrierCode, using the
+// default PhoneNumberUtil.
+func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string) string {
+	return defaultPhoneNumberUtil.FormatNationalNumberWithCarrierCode(
+		number, carrierCode)
+}
+
+// As PhoneNumberUtil.FormatNationalNumberWithPreferredCarrierCode,
+// using the default PhoneNumberUtil.
+func FormatNationalNumberWithPreferredCarrierCode(
+	number *PhoneNumber,
+	fallbackCarrierCode string) string {
+
+	return defaultPhoneNumberUtil.FormatNationalNumberWithPreferredCarrierCode(
+		number, fallbackCarrierCode)
+}
+
+// As PhoneNumberUtil.FormatNumberForMobileDialing, using the default
+// PhoneNumberUtil.
+func FormatNumberForMobileDialing(
+	number *PhoneNumber,
+	regionCallingFrom string,
+	withFormatting bool) string {
+
+	return defaultPhoneNumberUtil.FormatNumberForMobileDialing(
+		number, regionCallingFrom, withFormatting)
+}
+
+// As PhoneNumberUtil.FormatOutOfCountryCallingNumber, using the default
+// PhoneNumberUtil.
+func FormatOutOfCountryCallingNumber(
+	number *PhoneNumber,
+	regionCallingFrom string) string {
+
+	return defaultPhoneNumberUtil.FormatOutOfCountryCallingNumber(
+		number, regionCallingFrom)
+}
+
+// As PhoneNumberUtil.FormatInOriginalFormat, using the default
+// PhoneNumberUtil.
+func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) string {
+	return defaultPhoneNumberUtil.FormatInOriginalFormat(
+		number, regionCallingFrom)
+}
+
+// As PhoneNumberUtil.FormatOutOfCountryKeepingAlphaChars, using the
+// default PhoneNumberUtil.
+func FormatOutOfCountryKeepingAlphaChars(
+	number *PhoneNumber,
+	regionCallingFrom string) string {
+
+	return defaultPhoneNumberUtil.FormatOutOfCountryKeepingAlphaChars(
+		number, regionCallingFrom)
+}
+
+// As PhoneNumberUtil.GetExampleNumber, using the default
+// PhoneNumberUtil.
+func GetExampleNumber(regionCode string) *PhoneNumber {
+	return defaultPhoneNumberUtil.GetExampleNumber(regionCode)
+}
+
+// As PhoneNumberUtil.GetExampleNumberForType, using the default
+// PhoneNumberUtil.
+func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumber {
+	return defaultPhoneNumberUtil.GetExampleNumberForType(regionCode, typ)
+}
+
+// As PhoneNumberUtil.GetInvalidExampleNumber, using the default
+// PhoneNumberUtil.
+func GetInvalidExampleNumber(regionCode string) *PhoneNumber {
+	return defaultPhoneNumberUtil.GetInvalidExampleNumber(regionCode)
+}
+
+// As PhoneNumberUtil.GetInvalidExampleNumberForType, using the default
+// PhoneNumberUtil.
+func GetInvalidExampleNumberForType(
+	regionCode string,
+	typ PhoneNumberType) *PhoneNumber {
+
+	return defaultPhoneNumberUtil.GetInvalidExampleNumberForType(regionCode, typ)
+}
+
+// As PhoneNumberUtil.GetExampleNumberForNonGeoEntity, using the default
+// PhoneNumberUtil.
+func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
+	return defaultPhoneNumberUtil.GetExampleNumberForNonGeoEntity(
+		countryCallingCode)
+}
+
+// As PhoneNumberUtil.GetNumberType, using the default PhoneNumberUtil.
+func GetNumberType(number *PhoneNumber) PhoneNumberType {
+	return defaultPhoneNumberUtil.GetNumberType(number)
+}
+
+// As PhoneNumberUtil.IsValidNumber, using the default PhoneNumberUtil.
+func IsValidNumber(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsValidNumber(number)
+}
+
+// As PhoneNumberUtil.IsValidNumberForRegion, using the default
+// PhoneNumberUtil.
+func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
+	return defaultPhoneNumberUtil.IsValidNumberForRegion(number, regionCode)
+}
+
+// As PhoneNumberUtil.GetRegionCodeForNumber, using the default
+// PhoneNumberUtil.
+func GetRegionCodeForNumber(number *PhoneNumber) string {
+	return defaultPhoneNumberUtil.GetRegionCodeForNumber(number)
+}
+
+// As PhoneNumberUtil.GetRegionCodeForCountryCode, using the default
+// PhoneNumberUtil.
+func GetRegionCodeForCountryCode(countryCallingCode int) string {
+	return defaultPhoneNumberUtil.GetRegionCodeForCountryCode(
+		countryCallingCode)
+}
+
+// As PhoneNumberUtil.GetRegionCodesForCountryCode, using the default
+// PhoneNumberUtil.
+func GetRegionCodesForCountryCode(countryCallingCode int) []string {
+	return defaultPhoneNumberUtil.GetRegionCodesForCountryCode(
+		countryCallingCode)
+}
+
+// As PhoneNumberUtil.GetCountryCodeForRegion, using the default
+// PhoneNumberUtil.
+func GetCountryCodeForRegion(regionCode string) int {
+	return defaultPhoneNumberUtil.GetCountryCodeForRegion(regionCode)
+}
+
+// As PhoneNumberUtil.GetNddPrefixForRegion, using the default
+// PhoneNumberUtil.
+func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
+	return defaultPhoneNumberUtil.GetNddPrefixForRegion(
+		regionCode, stripNonDigits)
+}
+
+// As PhoneNumberUtil.IsNANPACountry, using the default PhoneNumberUtil.
+func IsNANPACountry(regionCode string) bool {
+	return defaultPhoneNumberUtil.IsNANPACountry(regionCode)
+}
+
+// As PhoneNumberUtil.IsPossibleNumber, using the default
+// PhoneNumberUtil.
+func IsPossibleNumber(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsPossibleNumber(number)
+}
+
+// As PhoneNumberUtil.IsPossibleNumberWithReason, using the default
+// PhoneNumberUtil.
+func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
+	return defaultPhoneNumberUtil.IsPossibleNumberWithReason(number)
+}
+
+// As PhoneNumberUtil.IsPossibleNumberForType, using the default
+// PhoneNumberUtil.
+func IsPossibleNumberForType(number *PhoneNumber, typ PhoneNumberType) bool {
+	return defaultPhoneNumberUtil.IsPossibleNumberForType(number, typ)
+}
+
+// As PhoneNumberUtil.IsPossibleNumberForTypeWithReason, using the
+// default PhoneNumberUtil.
+func IsPossibleNumberForTypeWithReason(
+	number *PhoneNumber,
+	typ PhoneNumberType) ValidationResult {
+
+	return defaultPhoneNumberUtil.IsPossibleNumberForTypeWithReason(number, typ)
+}
+
+// As PhoneNumberUtil.TruncateTooLongNumber, using the default
+// PhoneNumberUtil.
+func TruncateTooLongNumber(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.TruncateTooLongNumber(number)
+}
+
+// As PhoneNumberUtil.GetAsYouTypeFormatter, using the default
+// PhoneNumberUtil.
+func GetAsYouTypeFormatter(regionCode string) *AsYouTypeFormatter {
+	return defaultPhoneNumberUtil.GetAsYouTypeFormatter(regionCode)
+}
+
+// As PhoneNumberUtil.Parse, using the default PhoneNumberUtil.
+func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return defaultPhoneNumberUtil.Parse(numberToParse, defaultRegion)
+}
+
+// As PhoneNumberUtil.ParseToNumber, using the default PhoneNumberUtil.
+func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
+	return defaultPhoneNumberUtil.ParseToNumber(
+		numberToParse, defaultRegion, phoneNumber)
+}
+
+// As PhoneNumberUtil.ParseAndKeepRawInput, using the default
+// PhoneNumberUtil.
+func ParseAndKeepRawInput(
+	numberToParse, defaultRegion string) (*PhoneNumber, error) {
+
+	return defaultPhoneNumberUtil.ParseAndKeepRawInput(
+		numberToParse, defaultRegion)
+}
+
+// As PhoneNumberUtil.ParseAndKeepRawInputToNumber, using the default
+// PhoneNumberUtil.
+func ParseAndKeepRawInputToNumber(
+	numberToParse, defaultRegion string,
+	phoneNumber *PhoneNumber) error {
+
+	return defaultPhoneNumberUtil.ParseAndKeepRawInputToNumber(
+		numberToParse, defaultRegion, phoneNumber)
+}
+
+// As PhoneNumberUtil.FindNumbers, using the default PhoneNumberUtil.
+func FindNumbers(text, defaultRegion string) *PhoneNumberMatcher {
+	return defaultPhoneNumberUtil.FindNumbers(text, defaultRegion)
+}
+
+// As PhoneNumberUtil.FindNumbersWithLeniency, using the default
+// PhoneNumberUtil.
+func FindNumbersWithLeniency(
+	text, defaultRegion string,
+	leniency Leniency,
+	maxTries int64) *PhoneNumberMatcher {
+
+	return defaultPhoneNumberUtil.FindNumbersWithLeniency(
+		text, defaultRegion, leniency, maxTries)
+}
+
+// As PhoneNumberUtil.IsNumberMatch, using the default PhoneNumberUtil.
+func IsNumberMatch(firstNumber, secondNumber string) MatchType {
+	return defaultPhoneNumberUtil.IsNumberMatch(firstNumber, secondNumber)
+}
+
+// As PhoneNumberUtil.IsNumberMatchWithNumbers, using the default
+// PhoneNumberUtil.
+func IsNumberMatchWithNumbers(firstNumber, secondNumber *PhoneNumber) MatchType {
+	return defaultPhoneNumberUtil.IsNumberMatchWithNumbers(firstNumber, secondNumber)
+}
+
+// As PhoneNumberUtil.IsNumberMatchWithOneNumber, using the default
+// PhoneNumberUtil.
+func IsNumberMatchWithOneNumber(
+	firstNumber *PhoneNumber,
+	secondNumber string) MatchType {
+
+	return defaultPhoneNumberUtil.IsNumberMatchWithOneNumber(firstNumber, secondNumber)
+}
+
+// As PhoneNumberUtil.IsMobileNumberPortableRegion, using the default
+// PhoneNumberUtil.
+func IsMobileNumberPortableRegion(regionCode string) bool {
+	return defaultPhoneNumberUtil.IsMobileNumberPortableRegion(regionCode)
+}
+
+// As PhoneNumberUtil.ContainsOnlyValidXChars, using the default
+// PhoneNumberUtil.
+func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
+	return defaultPhoneNumberUtil.ContainsOnlyValidXChars(number, candidate)
+}
+
+// As PhoneNumberUtil.IsNationalPrefixPresentIfRequired, using the
+// default PhoneNumberUtil.
+func IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsNationalPrefixPresentIfRequired(number)
+}
+
+// As PhoneNumberUtil.CheckNumberGroupingIsValid, using the default
+// PhoneNumberUtil.
+func CheckNumberGroupingIsValid(
+	number *PhoneNumber,
+	candidate string,
+	fn func(*PhoneNumber, string, []string) bool) bool {
+
+	return defaultPhoneNumberUtil.CheckNumberGroupingIsValid(
+		number, candidate, fn)
+}
+
+// As PhoneNumberUtil.AllNumberGroupsRemainGrouped, using the default
+// PhoneNumberUtil.
+func AllNumberGroupsRemainGrouped(
+	number *PhoneNumber,
+	normalizedCandidate string,
+	formattedNumberGroups []string) bool {
+
+	return defaultPhoneNumberUtil.AllNumberGroupsRemainGrouped(
+		number, normalizedCandidate, formattedNumberGroups)
+}
+
+// As PhoneNumberUtil.LoadMetadata, using the default PhoneNumberUtil.
+func LoadMetadata(r io.Reader) error {
+	return defaultPhoneNumberUtil.LoadMetadata(r)
+}
+
+// As PhoneNumberUtil.LoadMetadataFromFile, using the default
+// PhoneNumberUtil.
+func LoadMetadataFromFile(path string) error {
+	return defaultPhoneNumberUtil.LoadMetadataFromFile(path)
+}
+
+// As PhoneNumberUtil.SetRegionMetadataOverride, using the default
+// PhoneNumberUtil.
+func SetRegionMetadataOverride(override *PhoneMetadata) error {
+	return defaultPhoneNumberUtil.SetRegionMetadataOverride(override)
+}
+
+// As PhoneNumberUtil.RemoveRegionMetadataOverride, using the default
+// PhoneNumberUtil.
+func RemoveRegionMetadataOverride(regionCode string) error {
+	return defaultPhoneNumberUtil.RemoveRegionMetadataOverride(regionCode)
+}
+
+// As PhoneNumberUtil.ClearRegionMetadataOverrides, using the default
+// PhoneNumberUtil.
+func ClearRegionMetadataOverrides() error {
+	return defaultPhoneNumberUtil.ClearRegionMetadataOverrides()
+}
+
+// As PhoneNumberUtil.GetCountryCodeToRegionMap, using the default
+// PhoneNumberUtil.
+func GetCountryCodeToRegionMap() map[int][]string {
+	return defaultPhoneNumberUtil.GetCountryCodeToRegionMap()
+}
+
+// As PhoneNumberUtil.MetadataVersion, using the default
+// PhoneNumberUtil.
+func MetadataVersion() MetadataVersionInfo {
+	return defaultPhoneNumberUtil.MetadataVersion()
+}
+
+// As PhoneNumberUtil.NewPhoneNumberToTimeZonesMapper, using the default
+// PhoneNumberUtil.
+func NewPhoneNumberToTimeZonesMapper(
+	prefixTimeZonesMap map[int][]string) *PhoneNumberToTimeZonesMapper {
+
+	return defaultPhoneNumberUtil.NewPhoneNumberToTimeZonesMapper(prefixTimeZonesMap)
+}
+
+// As PhoneNumberUtil.IsPossibleShortNumberForRegion, using the default
+// PhoneNumberUtil.
+func IsPossibleShortNumberForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	return defaultPhoneNumberUtil.IsPossibleShortNumberForRegion(number, regionDialingFrom)
+}
+
+// As PhoneNumberUtil.IsPossibleShortNumber, using the default
+// PhoneNumberUtil.
+func IsPossibleShortNumber(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsPossibleShortNumber(number)
+}
+
+// As PhoneNumberUtil.IsValidShortNumberForRegion, using the default
+// PhoneNumberUtil.
+func IsValidShortNumberForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	return defaultPhoneNumberUtil.IsValidShortNumberForRegion(number, regionDialingFrom)
+}
+
+// As PhoneNumberUtil.IsValidShortNumber, using the default
+// PhoneNumberUtil.
+func IsValidShortNumber(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsValidShortNumber(number)
+}
+
+// As PhoneNumberUtil.GetExpectedCostForRegion, using the default
+// PhoneNumberUtil.
+func GetExpectedCostForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) ShortNumberCost {
+
+	return defaultPhoneNumberUtil.GetExpectedCostForRegion(number, regionDialingFrom)
+}
+
+// As PhoneNumberUtil.GetExpectedCost, using the default
+// PhoneNumberUtil.
+func GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	return defaultPhoneNumberUtil.GetExpectedCost(number)
+}
+
+// As PhoneNumberUtil.ConnectsToEmergencyNumber, using the default
+// PhoneNumberUtil.
+func ConnectsToEmergencyNumber(number, regionCode string) bool {
+	return defaultPhoneNumberUtil.ConnectsToEmergencyNumber(number, regionCode)
+}
+
+// As PhoneNumberUtil.IsEmergencyNumber, using the default
+// PhoneNumberUtil.
+func IsEmergencyNumber(number, regionCode string) bool {
+	return defaultPhoneNumberUtil.IsEmergencyNumber(number, regionCode)
+}
+
+// As PhoneNumberUtil.IsCarrierSpecific, using the default
+// PhoneNumberUtil.
+func IsCarrierSpecific(number *PhoneNumber) bool {
+	return defaultPhoneNumberUtil.IsCarrierSpecific(number)
+}
+
+// As PhoneNumberUtil.IsCarrierSpecificForRegion, using the default
+// PhoneNumberUtil.
+func IsCarrierSpecificForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	return defaultPhoneNumberUtil.IsCarrierSpecificForRegion(number, regionDialingFrom)
+}
+
+// As PhoneNumberUtil.IsSmsServiceForRegion, using the default
+// PhoneNumberUtil.
+func IsSmsServiceForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	return defaultPhoneNumberUtil.IsSmsServiceForRegion(number, regionDialingFrom)
+}
@@ -0,0 +1,7 @@
+//go:build !libphonenumber_lite
+
+package libphonenumber
+
+// Builds without the libphonenumber_lite tag keep the example numbers
+// of the metadata. See litemetadata.go.
+const liteMetadata = false
@@ -0,0 +1,5 @@
+package libphonenumber
+
+// Regenerates the metadata from upstream's resources, fetched by
+// "make distupdate". See cmd/metagen.
+//go:generate go run ./cmd/metagen -metadata google_libphonenumber/resources/PhoneNumberMetadata.xml -short google_libphonenumber/resources/ShortNumberMetadata.xml -alternate google_libphonenumber/resources/PhoneNumberAlternateFormats.xml -timezones google_libphonenumber/resources/timezones/map_data.txt
@@ -0,0 +1,201 @@
+// Package geocoding provides geographical information related to a
+// phone number, such as "Mountain View, CA" or "Zürich", from offline
+// data embedded in the package.
+//
+// The data is upstream libphonenumber's geocoding data: one directory
+// per language under data/, holding one prefix file per country calling
+// code (per three-digit area for NANPA). When no finer description is
+// available for a number, the name of its country is used instead, in
+// the language of the locale when CLDR names the country in it and in
+// English otherwise.
+//
+// A Geocoder tells the region and type of numbers with the metadata of
+// the PhoneNumberUtil it is given; the package-level functions use the
+// default PhoneNumberUtil.
+package geocoding
+
+import (
+	"embed"
+	"io/fs"
+	"strings"
+
+	"github.com/ttacon/libphonenumber"
+	"github.com/ttacon/libphonenumber/internal/prefixmapper"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+//go:embed data
+var embeddedData embed.FS
+
+var prefixFileReader = newPrefixFileReader()
+
+func newPrefixFileReader() *prefixmapper.PrefixFileReader {
+	data, err := fs.Sub(embeddedData, "data")
+	if err != nil {
+		// better to die on start up
+		panic(err)
+	}
+	return prefixmapper.NewPrefixFileReader(data)
+}
+
+// A Geocoder describes where phone numbers are from, using the metadata
+// of a PhoneNumberUtil to tell their region and type. It is safe for
+// concurrent use.
+type Geocoder struct {
+	util *libphonenumber.PhoneNumberUtil
+}
+
+// The Geocoder used by the package-level functions.
+var defaultGeocoder = NewGeocoder(libphonenumber.GetDefaultPhoneNumberUtil())
+
+// Returns a Geocoder using the metadata of util.
+func NewGeocoder(util *libphonenumber.PhoneNumberUtil) *Geocoder {
+	return &Geocoder{util: util}
+}
+
+// Returns the display name of the territory the phone number is from,
+// in the language of the locale. If it could be from many territories,
+// nothing is returned.
+func (g *Geocoder) getCountryNameForNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	regionCodes := g.util.GetRegionCodesForCountryCode(
+		int(number.GetCountryCode()))
+	if len(regionCodes) == 1 {
+		return getRegionDisplayName(regionCodes[0], locale)
+	}
+	regionWhereNumberIsValid := "ZZ"
+	for _, regionCode := range regionCodes {
+		if g.util.IsValidNumberForRegion(number, regionCode) {
+			// If the number has already been found valid for one
+			// region, then we don't know which region it belongs to so
+			// we return nothing.
+			if regionWhereNumberIsValid != "ZZ" {
+				return ""
+			}
+			regionWhereNumberIsValid = regionCode
+		}
+	}
+	return getRegionDisplayName(regionWhereNumberIsValid, locale)
+}
+
+// Returns the display name of the given region in the language of the
+// locale, as named by CLDR, or its English name if CLDR has no names in
+// that language or the locale can't be parsed.
+func getRegionDisplayName(regionCode, locale string) string {
+	if len(regionCode) == 0 || regionCode == "ZZ" ||
+		regionCode == libphonenumber.REGION_CODE_FOR_NON_GEO_ENTITY {
+		return ""
+	}
+	if tag, err := language.Parse(locale); err == nil {
+		region, err := language.ParseRegion(regionCode)
+		if namer := display.Regions(tag); err == nil && namer != nil {
+			if name := namer.Name(region); len(name) > 0 {
+				return name
+			}
+		}
+	}
+	return englishCountryNames[regionCode]
+}
+
+// Returns a text description for the given phone number, in the
+// language of the locale. The description might consist of the name of
+// the country where the phone number is from, or the name of the
+// geographical area the phone number is from if more detailed
+// information is available. The number must be valid.
+func (g *Geocoder) getDescriptionForValidNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	language, script, region := prefixmapper.ParseLocale(locale)
+	var areaDescription string
+	mobileToken := libphonenumber.GetCountryMobileToken(int(number.GetCountryCode()))
+	nationalNumber := libphonenumber.GetNationalSignificantNumber(number)
+	if len(mobileToken) > 0 && strings.HasPrefix(nationalNumber, mobileToken) {
+		// In some countries, eg. Argentina, mobile numbers have a
+		// mobile token before the national destination code, this
+		// should be removed before geocoding.
+		nationalNumber = nationalNumber[len(mobileToken):]
+		regionCode := g.util.GetRegionCodeForCountryCode(
+			int(number.GetCountryCode()))
+		copiedNumber, err := g.util.Parse(nationalNumber, regionCode)
+		if err != nil {
+			// If this happens, just reuse what we had.
+			copiedNumber = number
+		}
+		areaDescription = prefixFileReader.GetDescriptionForNumber(
+			copiedNumber, language, script, region)
+	} else {
+		areaDescription = prefixFileReader.GetDescriptionForNumber(
+			number, language, script, region)
+	}
+	if len(areaDescription) > 0 {
+		return areaDescription
+	}
+	return g.getCountryNameForNumber(number, locale)
+}
+
+// As GetDescriptionForNumber, but assumes the number is valid and
+// takes the region of the user into account. If the number is from the
+// region of the user, the area the number is from is described, as in
+// "Mountain View, CA" for a Californian number seen from the US;
+// otherwise only the country is named, as in "United States" for the
+// same number seen from Germany. userRegion is a two-letter region code
+// such as "US".
+func (g *Geocoder) GetDescriptionForValidNumber(
+	number *libphonenumber.PhoneNumber,
+	locale, userRegion string) string {
+
+	// If the user region matches the number's region, then we just show
+	// the lower-level description, if one exists - if no description
+	// exists, we will show the region(country) name for the number.
+	regionCode := g.util.GetRegionCodeForNumber(number)
+	if userRegion == regionCode {
+		return g.getDescriptionForValidNumber(number, locale)
+	}
+	// Otherwise, we just show the region(country) name for now.
+	return getRegionDisplayName(regionCode, locale)
+}
+
+// Returns a text description for the given phone number, in the
+// language of the locale, such as "en", "de_CH" or "zh-Hant". The
+// description might consist of the name of the country where the phone
+// number is from, or the name of the geographical area the phone number
+// is from if more detailed information is available. When no
+// description is available in the language, English is used, except
+// for Chinese, Japanese and Korean; country names are in English when
+// CLDR has none in the language. Returns "" if the number could come
+// from multiple countries, or the country code is in fact invalid.
+func (g *Geocoder) GetDescriptionForNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	numberType := g.util.GetNumberType(number)
+	if numberType == libphonenumber.UNKNOWN {
+		return ""
+	} else if !libphonenumber.IsNumberGeographicalForType(
+		numberType, int(number.GetCountryCode())) {
+		return g.getCountryNameForNumber(number, locale)
+	}
+	return g.getDescriptionForValidNumber(number, locale)
+}
+
+// As Geocoder.GetDescriptionForValidNumber, using the default
+// PhoneNumberUtil.
+func GetDescriptionForValidNumber(
+	number *libphonenumber.PhoneNumber,
+	locale, userRegion string) string {
+
+	return defaultGeocoder.GetDescriptionForValidNumber(number, locale, userRegion)
+}
+
+// As Geocoder.GetDescriptionForNumber, using the default
+// PhoneNumberUtil.
+func GetDescriptionForNumber(
+	number *libphonenumber.PhoneNumber,
+	locale string) string {
+
+	return defaultGeocoder.GetDescriptionForNumber(number, locale)
+}
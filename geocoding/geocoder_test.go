@@ -0,0 +1,97 @@
+package geocoding
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+)
+
+func TestGetDescriptionForNumber(t *testing.T) {
+	var tests = []struct {
+		num    string
+		region string
+		locale string
+		exp    string
+	}{
+		{num: "+16502530000", locale: "en", exp: "Mountain View, CA"},
+		{num: "+16502120000", locale: "en", exp: "San Mateo, CA"},
+		{num: "+12015550123", locale: "en", exp: "New Jersey"},
+		{num: "+41446681800", locale: "de", exp: "Zürich"},
+		{num: "+41446681800", locale: "de_CH", exp: "Zürich"},
+		{num: "+41446681800", locale: "en", exp: "Zurich"},
+		{num: "+41446681800", locale: "it", exp: "Zurigo"},
+		// No Spanish data, so English is used.
+		{num: "+41446681800", locale: "es", exp: "Zurich"},
+		{num: "+49891234567", locale: "de", exp: "München"},
+		{num: "+442071234567", locale: "en-GB", exp: "London"},
+		{num: "+390236618300", locale: "en", exp: "Milan"},
+		// No finer data for this country, so the country name is used,
+		// in the language of the locale.
+		{num: "+4533123456", locale: "en", exp: "Denmark"},
+		{num: "+4533123456", locale: "de", exp: "Dänemark"},
+		{num: "+4533123456", locale: "zh_Hant", exp: "丹麥"},
+		// Mobile numbers aren't geographical.
+		{num: "+447912345678", locale: "en", exp: "United Kingdom"},
+		{num: "+447912345678", locale: "it", exp: "Regno Unito"},
+		// No English fallback for Korean, but the country is named.
+		{num: "+442071234567", locale: "ko", exp: "영국"},
+		// Numbers from non-geographical entities have no description.
+		{num: "+80012345678", locale: "en", exp: ""},
+		// Invalid numbers have no description.
+		{num: "+4412", locale: "en", exp: ""},
+	}
+	for i, test := range tests {
+		num, err := libphonenumber.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %v", i, test.num, err)
+			continue
+		}
+		if got := GetDescriptionForNumber(num, test.locale); got != test.exp {
+			t.Errorf("[test %d] GetDescriptionForNumber(%s, %s) = %q, want %q",
+				i, test.num, test.locale, got, test.exp)
+		}
+	}
+}
+
+func TestGetDescriptionForValidNumber(t *testing.T) {
+	num, err := libphonenumber.Parse("+16502530000", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := GetDescriptionForValidNumber(num, "en", "US"); got != "Mountain View, CA" {
+		t.Errorf("GetDescriptionForValidNumber(US) = %q, want %q", got, "Mountain View, CA")
+	}
+	if got := GetDescriptionForValidNumber(num, "en", "DE"); got != "United States" {
+		t.Errorf("GetDescriptionForValidNumber(DE) = %q, want %q", got, "United States")
+	}
+	if got := GetDescriptionForValidNumber(num, "de", "DE"); got != "Vereinigte Staaten" {
+		t.Errorf("GetDescriptionForValidNumber(de, DE) = %q, want %q", got, "Vereinigte Staaten")
+	}
+}
+
+func TestGeocoderUsesItsPhoneNumberUtil(t *testing.T) {
+	u := libphonenumber.NewPhoneNumberUtil()
+	// Only internal extensions are fixed-line numbers with the override.
+	err := u.SetRegionMetadataOverride(&libphonenumber.PhoneMetadata{
+		Id: proto.String("CH"),
+		FixedLine: &libphonenumber.PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+			PossibleLength:        []int32{5},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	num, err := libphonenumber.Parse("+41446681800", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NewGeocoder(u).GetDescriptionForNumber(num, "de"); got != "" {
+		t.Errorf("GetDescriptionForNumber() = %q for an invalid number, want \"\"", got)
+	}
+	if got := GetDescriptionForNumber(num, "de"); got != "Zürich" {
+		t.Errorf("GetDescriptionForNumber() = %q with the default PhoneNumberUtil, want %q",
+			got, "Zürich")
+	}
+}
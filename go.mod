@@ -5,6 +5,6 @@ go 1.22
 require (
 	github.com/golang/protobuf v1.5.4
 	github.com/ttacon/builder v0.0.0-20170518171403-c099f663e1c2
+	golang.org/x/text v0.21.0
+	google.golang.org/protobuf v1.33.0
 )
-
-require google.golang.org/protobuf v1.33.0 // indirect
@@ -0,0 +1,256 @@
+// Package prefixmapper looks up descriptions of phone numbers, such as
+// geographical areas or carrier names, in prefix files in the format
+// used by upstream libphonenumber. Each file holds the mappings for one
+// country calling code (or, for NANPA, one three-digit area) in one
+// language, one per line:
+//
+//	# Comments start with a hash.
+//	1650|California
+//	1650253|Mountain View, CA
+//
+// The prefixes include the country calling code. A number is described
+// by the mapping with the longest prefix that matches it.
+package prefixmapper
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ttacon/libphonenumber"
+)
+
+// A PrefixMap maps phone number prefixes, including the country calling
+// code, to descriptions.
+type PrefixMap struct {
+	descriptions map[string]string
+	// The distinct prefix lengths in the map, longest first.
+	lengths []int
+}
+
+// Parses a PrefixMap from r. Blank lines and lines starting with '#'
+// are ignored; every other line must be a prefix of ASCII digits
+// followed by '|' and the description.
+func ParsePrefixMap(r io.Reader) (*PrefixMap, error) {
+	m := &PrefixMap{descriptions: make(map[string]string)}
+	seenLengths := make(map[int]bool)
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		i := strings.IndexByte(line, '|')
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: missing prefix", lineNumber)
+		}
+		prefix := line[:i]
+		if _, err := strconv.ParseUint(prefix, 10, 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid prefix %q", lineNumber, prefix)
+		}
+		m.descriptions[prefix] = line[i+1:]
+		if !seenLengths[len(prefix)] {
+			seenLengths[len(prefix)] = true
+			m.lengths = append(m.lengths, len(prefix))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	// Longest prefixes first, so the first hit in Lookup is the most
+	// specific one.
+	sort.Sort(sort.Reverse(sort.IntSlice(m.lengths)))
+	return m, nil
+}
+
+// Returns the description of the longest prefix of prefixedNumber in
+// the map, or "" if none matches. prefixedNumber is the country calling
+// code followed by the national significant number.
+func (m *PrefixMap) Lookup(prefixedNumber string) string {
+	for _, length := range m.lengths {
+		if length > len(prefixedNumber) {
+			continue
+		}
+		if description, ok := m.descriptions[prefixedNumber[:length]]; ok {
+			return description
+		}
+	}
+	return ""
+}
+
+// Returns the description for number, or "" if there is none.
+func (m *PrefixMap) LookupNumber(number *libphonenumber.PhoneNumber) string {
+	return m.Lookup(strconv.Itoa(int(number.GetCountryCode())) +
+		libphonenumber.GetNationalSignificantNumber(number))
+}
+
+// Chinese locales that share their data files.
+var localeNormalizationMap = map[string]string{
+	"zh_TW": "zh_Hant",
+	"zh_HK": "zh_Hant",
+	"zh_MO": "zh_Hant",
+}
+
+// A PrefixFileReader reads prefix files from a file system laid out as
+// <language>/<prefix>.txt, where the language is a language code with
+// an optional script and region ("en", "zh_Hant") and the prefix is a
+// country calling code, or "1" and a three-digit area code for NANPA
+// numbers. Files are parsed on first use and kept in memory. A
+// PrefixFileReader is safe for concurrent use.
+type PrefixFileReader struct {
+	fsys fs.FS
+
+	mu sync.Mutex
+	// Parsed files by path; nil entries record files that are missing
+	// or could not be parsed.
+	maps map[string]*PrefixMap
+	// The languages available in fsys, loaded on first use.
+	languages map[string]bool
+}
+
+// Returns a PrefixFileReader over fsys.
+func NewPrefixFileReader(fsys fs.FS) *PrefixFileReader {
+	return &PrefixFileReader{
+		fsys: fsys,
+		maps: make(map[string]*PrefixMap),
+	}
+}
+
+// Returns a text description in the given language of the number, or
+// "" if none is available. When no description exists in the language,
+// English is used instead, except for Chinese, Japanese and Korean,
+// where an English description would not be helpful.
+func (r *PrefixFileReader) GetDescriptionForNumber(
+	number *libphonenumber.PhoneNumber,
+	language, script, region string) string {
+
+	countryCallingCode := int(number.GetCountryCode())
+	// As the NANPA data is split into multiple files covering 3-digit
+	// areas, use a phone number prefix of 4 digits for NANPA instead,
+	// e.g. 1650.
+	phonePrefix := countryCallingCode
+	if countryCallingCode == 1 {
+		phonePrefix = 1000 + int(number.GetNationalNumber()/10000000)
+	}
+	description := ""
+	if m := r.getPhonePrefixDescriptions(phonePrefix, language, script, region); m != nil {
+		description = m.LookupNumber(number)
+	}
+	// When a location is not available in the requested language, fall
+	// back to English.
+	if len(description) == 0 && mayFallBackToEnglish(language) {
+		if m := r.getPhonePrefixDescriptions(phonePrefix, "en", "", ""); m != nil {
+			description = m.LookupNumber(number)
+		}
+	}
+	return description
+}
+
+func mayFallBackToEnglish(language string) bool {
+	// Don't fall back to English if the requested language is among the
+	// following:
+	// - Chinese
+	// - Japanese
+	// - Korean
+	return language != "zh" && language != "ja" && language != "ko"
+}
+
+func (r *PrefixFileReader) getPhonePrefixDescriptions(
+	prefix int,
+	language, script, region string) *PrefixMap {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	languageCode := r.findBestMatchingLanguageCode(language, script, region)
+	if len(languageCode) == 0 {
+		return nil
+	}
+	fileName := path.Join(languageCode, strconv.Itoa(prefix)+".txt")
+	if m, ok := r.maps[fileName]; ok {
+		return m
+	}
+	var m *PrefixMap
+	if f, err := r.fsys.Open(fileName); err == nil {
+		m, _ = ParsePrefixMap(f)
+		f.Close()
+	}
+	r.maps[fileName] = m
+	return m
+}
+
+// Returns the best match among the available languages for the given
+// language, script and region, or "" if there is none.
+func (r *PrefixFileReader) findBestMatchingLanguageCode(
+	language, script, region string) string {
+
+	if r.languages == nil {
+		r.languages = make(map[string]bool)
+		entries, _ := fs.ReadDir(r.fsys, ".")
+		for _, entry := range entries {
+			if entry.IsDir() {
+				r.languages[entry.Name()] = true
+			}
+		}
+	}
+
+	fullLocale := language
+	if len(script) > 0 {
+		fullLocale += "_" + script
+	}
+	if len(region) > 0 {
+		fullLocale += "_" + region
+	}
+	if normalizedLocale, ok := localeNormalizationMap[fullLocale]; ok &&
+		r.languages[normalizedLocale] {
+		return normalizedLocale
+	}
+	if r.languages[fullLocale] {
+		return fullLocale
+	}
+	if (len(script) == 0) != (len(region) == 0) {
+		if r.languages[language] {
+			return language
+		}
+	} else if len(script) > 0 && len(region) > 0 {
+		if r.languages[language+"_"+script] {
+			return language + "_" + script
+		}
+		if r.languages[language+"_"+region] {
+			return language + "_" + region
+		}
+		if r.languages[language] {
+			return language
+		}
+	}
+	return ""
+}
+
+// Splits a locale such as "de", "de_CH", "de-CH" or "zh-Hant-TW" into
+// its language, script and region.
+func ParseLocale(locale string) (language, script, region string) {
+	parts := strings.FieldsFunc(locale, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	if len(parts) == 0 {
+		return "", "", ""
+	}
+	language = strings.ToLower(parts[0])
+	for _, part := range parts[1:] {
+		switch {
+		case len(part) == 4 && len(script) == 0 && len(region) == 0:
+			script = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case (len(part) == 2 || len(part) == 3) && len(region) == 0:
+			region = strings.ToUpper(part)
+		}
+	}
+	return language, script, region
+}
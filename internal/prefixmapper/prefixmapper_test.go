@@ -0,0 +1,116 @@
+package prefixmapper
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ttacon/libphonenumber"
+)
+
+func TestPrefixMapLookup(t *testing.T) {
+	m, err := ParsePrefixMap(strings.NewReader(`# A comment.
+1650|California
+
+1650253|Mountain View, CA
+4930|Berlin
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		number string
+		exp    string
+	}{
+		{number: "16502530000", exp: "Mountain View, CA"},
+		{number: "16502120000", exp: "California"},
+		{number: "12015550123", exp: ""},
+		{number: "49301234567", exp: "Berlin"},
+		{number: "49", exp: ""},
+	}
+	for i, test := range tests {
+		if got := m.Lookup(test.number); got != test.exp {
+			t.Errorf("[test %d] Lookup(%s) = %q, want %q", i, test.number, got, test.exp)
+		}
+	}
+}
+
+func TestParsePrefixMapErrors(t *testing.T) {
+	for _, in := range []string{"California", "|California", "16x0|California"} {
+		if _, err := ParsePrefixMap(strings.NewReader(in)); err == nil {
+			t.Errorf("ParsePrefixMap(%q) should fail", in)
+		}
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	var tests = []struct {
+		locale                   string
+		language, script, region string
+	}{
+		{locale: "en", language: "en"},
+		{locale: "de_CH", language: "de", region: "CH"},
+		{locale: "de-ch", language: "de", region: "CH"},
+		{locale: "zh-Hant", language: "zh", script: "Hant"},
+		{locale: "zh_hant_TW", language: "zh", script: "Hant", region: "TW"},
+		{locale: "es-419", language: "es", region: "419"},
+		{locale: ""},
+	}
+	for i, test := range tests {
+		language, script, region := ParseLocale(test.locale)
+		if language != test.language || script != test.script || region != test.region {
+			t.Errorf("[test %d] ParseLocale(%q) = %q, %q, %q, want %q, %q, %q",
+				i, test.locale, language, script, region,
+				test.language, test.script, test.region)
+		}
+	}
+}
+
+func TestPrefixFileReader(t *testing.T) {
+	reader := NewPrefixFileReader(fstest.MapFS{
+		"en/1650.txt":    {Data: []byte("1650|California\n")},
+		"en/49.txt":      {Data: []byte("4989|Munich\n4930|Berlin\n")},
+		"de/49.txt":      {Data: []byte("4989|München\n")},
+		"zh/49.txt":      {Data: []byte("4989|慕尼黑\n")},
+		"zh_Hant/49.txt": {Data: []byte("4989|慕尼黑市\n")},
+	})
+	munich := &libphonenumber.PhoneNumber{
+		CountryCode:    proto.Int32(49),
+		NationalNumber: proto.Uint64(891234567),
+	}
+	berlin := &libphonenumber.PhoneNumber{
+		CountryCode:    proto.Int32(49),
+		NationalNumber: proto.Uint64(301234567),
+	}
+	mountainView := &libphonenumber.PhoneNumber{
+		CountryCode:    proto.Int32(1),
+		NationalNumber: proto.Uint64(6502530000),
+	}
+	var tests = []struct {
+		number                   *libphonenumber.PhoneNumber
+		language, script, region string
+		exp                      string
+	}{
+		{number: munich, language: "de", exp: "München"},
+		{number: munich, language: "de", region: "CH", exp: "München"},
+		{number: munich, language: "en", exp: "Munich"},
+		// Falls back to English.
+		{number: berlin, language: "de", exp: "Berlin"},
+		{number: munich, language: "fr", exp: "Munich"},
+		{number: mountainView, language: "en", exp: "California"},
+		{number: munich, language: "zh", region: "TW", exp: "慕尼黑市"},
+		{number: munich, language: "zh", script: "Hant", region: "TW", exp: "慕尼黑市"},
+		// No English fallback for Chinese, Japanese and Korean.
+		{number: berlin, language: "zh", exp: ""},
+		{number: berlin, language: "ja", exp: ""},
+	}
+	for i, test := range tests {
+		got := reader.GetDescriptionForNumber(
+			test.number, test.language, test.script, test.region)
+		if got != test.exp {
+			t.Errorf("[test %d] GetDescriptionForNumber(%v, %s, %s, %s) = %q, want %q",
+				i, test.number, test.language, test.script, test.region, got, test.exp)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+//go:build libphonenumber_lite
+
+package libphonenumber
+
+// Builds with the libphonenumber_lite tag leave the example numbers out
+// of the metadata as it is decoded, as upstream's lite build does.
+const liteMetadata = true
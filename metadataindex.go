@@ -0,0 +1,220 @@
+package libphonenumber
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the serialized PhoneMetadataCollection and
+// PhoneMetadata read when indexing metadata.
+const (
+	collectionMetadataField         protowire.Number = 1
+	metadataIdField                 protowire.Number = 9
+	metadataCountryCodeField        protowire.Number = 10
+	metadataMainCountryForCodeField protowire.Number = 22
+)
+
+// The metadata of a region, or of a non-geographical country calling
+// code, decoded from its serialized form on first use.
+type lazyPhoneMetadata struct {
+	data []byte
+	once sync.Once
+	meta *PhoneMetadata
+}
+
+// Returns the metadata, decoding it if this is the first call. Returns
+// nil if the metadata can't be decoded.
+func (l *lazyPhoneMetadata) get() *PhoneMetadata {
+	l.once.Do(func() {
+		meta := &PhoneMetadata{}
+		if err := proto.Unmarshal(l.data, meta); err == nil {
+			stripLiteMetadata(meta)
+			l.meta = meta
+		}
+		l.data = nil
+	})
+	return l.meta
+}
+
+// Drops the example numbers of meta in builds with the
+// libphonenumber_lite tag, so that they don't take up heap once the
+// metadata is decoded. Parsing, formatting and validation don't use them.
+func stripLiteMetadata(meta *PhoneMetadata) {
+	if !liteMetadata {
+		return
+	}
+	for _, field := range phoneNumberDescFields(meta) {
+		if *field != nil {
+			(*field).ExampleNumber = nil
+		}
+	}
+}
+
+// A metadataIndex locates the metadata of each region in a serialized
+// PhoneMetadataCollection. Building it only reads the id, country
+// calling code and main country flag of each region; the rest of the
+// metadata of a region is decoded the first time it is asked for, so
+// programs only pay for the regions they use. An index is never
+// modified once built, and may be shared by any number of registries.
+type metadataIndex struct {
+	// The metadata of each region, by region code.
+	regions map[string]*lazyPhoneMetadata
+
+	// The metadata of each non-geographical entity, by country calling
+	// code.
+	nonGeographical map[int]*lazyPhoneMetadata
+
+	// The mapping from country calling codes to the regions using them.
+	// The main country for a code is listed first, the other regions
+	// follow in the order of the collection.
+	countryCodeToRegion map[int][]string
+
+	// The provenance of the metadata. See MetadataVersion.
+	version MetadataVersionInfo
+
+	// The serialized metadata, kept to compute the content hash the
+	// first time it is asked for when it was not recorded.
+	unhashed []byte
+	hashOnce sync.Once
+}
+
+func newEmptyMetadataIndex() *metadataIndex {
+	return &metadataIndex{
+		regions:             make(map[string]*lazyPhoneMetadata),
+		nonGeographical:     make(map[int]*lazyPhoneMetadata),
+		countryCodeToRegion: make(map[int][]string),
+	}
+}
+
+// Indexes the serialized PhoneMetadataCollection in data, which may be
+// gzip compressed. The regions are not decoded.
+func newMetadataIndex(data []byte) (*metadataIndex, error) {
+	data, err := decompressMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	version, data, err := extractMetadataVersion(data)
+	if err != nil {
+		return nil, err
+	}
+	index := newEmptyMetadataIndex()
+	index.version = version
+	if len(version.ContentHash) == 0 {
+		index.unhashed = data
+	}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		if num != collectionMetadataField || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+		metadata, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		id, countryCode, mainCountryForCode, err := peekPhoneMetadata(metadata)
+		if err != nil {
+			return nil, err
+		}
+		index.add(id, countryCode, mainCountryForCode,
+			&lazyPhoneMetadata{data: metadata})
+	}
+	return index, nil
+}
+
+// Indexes the metadata of collection, which is already decoded.
+func newMetadataIndexFromCollection(collection *PhoneMetadataCollection) *metadataIndex {
+	index := newEmptyMetadataIndex()
+	for _, meta := range collection.GetMetadata() {
+		stripLiteMetadata(meta)
+		entry := &lazyPhoneMetadata{meta: meta}
+		entry.once.Do(func() {})
+		index.add(meta.GetId(), int(meta.GetCountryCode()),
+			meta.GetMainCountryForCode(), entry)
+	}
+	return index
+}
+
+// Adds the metadata of the region id to the index.
+func (index *metadataIndex) add(
+	id string,
+	countryCode int,
+	mainCountryForCode bool,
+	entry *lazyPhoneMetadata) {
+
+	if id == REGION_CODE_FOR_NON_GEO_ENTITY {
+		index.nonGeographical[countryCode] = entry
+	} else {
+		index.regions[id] = entry
+	}
+	if mainCountryForCode {
+		index.countryCodeToRegion[countryCode] = append(
+			[]string{id}, index.countryCodeToRegion[countryCode]...)
+	} else {
+		index.countryCodeToRegion[countryCode] = append(
+			index.countryCodeToRegion[countryCode], id)
+	}
+}
+
+// Returns the provenance of the metadata, computing its content hash if
+// it was not recorded.
+func (index *metadataIndex) getVersion() MetadataVersionInfo {
+	index.hashOnce.Do(func() {
+		if index.unhashed != nil {
+			index.version.ContentHash = metadataContentHash(index.unhashed)
+			index.unhashed = nil
+		}
+	})
+	return index.version
+}
+
+// Returns the number of regions and non-geographical entities indexed.
+func (index *metadataIndex) size() int {
+	return len(index.regions) + len(index.nonGeographical)
+}
+
+// Reads the id, country calling code and main country flag of the
+// serialized PhoneMetadata in data, skipping everything else.
+func peekPhoneMetadata(data []byte) (
+	id string, countryCode int, mainCountryForCode bool, err error) {
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", 0, false, protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch {
+		case num == metadataIdField && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(data)
+			id = string(v)
+		case num == metadataCountryCodeField && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(data)
+			countryCode = int(int32(v))
+		case num == metadataMainCountryForCodeField && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(data)
+			mainCountryForCode = protowire.DecodeBool(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return "", 0, false, protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return id, countryCode, mainCountryForCode, nil
+}
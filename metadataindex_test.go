@@ -0,0 +1,240 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"compress/gzip"
+	"runtime"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Reports whether the metadata has been decoded. Not safe for
+// concurrent use with get.
+func (l *lazyPhoneMetadata) decoded() bool {
+	return l.data == nil
+}
+
+func TestNewMetadataIndex(t *testing.T) {
+	index, err := newMetadataIndex(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.size() != len(collection.GetMetadata()) {
+		t.Fatalf("indexed %d regions, want %d",
+			index.size(), len(collection.GetMetadata()))
+	}
+	for _, want := range collection.GetMetadata() {
+		stripLiteMetadata(want)
+		entry := index.regions[want.GetId()]
+		if want.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+			entry = index.nonGeographical[int(want.GetCountryCode())]
+		}
+		if entry == nil {
+			t.Errorf("%s (%d) is not indexed", want.GetId(), want.GetCountryCode())
+			continue
+		}
+		if entry.decoded() {
+			t.Errorf("%s (%d) was decoded when indexing",
+				want.GetId(), want.GetCountryCode())
+		}
+		if got := entry.get(); !proto.Equal(got, want) {
+			t.Errorf("%s (%d) decodes to different metadata",
+				want.GetId(), want.GetCountryCode())
+		}
+	}
+	for countryCode, regions := range CountryCodeToRegion {
+		// The main country for a code is listed first. CountryCodeToRegion
+		// has a few codes the metadata has no regions for, such as 379.
+		got, ok := index.countryCodeToRegion[countryCode]
+		if ok && got[0] != regions[0] {
+			t.Errorf("regions for %d = %v, want %s first",
+				countryCode, got, regions[0])
+		}
+	}
+
+	if _, err := newMetadataIndex([]byte{0x0a, 0x05, 0x4a}); err == nil {
+		t.Error("indexed truncated metadata")
+	}
+}
+
+func TestMetadataDecodedOnFirstUse(t *testing.T) {
+	index, err := newMetadataIndex(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := newMetadataRegistry(index, CountryCodeToRegion, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	u.registry.Store(registry)
+
+	number, err := u.Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !u.IsValidNumber(number) {
+		t.Error("044 668 18 00 is not valid for CH")
+	}
+	for regionCode, entry := range index.regions {
+		if got, want := entry.decoded(), regionCode == "CH"; got != want {
+			t.Errorf("%s: decoded = %v, want %v", regionCode, got, want)
+		}
+	}
+}
+
+func TestLiteMetadataStripsExampleNumbers(t *testing.T) {
+	// The compiled-in metadata and metadata loaded at run time alike.
+	loaded, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	for name, index := range map[string]*metadataIndex{
+		"compiled-in": u.currentMetadataRegistry().index,
+		"loaded":      newMetadataIndexFromCollection(loaded),
+	} {
+		meta := index.regions["CH"].get()
+		if got := meta.GetFixedLine().ExampleNumber != nil; got == liteMetadata {
+			t.Errorf("%s: has example numbers = %v with liteMetadata = %v",
+				name, got, liteMetadata)
+		}
+		if meta.GetFixedLine().GetNationalNumberPattern() == "" {
+			t.Errorf("%s: lost the national number pattern", name)
+		}
+	}
+}
+
+func BenchmarkLoadMetadata(b *testing.B) {
+	b.Run("Eager", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			collection, err := unmarshalMetadataCollection(metaData)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_, err = newMetadataRegistry(
+				newMetadataIndexFromCollection(collection), CountryCodeToRegion, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Lazy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			index, err := newMetadataIndex(metaData)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_, err = newMetadataRegistry(index, CountryCodeToRegion, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// Measures the first parse of a number after start up, which decodes
+// the metadata of the region.
+func BenchmarkFirstParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		index, err := newMetadataIndex(metaData)
+		if err != nil {
+			b.Fatal(err)
+		}
+		registry, err := newMetadataRegistry(index, CountryCodeToRegion, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		u := NewPhoneNumberUtil()
+		u.registry.Store(registry)
+		if _, err := u.Parse("044 668 18 00", "CH"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Returns the heap taken by a PhoneNumberUtil over the serialized
+// metadata in data, as if it were compiled in, once the metadata of
+// every region has been decoded.
+func decodedMetadataHeap(b *testing.B, data []byte) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	index, err := newMetadataIndex(data)
+	if err != nil {
+		b.Fatal(err)
+	}
+	registry, err := newMetadataRegistry(index, CountryCodeToRegion, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	u.registry.Store(registry)
+	for regionCode := range u.GetSupportedRegions() {
+		u.getMetadataForRegion(regionCode)
+	}
+	for countryCode := range u.GetSupportedGlobalNetworkCallingCodes() {
+		u.getMetadataForNonGeographicalRegion(countryCode)
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(u)
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// Compares the compiled-in metadata with its lite variant, which leaves
+// the example numbers out, by the size of the serialized metadata, plain
+// and gzip compressed, and the heap it takes once decoded. Builds with
+// the libphonenumber_lite tag save the heap, metagen's -lite saves both.
+// The README quotes these numbers; run it without the libphonenumber_lite
+// tag, as both variants are otherwise decoded without example numbers:
+//
+//	go test -run '^$' -bench LiteMetadata .
+func BenchmarkLiteMetadata(b *testing.B) {
+	full, err := decompressMetadata(metaData)
+	if err != nil {
+		b.Fatal(err)
+	}
+	collection, err := unmarshalMetadataCollection(full)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, meta := range collection.GetMetadata() {
+		for _, desc := range phoneNumberDescFields(meta) {
+			if *desc != nil {
+				(*desc).ExampleNumber = nil
+			}
+		}
+	}
+	lite, err := MarshalMetadata(collection, "", "")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, variant := range []struct {
+		name string
+		data []byte
+	}{
+		{"Full", full},
+		{"Lite", lite},
+	} {
+		b.Run(variant.name, func(b *testing.B) {
+			var compressed bytes.Buffer
+			w := gzip.NewWriter(&compressed)
+			w.Write(variant.data)
+			w.Close()
+			var heap uint64
+			for i := 0; i < b.N; i++ {
+				heap = decodedMetadataHeap(b, variant.data)
+			}
+			b.ReportMetric(float64(len(variant.data)), "bytes")
+			b.ReportMetric(float64(compressed.Len()), "gzip-bytes")
+			b.ReportMetric(float64(heap), "heap-bytes")
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package libphonenumber
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+// ErrInvalidMetadata is returned, wrapped with the details of the
+// problem, by LoadMetadata when the metadata supplied is unusable.
+var ErrInvalidMetadata = errors.New("invalid metadata")
+
+// Replaces the metadata used by u with the serialized
+// PhoneMetadataCollection read from r, in the format of the metaData
+// blob compiled into the library. This allows numbering plan changes to
+// be picked up without a new release of the library or a restart of
+// the process.
+//
+// The metadata is validated before it is used: every region must have
+// an id and a country calling code, no region may appear twice and all
+// of the patterns must compile. The mapping from country calling codes
+// to regions is derived from the metadata, listing the main country for
+// each code first. If the metadata is invalid, an error is returned and
+// the metadata in use is left as it was; otherwise all subsequent calls
+// to Parse, Format, IsValidNumber and the like on u use the new
+// metadata; other PhoneNumberUtils are unaffected.
+// Calls already in progress finish with the metadata they started with.
+// Overrides set with SetRegionMetadataOverride stay in place and are
+// layered over the new metadata. The provenance recorded in the
+// metadata by MarshalMetadata, if any, is reported by MetadataVersion;
+// metadata whose content doesn't match its recorded hash is rejected.
+func (u *PhoneNumberUtil) LoadMetadata(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if data, err = decompressMetadata(data); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	version, data, err := extractMetadataVersion(data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	contentHash := metadataContentHash(data)
+	if len(version.ContentHash) > 0 && version.ContentHash != contentHash {
+		return fmt.Errorf("%w: content hash is %s, but %s was recorded",
+			ErrInvalidMetadata, contentHash, version.ContentHash)
+	}
+	version.ContentHash = contentHash
+	metadataCollection, err := unmarshalMetadataCollection(data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	if err := validateMetadataCollection(metadataCollection); err != nil {
+		return err
+	}
+	return u.updateMetadataRegistry(
+		func(current *MetadataRegistry) (*MetadataRegistry, error) {
+			index := newMetadataIndexFromCollection(metadataCollection)
+			index.version = version
+			return newMetadataRegistry(
+				index, index.countryCodeToRegion, current.getOverrides())
+		})
+}
+
+// As LoadMetadata, but reads the serialized PhoneMetadataCollection
+// from the file at path.
+func (u *PhoneNumberUtil) LoadMetadataFromFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return u.LoadMetadata(f)
+}
+
+// Checks that the metadata in collection can safely replace the
+// metadata in use.
+func validateMetadataCollection(collection *PhoneMetadataCollection) error {
+	metadataList := collection.GetMetadata()
+	if len(metadataList) == 0 {
+		return ErrEmptyMetadata
+	}
+	seenRegions := make(map[string]bool)
+	seenNonGeoCodes := make(map[int32]bool)
+	for _, meta := range metadataList {
+		region := meta.GetId()
+		if len(region) == 0 {
+			return fmt.Errorf("%w: metadata with no id", ErrInvalidMetadata)
+		}
+		if meta.GetCountryCode() <= 0 {
+			return fmt.Errorf("%w: region %s has no country calling code",
+				ErrInvalidMetadata, region)
+		}
+		if region == REGION_CODE_FOR_NON_GEO_ENTITY {
+			if seenNonGeoCodes[meta.GetCountryCode()] {
+				return fmt.Errorf("%w: country calling code %d is listed twice",
+					ErrInvalidMetadata, meta.GetCountryCode())
+			}
+			seenNonGeoCodes[meta.GetCountryCode()] = true
+		} else {
+			if seenRegions[region] {
+				return fmt.Errorf("%w: region %s is listed twice",
+					ErrInvalidMetadata, region)
+			}
+			seenRegions[region] = true
+		}
+		if meta.GetGeneralDesc() == nil {
+			return fmt.Errorf("%w: region %s has no general description",
+				ErrInvalidMetadata, region)
+		}
+		if err := validateMetadataPatterns(meta); err != nil {
+			return fmt.Errorf("%w: region %s: %v", ErrInvalidMetadata, region, err)
+		}
+	}
+	return nil
+}
+
+// Checks that all the regular expressions in meta compile.
+func validateMetadataPatterns(meta *PhoneMetadata) error {
+	var patterns = []string{
+		meta.GetInternationalPrefix(),
+		meta.GetNationalPrefixForParsing(),
+		meta.GetLeadingDigits(),
+	}
+	for _, desc := range []*PhoneNumberDesc{
+		meta.GetGeneralDesc(),
+		meta.GetFixedLine(),
+		meta.GetMobile(),
+		meta.GetTollFree(),
+		meta.GetPremiumRate(),
+		meta.GetSharedCost(),
+		meta.GetPersonalNumber(),
+		meta.GetVoip(),
+		meta.GetPager(),
+		meta.GetUan(),
+		meta.GetEmergency(),
+		meta.GetVoicemail(),
+		meta.GetShortCode(),
+		meta.GetStandardRate(),
+		meta.GetCarrierSpecific(),
+		meta.GetSmsServices(),
+		meta.GetNoInternationalDialling(),
+	} {
+		patterns = append(patterns, desc.GetNationalNumberPattern())
+	}
+	for _, formats := range [][]*NumberFormat{
+		meta.GetNumberFormat(),
+		meta.GetIntlNumberFormat(),
+	} {
+		for _, format := range formats {
+			patterns = append(patterns, format.GetPattern())
+			patterns = append(patterns, format.GetLeadingDigitsPattern()...)
+		}
+	}
+	for _, pattern := range patterns {
+		if len(pattern) == 0 {
+			continue
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
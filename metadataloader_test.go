@@ -0,0 +1,180 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Returns the compiled-in metadata collection, with modify applied to
+// the metadata of each region, serialized again with the content hash
+// of the modified metadata.
+func modifiedMetadata(t *testing.T, modify func(meta *PhoneMetadata)) []byte {
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, meta := range collection.GetMetadata() {
+		modify(meta)
+	}
+	data, err := MarshalMetadata(collection, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestLoadMetadata(t *testing.T) {
+	u := NewPhoneNumberUtil()
+	number, err := u.Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !u.IsValidNumber(number) {
+		t.Fatal("044 668 18 00 is not valid for CH before reloading")
+	}
+
+	// Narrow the numbering plan of Switzerland.
+	data := modifiedMetadata(t, func(meta *PhoneMetadata) {
+		if meta.GetId() == "CH" {
+			meta.GeneralDesc.NationalNumberPattern = proto.String("9\\d{8}")
+		}
+	})
+	if err := u.LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if u.IsValidNumber(number) {
+		t.Error("044 668 18 00 is valid for CH after reloading")
+	}
+	if got := u.GetRegionCodeForCountryCode(41); got != "CH" {
+		t.Errorf("GetRegionCodeForCountryCode(41) = %s, want CH", got)
+	}
+	if got := u.GetRegionCodeForCountryCode(1); got != "US" {
+		t.Errorf("GetRegionCodeForCountryCode(1) = %s, want US", got)
+	}
+	if _, ok := u.GetSupportedRegions()["GB"]; !ok {
+		t.Error("GB is not supported after reloading")
+	}
+	// Other PhoneNumberUtils keep their metadata.
+	if !IsValidNumber(number) {
+		t.Error("044 668 18 00 is not valid for CH with the default PhoneNumberUtil")
+	}
+}
+
+func TestLoadMetadataFromFile(t *testing.T) {
+	data := modifiedMetadata(t, func(meta *PhoneMetadata) {
+		if meta.GetId() == "CH" {
+			meta.GeneralDesc.NationalNumberPattern = proto.String("9\\d{8}")
+		}
+	})
+	path := filepath.Join(t.TempDir(), "PhoneNumberMetadata.bin")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	if err := u.LoadMetadataFromFile(path); err != nil {
+		t.Fatal(err)
+	}
+	number, _ := u.Parse("044 668 18 00", "CH")
+	if u.IsValidNumber(number) {
+		t.Error("044 668 18 00 is valid for CH after reloading")
+	}
+
+	err := u.LoadMetadataFromFile(filepath.Join(t.TempDir(), "missing.bin"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadMetadataFromFile(missing) = %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestLoadMetadataRejectsInvalidMetadata(t *testing.T) {
+	var tests = []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{
+			name: "empty",
+			data: nil,
+			err:  ErrEmptyMetadata,
+		}, {
+			name: "garbage",
+			data: []byte("not a metadata collection"),
+			err:  ErrInvalidMetadata,
+		}, {
+			name: "bad pattern",
+			data: modifiedMetadata(t, func(meta *PhoneMetadata) {
+				if meta.GetId() == "CH" {
+					meta.Mobile.NationalNumberPattern = proto.String("7[5-9\\d{7}")
+				}
+			}),
+			err: ErrInvalidMetadata,
+		}, {
+			name: "duplicate region",
+			data: modifiedMetadata(t, func(meta *PhoneMetadata) {
+				if meta.GetId() == "CH" {
+					meta.Id = proto.String("DE")
+				}
+			}),
+			err: ErrInvalidMetadata,
+		}, {
+			name: "missing country code",
+			data: modifiedMetadata(t, func(meta *PhoneMetadata) {
+				if meta.GetId() == "CH" {
+					meta.CountryCode = nil
+				}
+			}),
+			err: ErrInvalidMetadata,
+		},
+	}
+
+	u := NewPhoneNumberUtil()
+	before := u.currentMetadataRegistry()
+	for _, test := range tests {
+		err := u.LoadMetadata(bytes.NewReader(test.data))
+		if !errors.Is(err, test.err) {
+			t.Errorf("[%s] LoadMetadata() = %v, want %v", test.name, err, test.err)
+		}
+		if u.currentMetadataRegistry() != before {
+			t.Errorf("[%s] the metadata was replaced", test.name)
+		}
+	}
+}
+
+func TestLoadLiteMetadata(t *testing.T) {
+	data := modifiedMetadata(t, func(meta *PhoneMetadata) {
+		for _, desc := range phoneNumberDescFields(meta) {
+			if *desc != nil {
+				(*desc).ExampleNumber = nil
+			}
+		}
+	})
+	u := NewPhoneNumberUtil()
+	if err := u.LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if number := u.GetExampleNumber("CH"); number != nil {
+		t.Errorf("GetExampleNumber(CH) = %v, want nil", number)
+	}
+	if number := u.GetExampleNumberForType("CH", MOBILE); number != nil {
+		t.Errorf("GetExampleNumberForType(CH, MOBILE) = %v, want nil", number)
+	}
+	if number := u.GetExampleNumberForNonGeoEntity(800); number != nil {
+		t.Errorf("GetExampleNumberForNonGeoEntity(800) = %v, want nil", number)
+	}
+
+	// Lite metadata parses, formats and validates as before.
+	number, err := u.Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !u.IsValidNumber(number) {
+		t.Error("044 668 18 00 is not valid for CH with lite metadata")
+	}
+	if got := u.Format(number, INTERNATIONAL); got != "+41 44 668 18 00" {
+		t.Errorf("Format() = %q, want %q", got, "+41 44 668 18 00")
+	}
+}
@@ -0,0 +1,258 @@
+package libphonenumber
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Layers override over the metadata of the region override.GetId(), or
+// adds a custom region if the library has no metadata for it. This lets
+// private numbering plans, such as internal extensions or test ranges,
+// be handled like any other region. The override takes effect for all
+// subsequent calls on u, and stays in place when metadata is loaded
+// with LoadMetadata.
+//
+// For an existing region, the fields set in the override replace those
+// of the region:
+//   - each PhoneNumberDesc set, such as FixedLine, replaces the region's
+//     description of that type as a whole; remember to widen GeneralDesc
+//     too when adding numbers
+//   - scalar fields, such as NationalPrefix, NationalPrefixForParsing
+//     or InternationalPrefix, replace the region's values
+//   - NumberFormat and IntlNumberFormat are tried before the region's
+//     own formats. If the region has international formats and the
+//     override has none, its national formats are used for both.
+//
+// The country calling code may be left unset, but must not differ from
+// the region's.
+//
+// For a custom region, the override is the complete metadata of the
+// region, and must have a country calling code and a general
+// description. The region is added to the supported regions and to
+// the regions of its country calling code: first if MainCountryForCode
+// is set, which makes it the region GetRegionCodeForCountryCode returns
+// for the code, last otherwise.
+//
+// Setting an override for a region replaces any previous override for
+// it. The override must not be modified after the call.
+func (u *PhoneNumberUtil) SetRegionMetadataOverride(override *PhoneMetadata) error {
+	regionCode := override.GetId()
+	if len(regionCode) == 0 || regionCode == UNKNOWN_REGION ||
+		regionCode == REGION_CODE_FOR_NON_GEO_ENTITY {
+		return fmt.Errorf("%w: override for invalid region %q",
+			ErrInvalidMetadata, regionCode)
+	}
+	if err := validateMetadataPatterns(override); err != nil {
+		return fmt.Errorf("%w: region %s: %v", ErrInvalidMetadata, regionCode, err)
+	}
+	return u.updateMetadataRegistry(
+		func(current *MetadataRegistry) (*MetadataRegistry, error) {
+			overrides := make(map[string]*PhoneMetadata)
+			for region, meta := range current.overrides {
+				overrides[region] = meta
+			}
+			overrides[regionCode] = override
+			return newMetadataRegistry(
+				current.index, current.baseCountryCodeToRegion, overrides)
+		})
+}
+
+// Removes the override for the region, if any, restoring its metadata,
+// or removing it if it is a custom region. If the metadata can't be
+// rebuilt without the override, an error is returned and the metadata
+// is left as it was.
+func (u *PhoneNumberUtil) RemoveRegionMetadataOverride(regionCode string) error {
+	return u.updateMetadataRegistry(
+		func(current *MetadataRegistry) (*MetadataRegistry, error) {
+			overrides := make(map[string]*PhoneMetadata)
+			for region, meta := range current.overrides {
+				if region != regionCode {
+					overrides[region] = meta
+				}
+			}
+			return newMetadataRegistry(
+				current.index, current.baseCountryCodeToRegion, overrides)
+		})
+}
+
+// Removes all overrides and custom regions. If the metadata can't be
+// rebuilt without them, an error is returned and the metadata is left
+// as it was.
+func (u *PhoneNumberUtil) ClearRegionMetadataOverrides() error {
+	return u.updateMetadataRegistry(
+		func(current *MetadataRegistry) (*MetadataRegistry, error) {
+			return newMetadataRegistry(
+				current.index, current.baseCountryCodeToRegion, nil)
+		})
+}
+
+// Returns the mapping from country calling codes to the region codes in
+// use by u, including custom regions, with the main region of each code
+// first. It reflects metadata loaded with LoadMetadata and regions
+// added with SetRegionMetadataOverride. The returned map is a copy and
+// may be modified by the caller.
+func (u *PhoneNumberUtil) GetCountryCodeToRegionMap() map[int][]string {
+	countryCodeToRegion := u.currentMetadataRegistry().countryCodeToRegion
+	mapping := make(map[int][]string, len(countryCodeToRegion))
+	for countryCode, regions := range countryCodeToRegion {
+		mapping[countryCode] = append([]string(nil), regions...)
+	}
+	return mapping
+}
+
+// Returns pointers to the PhoneNumberDesc fields of meta.
+func phoneNumberDescFields(meta *PhoneMetadata) []**PhoneNumberDesc {
+	return []**PhoneNumberDesc{
+		&meta.GeneralDesc,
+		&meta.FixedLine,
+		&meta.Mobile,
+		&meta.TollFree,
+		&meta.PremiumRate,
+		&meta.SharedCost,
+		&meta.PersonalNumber,
+		&meta.Voip,
+		&meta.Pager,
+		&meta.Uan,
+		&meta.Emergency,
+		&meta.Voicemail,
+		&meta.ShortCode,
+		&meta.StandardRate,
+		&meta.CarrierSpecific,
+		&meta.SmsServices,
+		&meta.NoInternationalDialling,
+	}
+}
+
+// Returns a copy of the metadata of a region with override layered over
+// it, as described in SetRegionMetadataOverride.
+func mergeRegionMetadata(base, override *PhoneMetadata) *PhoneMetadata {
+	merged := proto.Clone(base).(*PhoneMetadata)
+	scalars := proto.Clone(override).(*PhoneMetadata)
+
+	overrideDescs := phoneNumberDescFields(scalars)
+	for i, field := range phoneNumberDescFields(merged) {
+		if desc := *overrideDescs[i]; desc != nil {
+			*field = desc
+			*overrideDescs[i] = nil
+		}
+	}
+
+	numberFormats := scalars.NumberFormat
+	intlNumberFormats := scalars.IntlNumberFormat
+	scalars.NumberFormat = nil
+	scalars.IntlNumberFormat = nil
+	if len(intlNumberFormats) == 0 && len(merged.IntlNumberFormat) > 0 {
+		// The region only falls back to its national formats when it
+		// has no international ones, so they must be added to both.
+		for _, format := range numberFormats {
+			intlNumberFormats = append(intlNumberFormats,
+				proto.Clone(format).(*NumberFormat))
+		}
+	}
+	if len(numberFormats) > 0 {
+		merged.NumberFormat = append(numberFormats, merged.NumberFormat...)
+	}
+	if len(intlNumberFormats) > 0 {
+		merged.IntlNumberFormat = append(intlNumberFormats, merged.IntlNumberFormat...)
+	}
+
+	// What is left are the scalar fields, which replace the region's
+	// when set.
+	proto.Merge(merged, scalars)
+	return merged
+}
+
+// Returns a copy of the metadata of a custom region in which the types
+// of numbers left out are described as having no numbers, as they are
+// in generated metadata.
+func completeCustomRegionMetadata(override *PhoneMetadata) *PhoneMetadata {
+	meta := proto.Clone(override).(*PhoneMetadata)
+	for _, field := range []**PhoneNumberDesc{
+		&meta.FixedLine,
+		&meta.Mobile,
+		&meta.TollFree,
+		&meta.PremiumRate,
+		&meta.SharedCost,
+		&meta.PersonalNumber,
+		&meta.Voip,
+		&meta.Pager,
+		&meta.Uan,
+		&meta.Voicemail,
+		&meta.NoInternationalDialling,
+	} {
+		if *field == nil {
+			// -1 never matches the length of a number.
+			*field = &PhoneNumberDesc{PossibleLength: []int32{-1}}
+		}
+	}
+	return meta
+}
+
+// Layers the overrides of the registry over its region metadata, adding
+// custom regions to its country calling code mapping.
+func (registry *MetadataRegistry) applyOverrides() error {
+	if len(registry.overrides) == 0 {
+		return nil
+	}
+	registry.overriddenMetadata = make(map[string]*PhoneMetadata)
+	// Apply the overrides in a fixed order, so that custom regions
+	// sharing a country calling code are always listed in the same
+	// order.
+	regionCodes := make([]string, 0, len(registry.overrides))
+	for regionCode := range registry.overrides {
+		regionCodes = append(regionCodes, regionCode)
+	}
+	sort.Strings(regionCodes)
+
+	countryCodeToRegion := registry.countryCodeToRegion
+	copied := false
+	for _, regionCode := range regionCodes {
+		override := registry.overrides[regionCode]
+		if base, ok := registry.getMetadataForRegion(regionCode); ok {
+			if override.CountryCode != nil &&
+				override.GetCountryCode() != base.GetCountryCode() {
+				return fmt.Errorf(
+					"%w: override changes the country calling code of %s from %d to %d",
+					ErrInvalidMetadata, regionCode,
+					base.GetCountryCode(), override.GetCountryCode())
+			}
+			registry.overriddenMetadata[regionCode] = mergeRegionMetadata(base, override)
+			continue
+		}
+
+		// A custom region.
+		countryCode := int(override.GetCountryCode())
+		if countryCode <= 0 {
+			return fmt.Errorf("%w: custom region %s has no country calling code",
+				ErrInvalidMetadata, regionCode)
+		}
+		if override.GetGeneralDesc() == nil {
+			return fmt.Errorf("%w: custom region %s has no general description",
+				ErrInvalidMetadata, regionCode)
+		}
+		if _, ok := registry.index.nonGeographical[countryCode]; ok {
+			return fmt.Errorf(
+				"%w: custom region %s uses the non-geographical country calling code %d",
+				ErrInvalidMetadata, regionCode, countryCode)
+		}
+		if !copied {
+			countryCodeToRegion = make(map[int][]string)
+			for cc, regions := range registry.countryCodeToRegion {
+				countryCodeToRegion[cc] = regions
+			}
+			copied = true
+		}
+		regions := countryCodeToRegion[countryCode]
+		if override.GetMainCountryForCode() {
+			regions = append([]string{regionCode}, regions...)
+		} else {
+			regions = append(regions[:len(regions):len(regions)], regionCode)
+		}
+		countryCodeToRegion[countryCode] = regions
+		registry.overriddenMetadata[regionCode] = completeCustomRegionMetadata(override)
+	}
+	registry.countryCodeToRegion = countryCodeToRegion
+	return nil
+}
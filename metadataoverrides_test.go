@@ -0,0 +1,373 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Swiss internal extensions: five digits starting with 1.
+var chExtensionsOverride = &PhoneMetadata{
+	Id: proto.String("CH"),
+	GeneralDesc: &PhoneNumberDesc{
+		NationalNumberPattern: proto.String("1\\d{4}|8\\d{11}|[2-9]\\d{8}"),
+		PossibleLength:        []int32{5, 9, 12},
+	},
+	FixedLine: &PhoneNumberDesc{
+		NationalNumberPattern: proto.String("1\\d{4}"),
+		PossibleLength:        []int32{5},
+	},
+	NumberFormat: []*NumberFormat{{
+		Pattern:              proto.String("(\\d{2})(\\d{3})"),
+		Format:               proto.String("$1 $2"),
+		LeadingDigitsPattern: []string{"1"},
+	}},
+}
+
+func TestSetRegionMetadataOverride(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	extension, err := u.Parse("12345", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mobile, err := u.Parse("078 123 45 67", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.IsValidNumber(extension) {
+		t.Fatal("12345 is valid for CH without the override")
+	}
+
+	if err := u.SetRegionMetadataOverride(chExtensionsOverride); err != nil {
+		t.Fatal(err)
+	}
+	if !u.IsValidNumber(extension) {
+		t.Error("12345 is not valid for CH with the override")
+	}
+	// Other PhoneNumberUtils are left alone.
+	if IsValidNumber(extension) {
+		t.Error("12345 is valid for CH with the default PhoneNumberUtil")
+	}
+	if got := u.GetNumberType(extension); got != FIXED_LINE {
+		t.Errorf("GetNumberType(12345) = %v, want FIXED_LINE", got)
+	}
+	if got := u.Format(extension, NATIONAL); got != "12 345" {
+		t.Errorf("Format(12345, NATIONAL) = %q, want \"12 345\"", got)
+	}
+	// The parts of CH that were not overridden are kept.
+	if got := u.GetNumberType(mobile); got != MOBILE {
+		t.Errorf("GetNumberType(078 123 45 67) = %v, want MOBILE", got)
+	}
+	if got := u.Format(mobile, INTERNATIONAL); got != "+41 78 123 45 67" {
+		t.Errorf("Format(078 123 45 67, INTERNATIONAL) = %q", got)
+	}
+	if got := u.getMetadataForRegion("CH").GetNationalPrefix(); got != "0" {
+		t.Errorf("CH national prefix = %q, want 0", got)
+	}
+
+	// Overrides are layered over reloaded metadata.
+	if err := u.LoadMetadata(bytes.NewReader(metaData)); err != nil {
+		t.Fatal(err)
+	}
+	if !u.IsValidNumber(extension) {
+		t.Error("12345 is not valid for CH after reloading the metadata")
+	}
+
+	if err := u.RemoveRegionMetadataOverride("CH"); err != nil {
+		t.Fatal(err)
+	}
+	if u.IsValidNumber(extension) {
+		t.Error("12345 is valid for CH after removing the override")
+	}
+}
+
+func TestSetRegionMetadataOverrideNationalPrefix(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	err := u.SetRegionMetadataOverride(&PhoneMetadata{
+		Id:                       proto.String("CH"),
+		NationalPrefix:           proto.String("9"),
+		NationalPrefixForParsing: proto.String("9"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	number, err := u.Parse("978 123 45 67", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := GetNationalSignificantNumber(number); got != "781234567" {
+		t.Errorf("national significant number = %s, want 781234567", got)
+	}
+}
+
+func TestCustomRegion(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	// A test range on an unassigned country calling code.
+	err := u.SetRegionMetadataOverride(&PhoneMetadata{
+		Id:                  proto.String("XT"),
+		CountryCode:         proto.Int32(999),
+		InternationalPrefix: proto.String("00"),
+		GeneralDesc: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("5\\d{7}"),
+			PossibleLength:        []int32{8},
+		},
+		FixedLine: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("5\\d{7}"),
+		},
+		Mobile: &PhoneNumberDesc{
+			PossibleLength: []int32{-1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := u.GetSupportedRegions()["XT"]; !ok {
+		t.Error("XT is not a supported region")
+	}
+	if got := u.GetCountryCodeToRegionMap()[999]; !reflect.DeepEqual(got, []string{"XT"}) {
+		t.Errorf("GetCountryCodeToRegionMap()[999] = %v, want [XT]", got)
+	}
+	if got := u.GetCountryCodeForRegion("XT"); got != 999 {
+		t.Errorf("GetCountryCodeForRegion(XT) = %d, want 999", got)
+	}
+	number, err := u.Parse("+999 5123 4567", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodeForNumber(number); got != "XT" {
+		t.Errorf("GetRegionCodeForNumber(+999 5123 4567) = %q, want XT", got)
+	}
+	if !u.IsValidNumber(number) {
+		t.Error("+999 5123 4567 is not valid")
+	}
+	types := u.GetSupportedTypesForRegion("XT")
+	if _, ok := types[FIXED_LINE]; !ok || len(types) != 1 {
+		t.Errorf("GetSupportedTypesForRegion(XT) = %v, want FIXED_LINE only", types)
+	}
+	// The mapping returned is a copy.
+	delete(u.GetCountryCodeToRegionMap(), 999)
+	if _, ok := u.GetCountryCodeToRegionMap()[999]; !ok {
+		t.Error("deleting from GetCountryCodeToRegionMap() removed XT")
+	}
+
+	if err := u.RemoveRegionMetadataOverride("XT"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := u.GetSupportedRegions()["XT"]; ok {
+		t.Error("XT is still supported after removing it")
+	}
+	if _, ok := u.GetCountryCodeToRegionMap()[999]; ok {
+		t.Error("999 is still mapped after removing XT")
+	}
+}
+
+func TestCustomRegionSharingCountryCode(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	err := u.SetRegionMetadataOverride(&PhoneMetadata{
+		Id:          proto.String("XC"),
+		CountryCode: proto.Int32(41),
+		GeneralDesc: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+			PossibleLength:        []int32{5},
+		},
+		FixedLine: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodesForCountryCode(41); !reflect.DeepEqual(got, []string{"CH", "XC"}) {
+		t.Errorf("GetRegionCodesForCountryCode(41) = %v, want [CH XC]", got)
+	}
+	if got := u.GetCountryCodeToRegionMap()[41]; !reflect.DeepEqual(got, []string{"CH", "XC"}) {
+		t.Errorf("GetCountryCodeToRegionMap()[41] = %v, want [CH XC]", got)
+	}
+	number, err := u.Parse("+41 12345", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodeForNumber(number); got != "XC" {
+		t.Errorf("GetRegionCodeForNumber(+41 12345) = %q, want XC", got)
+	}
+
+	if err := u.ClearRegionMetadataOverrides(); err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodesForCountryCode(41); !reflect.DeepEqual(got, []string{"CH"}) {
+		t.Errorf("GetRegionCodesForCountryCode(41) = %v after clearing, want [CH]", got)
+	}
+}
+
+func TestCustomRegionAsMainCountryForCode(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	// A custom region that is the main country of an existing country
+	// calling code takes it over: it is listed first and is the region
+	// of the code, though numbers valid in Switzerland stay Swiss.
+	err := u.SetRegionMetadataOverride(&PhoneMetadata{
+		Id:                 proto.String("XM"),
+		CountryCode:        proto.Int32(41),
+		MainCountryForCode: proto.Bool(true),
+		GeneralDesc: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+			PossibleLength:        []int32{5},
+		},
+		FixedLine: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodesForCountryCode(41); !reflect.DeepEqual(got, []string{"XM", "CH"}) {
+		t.Errorf("GetRegionCodesForCountryCode(41) = %v, want [XM CH]", got)
+	}
+	if got := u.GetRegionCodeForCountryCode(41); got != "XM" {
+		t.Errorf("GetRegionCodeForCountryCode(41) = %s, want XM", got)
+	}
+	number, err := u.Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodeForNumber(number); got != "CH" {
+		t.Errorf("GetRegionCodeForNumber(044 668 18 00) = %q, want CH", got)
+	}
+	number, err = u.Parse("+41 12345", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodeForNumber(number); got != "XM" {
+		t.Errorf("GetRegionCodeForNumber(+41 12345) = %q, want XM", got)
+	}
+
+	if err := u.RemoveRegionMetadataOverride("XM"); err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetRegionCodeForCountryCode(41); got != "CH" {
+		t.Errorf("GetRegionCodeForCountryCode(41) = %s after removing XM, want CH", got)
+	}
+}
+
+func TestCountryCodeToRegionFollowsDefaultPhoneNumberUtil(t *testing.T) {
+	defer func() {
+		if err := defaultPhoneNumberUtil.loadDefaultMetadata(); err != nil {
+			t.Error(err)
+		}
+		if err := ClearRegionMetadataOverrides(); err != nil {
+			t.Error(err)
+		}
+		if !reflect.DeepEqual(CountryCodeToRegion, builtinMetadataRegistry.countryCodeToRegion) {
+			t.Error("CountryCodeToRegion was not restored")
+		}
+	}()
+	custom := &PhoneMetadata{
+		Id:          proto.String("XC"),
+		CountryCode: proto.Int32(41),
+		GeneralDesc: &PhoneNumberDesc{
+			NationalNumberPattern: proto.String("1\\d{4}"),
+			PossibleLength:        []int32{5},
+		},
+	}
+
+	// Other PhoneNumberUtils leave it alone.
+	if err := NewPhoneNumberUtil().SetRegionMetadataOverride(custom); err != nil {
+		t.Fatal(err)
+	}
+	if got := CountryCodeToRegion[41]; !reflect.DeepEqual(got, []string{"CH"}) {
+		t.Errorf("CountryCodeToRegion[41] = %v, want [CH]", got)
+	}
+
+	if err := SetRegionMetadataOverride(custom); err != nil {
+		t.Fatal(err)
+	}
+	if got := CountryCodeToRegion[41]; !reflect.DeepEqual(got, []string{"CH", "XC"}) {
+		t.Errorf("CountryCodeToRegion[41] = %v, want [CH XC]", got)
+	}
+
+	// Metadata without Liechtenstein.
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metadata []*PhoneMetadata
+	for _, meta := range collection.GetMetadata() {
+		if meta.GetId() != "LI" {
+			metadata = append(metadata, meta)
+		}
+	}
+	collection.Metadata = metadata
+	data, err := MarshalMetadata(collection, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := CountryCodeToRegion[423]; ok {
+		t.Errorf("CountryCodeToRegion[423] = %v after loading metadata without LI", got)
+	}
+	if got := CountryCodeToRegion[41]; !reflect.DeepEqual(got, []string{"CH", "XC"}) {
+		t.Errorf("CountryCodeToRegion[41] = %v after loading metadata, want [CH XC]", got)
+	}
+
+	if err := RemoveRegionMetadataOverride("XC"); err != nil {
+		t.Fatal(err)
+	}
+	if got := CountryCodeToRegion[41]; !reflect.DeepEqual(got, []string{"CH"}) {
+		t.Errorf("CountryCodeToRegion[41] = %v after removing XC, want [CH]", got)
+	}
+}
+
+func TestSetRegionMetadataOverrideErrors(t *testing.T) {
+	u := NewPhoneNumberUtil()
+
+	var tests = []struct {
+		name     string
+		override *PhoneMetadata
+	}{
+		{"no id", &PhoneMetadata{}},
+		{"non-geo entity", &PhoneMetadata{Id: proto.String("001")}},
+		{
+			"invalid pattern",
+			&PhoneMetadata{
+				Id:        proto.String("CH"),
+				FixedLine: &PhoneNumberDesc{NationalNumberPattern: proto.String("(1\\d{4}")},
+			},
+		}, {
+			"changed country code",
+			&PhoneMetadata{Id: proto.String("CH"), CountryCode: proto.Int32(49)},
+		}, {
+			"custom region without country code",
+			&PhoneMetadata{Id: proto.String("XT"), GeneralDesc: &PhoneNumberDesc{}},
+		}, {
+			"custom region without general desc",
+			&PhoneMetadata{Id: proto.String("XT"), CountryCode: proto.Int32(999)},
+		}, {
+			"custom region on a non-geographical code",
+			&PhoneMetadata{
+				Id:          proto.String("XT"),
+				CountryCode: proto.Int32(800),
+				GeneralDesc: &PhoneNumberDesc{},
+			},
+		},
+	}
+	before := u.currentMetadataRegistry()
+	for _, test := range tests {
+		err := u.SetRegionMetadataOverride(test.override)
+		if !errors.Is(err, ErrInvalidMetadata) {
+			t.Errorf("[%s] SetRegionMetadataOverride() = %v, want %v",
+				test.name, err, ErrInvalidMetadata)
+		}
+	}
+	if u.currentMetadataRegistry() != before {
+		t.Error("the metadata was replaced")
+	}
+}
@@ -0,0 +1,208 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A MetadataRegistry is an immutable snapshot of the metadata the
+// library works from: the per-region metadata and the lookup tables
+// derived from it. A registry is never modified once it has been
+// published; replacing the metadata means building a new registry and
+// publishing it in place of the old one, so readers always see a
+// consistent set of tables without taking locks.
+type MetadataRegistry struct {
+	// The index of the metadata the registry was built from.
+	index *metadataIndex
+
+	// The country calling code to region code mapping the registry was
+	// built from, before any custom regions were added.
+	baseCountryCodeToRegion map[int][]string
+
+	// The overrides layered over the metadata in index, by region code.
+	// See SetRegionMetadataOverride.
+	overrides map[string]*PhoneMetadata
+
+	// The metadata of the regions with overrides, with the overrides
+	// applied, and of the custom regions. Other regions are looked up in
+	// index.
+	overriddenMetadata map[string]*PhoneMetadata
+
+	// A mapping from a country calling code to the region codes which
+	// denote the region represented by that country calling code,
+	// including custom regions.
+	countryCodeToRegion map[int][]string
+
+	// The set of regions the library supports.
+	supportedRegions map[string]struct{}
+
+	// The set of county calling codes that map to the non-geo entity
+	// region ("001").
+	countryCodesForNonGeographicalRegion map[int]struct{}
+
+	// The set of regions that share country calling code 1.
+	nanpaRegions map[string]struct{}
+}
+
+// Returns the registry currently in use by u.
+func (u *PhoneNumberUtil) currentMetadataRegistry() *MetadataRegistry {
+	return u.registry.Load()
+}
+
+// Builds a new registry from the current one of u with update and,
+// unless update fails, makes it the one in use by all subsequent calls
+// on u.
+func (u *PhoneNumberUtil) updateMetadataRegistry(
+	update func(current *MetadataRegistry) (*MetadataRegistry, error)) error {
+
+	u.registryUpdateMutex.Lock()
+	defer u.registryUpdateMutex.Unlock()
+	registry, err := update(u.registry.Load())
+	if err != nil {
+		return err
+	}
+	u.registry.Store(registry)
+	if u == defaultPhoneNumberUtil {
+		syncCountryCodeToRegion(registry.countryCodeToRegion)
+	}
+	return nil
+}
+
+// Updates CountryCodeToRegion in place to countryCodeToRegion, the
+// mapping now in use by the default PhoneNumberUtil. It is called with
+// the update lock of the default PhoneNumberUtil held, so updates are
+// applied in the order the registries were published.
+func syncCountryCodeToRegion(countryCodeToRegion map[int][]string) {
+	for countryCode := range CountryCodeToRegion {
+		if _, ok := countryCodeToRegion[countryCode]; !ok {
+			delete(CountryCodeToRegion, countryCode)
+		}
+	}
+	for countryCode, regions := range countryCodeToRegion {
+		CountryCodeToRegion[countryCode] = append([]string(nil), regions...)
+	}
+}
+
+// Returns the overrides of registry, which may be nil.
+func (registry *MetadataRegistry) getOverrides() map[string]*PhoneMetadata {
+	if registry == nil {
+		return nil
+	}
+	return registry.overrides
+}
+
+// Returns the metadata for the region, with any override applied.
+func (registry *MetadataRegistry) getMetadataForRegion(
+	regionCode string) (*PhoneMetadata, bool) {
+
+	if meta, ok := registry.overriddenMetadata[regionCode]; ok {
+		return meta, true
+	}
+	if entry, ok := registry.index.regions[regionCode]; ok {
+		if meta := entry.get(); meta != nil {
+			return meta, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the metadata for a non-geographical entity's country calling
+// code, such as 800 (International Toll Free Service) or 808
+// (International Shared Cost Service).
+func (registry *MetadataRegistry) getMetadataForNonGeographicalRegion(
+	countryCallingCode int) (*PhoneMetadata, bool) {
+
+	if entry, ok := registry.index.nonGeographical[countryCallingCode]; ok {
+		if meta := entry.get(); meta != nil {
+			return meta, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the serialized PhoneMetadataCollection in data, decompressing
+// it if it is gzip compressed. Compressed data is told apart by the gzip
+// magic number, which a serialized collection never starts with.
+func decompressMetadata(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// Unmarshals a PhoneMetadataCollection from its serialized form, which
+// may be gzip compressed.
+func unmarshalMetadataCollection(data []byte) (*PhoneMetadataCollection, error) {
+	data, err := decompressMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	var metadataCollection = &PhoneMetadataCollection{}
+	if err := proto.Unmarshal(data, metadataCollection); err != nil {
+		return nil, err
+	}
+	return metadataCollection, nil
+}
+
+// Builds a registry from the metadata in index and the country calling
+// code to region code mapping in countryCodeToRegion, with overrides
+// layered over them. None of them is copied, so none may be modified
+// afterwards. Only the metadata of the regions with overrides is
+// decoded.
+func newMetadataRegistry(
+	index *metadataIndex,
+	countryCodeToRegion map[int][]string,
+	overrides map[string]*PhoneMetadata) (*MetadataRegistry, error) {
+
+	if index.size() == 0 {
+		return nil, ErrEmptyMetadata
+	}
+
+	registry := &MetadataRegistry{
+		index:                                index,
+		baseCountryCodeToRegion:              countryCodeToRegion,
+		overrides:                            overrides,
+		countryCodeToRegion:                  countryCodeToRegion,
+		supportedRegions:                     make(map[string]struct{}),
+		countryCodesForNonGeographicalRegion: make(map[int]struct{}),
+		nanpaRegions:                         make(map[string]struct{}),
+	}
+	if err := registry.applyOverrides(); err != nil {
+		return nil, err
+	}
+
+	for eKey, regionCodes := range registry.countryCodeToRegion {
+		// We can assume that if the county calling code maps to the
+		// non-geo entity region code then that's the only region code
+		// it maps to.
+		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
+			// This is the subset of all country codes that map to the
+			// non-geo entity region code.
+			registry.countryCodesForNonGeographicalRegion[eKey] = struct{}{}
+		} else {
+			// The supported regions set does not include the "001"
+			// non-geo entity region code.
+			for _, val := range regionCodes {
+				registry.supportedRegions[val] = struct{}{}
+			}
+		}
+	}
+	// If the non-geo entity still got added to the set of supported
+	// regions it must be because there are entries that list the non-geo
+	// entity alongside normal regions (which is wrong). If we discover
+	// this, remove the non-geo entity from the set of supported regions
+	// and log (or not log).
+	delete(registry.supportedRegions, REGION_CODE_FOR_NON_GEO_ENTITY)
+
+	for _, val := range registry.countryCodeToRegion[NANPA_COUNTRY_CODE] {
+		registry.nanpaRegions[val] = struct{}{}
+	}
+	return registry, nil
+}
@@ -0,0 +1,123 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"compress/gzip"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestNewMetadataRegistry(t *testing.T) {
+	registry := NewPhoneNumberUtil().currentMetadataRegistry()
+	if registry == nil {
+		t.Fatal("no registry was published at init")
+	}
+	if _, ok := registry.supportedRegions["US"]; !ok {
+		t.Error("US is not a supported region")
+	}
+	if _, ok := registry.supportedRegions[REGION_CODE_FOR_NON_GEO_ENTITY]; ok {
+		t.Error("the non-geo entity is a supported region")
+	}
+	if _, ok := registry.countryCodesForNonGeographicalRegion[800]; !ok {
+		t.Error("800 is not a non-geographical country calling code")
+	}
+	if _, ok := registry.nanpaRegions["CA"]; !ok {
+		t.Error("CA is not a NANPA region")
+	}
+	if _, ok := registry.nanpaRegions["GB"]; ok {
+		t.Error("GB is a NANPA region")
+	}
+	if _, ok := registry.getMetadataForNonGeographicalRegion(800); !ok {
+		t.Error("no metadata for country calling code 800")
+	}
+
+	_, err := newMetadataRegistry(
+		newMetadataIndexFromCollection(&PhoneMetadataCollection{}),
+		CountryCodeToRegion, nil)
+	if err != ErrEmptyMetadata {
+		t.Errorf("newMetadataRegistry(empty) = %v, want %v", err, ErrEmptyMetadata)
+	}
+}
+
+// Parses and formats example numbers of every region from many
+// goroutines while the registry is being replaced, for the race
+// detector to check.
+func TestParallelParseAcrossRegions(t *testing.T) {
+	type example struct {
+		region string
+		e164   string
+	}
+	u := NewPhoneNumberUtil()
+	var examples []example
+	for region := range u.GetSupportedRegions() {
+		number := u.GetExampleNumber(region)
+		if number == nil {
+			continue
+		}
+		examples = append(examples, example{region, u.Format(number, E164)})
+	}
+
+	done := make(chan struct{})
+	var republisher sync.WaitGroup
+	republisher.Add(1)
+	go func() {
+		defer republisher.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := u.loadDefaultMetadata(); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for j := range examples {
+				ex := examples[(j+offset)%len(examples)]
+				number, err := u.Parse(ex.e164, ex.region)
+				if err != nil {
+					t.Errorf("Parse(%q, %s) failed: %v", ex.e164, ex.region, err)
+					continue
+				}
+				if got := u.Format(number, E164); got != ex.e164 {
+					t.Errorf("Format(Parse(%q, %s)) = %q", ex.e164, ex.region, got)
+				}
+				if got := u.GetRegionCodeForNumber(number); got == "" {
+					t.Errorf("GetRegionCodeForNumber(%q) = \"\"", ex.e164)
+				}
+			}
+		}(i * 31)
+	}
+	wg.Wait()
+	close(done)
+	republisher.Wait()
+}
+
+func TestUnmarshalCompressedMetadataCollection(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(metaData)
+	w.Close()
+
+	compressed, err := unmarshalMetadataCollection(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncompressed, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(compressed, uncompressed) {
+		t.Error("the compressed metadata differs from the uncompressed metadata")
+	}
+}
@@ -0,0 +1,160 @@
+package libphonenumber
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The field of the serialized PhoneMetadataCollection the provenance of
+// the metadata is recorded in, and the fields of the provenance. The
+// field is not part of phonemetadata.proto, so other readers of the
+// metadata skip it like any unknown field.
+const (
+	collectionVersionField protowire.Number = 100
+
+	versionUpstreamVersionField protowire.Number = 1
+	versionUpstreamCommitField  protowire.Number = 2
+	versionContentHashField     protowire.Number = 3
+)
+
+// MetadataVersionInfo describes where metadata came from, so that two
+// programs can tell whether they use the same metadata.
+type MetadataVersionInfo struct {
+	// The upstream libphonenumber release the metadata was generated
+	// from, such as "v8.13.27", or empty if it was not recorded.
+	UpstreamVersion string
+
+	// The upstream libphonenumber commit the metadata was generated
+	// from, or empty if it was not recorded.
+	UpstreamCommit string
+
+	// The SHA-256 hash of the serialized metadata, as "sha256:" followed
+	// by the hash in hex. Unlike the upstream version and commit, it is
+	// always set, and only depends on the metadata itself.
+	ContentHash string
+}
+
+// Returns the provenance of the metadata used by u: the metadata
+// compiled into the library, or the metadata last loaded with
+// LoadMetadata. Overrides set with SetRegionMetadataOverride are not
+// taken into account.
+func (u *PhoneNumberUtil) MetadataVersion() MetadataVersionInfo {
+	return u.currentMetadataRegistry().index.getVersion()
+}
+
+// Serializes collection with the upstream version and commit it was
+// generated from, and its content hash, in the format read by
+// LoadMetadata. This is how cmd/metagen records the provenance of the
+// metadata it generates.
+func MarshalMetadata(
+	collection *PhoneMetadataCollection,
+	upstreamVersion, upstreamCommit string) ([]byte, error) {
+
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		return nil, err
+	}
+	// Drop any provenance the collection was read with.
+	_, data, err = extractMetadataVersion(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var version []byte
+	for _, field := range []struct {
+		num   protowire.Number
+		value string
+	}{
+		{versionUpstreamVersionField, upstreamVersion},
+		{versionUpstreamCommitField, upstreamCommit},
+		{versionContentHashField, metadataContentHash(data)},
+	} {
+		if len(field.value) > 0 {
+			version = protowire.AppendTag(version, field.num, protowire.BytesType)
+			version = protowire.AppendString(version, field.value)
+		}
+	}
+	data = protowire.AppendTag(data, collectionVersionField, protowire.BytesType)
+	return protowire.AppendBytes(data, version), nil
+}
+
+// Returns the content hash of the serialized metadata in data, which
+// holds no provenance.
+func metadataContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Returns the provenance recorded in the serialized
+// PhoneMetadataCollection in data, if any, and data without it. The
+// content hash is only set if it was recorded.
+func extractMetadataVersion(data []byte) (MetadataVersionInfo, []byte, error) {
+	var info MetadataVersionInfo
+	var rest []byte
+	for start := 0; start < len(data); {
+		num, typ, n := protowire.ConsumeTag(data[start:])
+		if n < 0 {
+			return info, nil, protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, data[start+n:])
+		if m < 0 {
+			return info, nil, protowire.ParseError(m)
+		}
+		end := start + n + m
+		if num != collectionVersionField || typ != protowire.BytesType {
+			if rest != nil {
+				rest = append(rest, data[start:end]...)
+			}
+			start = end
+			continue
+		}
+		if rest == nil {
+			// Copy what came before, so that data is left untouched.
+			rest = append(make([]byte, 0, len(data)), data[:start]...)
+		}
+		version, _ := protowire.ConsumeBytes(data[start+n:])
+		if err := parseMetadataVersion(version, &info); err != nil {
+			return info, nil, err
+		}
+		start = end
+	}
+	if rest == nil {
+		rest = data
+	}
+	return info, rest, nil
+}
+
+// Reads the fields of a serialized provenance into info.
+func parseMetadataVersion(data []byte, info *MetadataVersionInfo) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		var field *string
+		switch num {
+		case versionUpstreamVersionField:
+			field = &info.UpstreamVersion
+		case versionUpstreamCommitField:
+			field = &info.UpstreamCommit
+		case versionContentHashField:
+			field = &info.ContentHash
+		}
+		if field != nil && typ == protowire.BytesType {
+			var v []byte
+			v, n = protowire.ConsumeBytes(data)
+			*field = string(v)
+		} else {
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return nil
+}
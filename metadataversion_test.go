@@ -0,0 +1,109 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestMetadataVersionOfBuiltinMetadata(t *testing.T) {
+	data, err := decompressMetadata(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, data, err := extractMetadataVersion(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The compiled-in metadata predates recording provenance, and matches
+	// no upstream release, so only its content hash is recorded.
+	sum := sha256.Sum256(data)
+	want := MetadataVersionInfo{ContentHash: "sha256:" + hex.EncodeToString(sum[:])}
+	if recorded != want {
+		t.Errorf("recorded provenance = %+v, want %+v", recorded, want)
+	}
+	if got := NewPhoneNumberUtil().MetadataVersion(); got != want {
+		t.Errorf("MetadataVersion() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMetadataVersionOfLoadedMetadata(t *testing.T) {
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := MarshalMetadata(collection, "v8.13.27", "0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	if err := u.LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	got := u.MetadataVersion()
+	if got.UpstreamVersion != "v8.13.27" || got.UpstreamCommit != "0123456789abcdef" {
+		t.Errorf("MetadataVersion() = %+v, want v8.13.27 at 0123456789abcdef", got)
+	}
+	// The hash only covers the metadata, which is unchanged.
+	if want := NewPhoneNumberUtil().MetadataVersion().ContentHash; got.ContentHash != want {
+		t.Errorf("ContentHash = %s, want %s", got.ContentHash, want)
+	}
+
+	// Metadata read back keeps its provenance, and marshaling it again
+	// replaces the provenance rather than adding to it.
+	index, err := newMetadataIndex(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.getVersion() != got {
+		t.Errorf("indexed version = %+v, want %+v", index.getVersion(), got)
+	}
+	reread := &PhoneMetadataCollection{}
+	if err := proto.Unmarshal(data, reread); err != nil {
+		t.Fatal(err)
+	}
+	remarshaled, err := MarshalMetadata(reread, "v8.13.28", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, _, err := extractMetadataVersion(remarshaled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MetadataVersionInfo{UpstreamVersion: "v8.13.28", ContentHash: got.ContentHash}
+	if info != want {
+		t.Errorf("remarshaled version = %+v, want %+v", info, want)
+	}
+}
+
+func TestLoadMetadataRejectsWrongContentHash(t *testing.T) {
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection.Metadata = collection.Metadata[:10]
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Record the hash of the complete metadata instead.
+	version := protowire.AppendTag(nil, versionContentHashField, protowire.BytesType)
+	version = protowire.AppendString(version, metadataContentHash(metaData))
+	data = protowire.AppendTag(data, collectionVersionField, protowire.BytesType)
+	data = protowire.AppendBytes(data, version)
+
+	u := NewPhoneNumberUtil()
+	err = u.LoadMetadata(bytes.NewReader(data))
+	if !errors.Is(err, ErrInvalidMetadata) || !strings.Contains(err.Error(), "content hash") {
+		t.Errorf("LoadMetadata() = %v, want a content hash mismatch", err)
+	}
+	if got := u.MetadataVersion(); got != NewPhoneNumberUtil().MetadataVersion() {
+		t.Errorf("MetadataVersion() = %+v after rejected metadata", got)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by cmd/metagen. DO NOT EDIT.
+
 package libphonenumber
 
 var metaData = []byte{
@@ -14073,5 +14075,11 @@ var metaData = []byte{
 	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xC2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
 	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xCA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
 	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xE2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
-	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xA2, 0x06, 0x49, 0x1A, 0x47,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x3A, 0x64, 0x61, 0x34, 0x33, 0x61, 0x61,
+	0x66, 0x66, 0x65, 0x65, 0x33, 0x38, 0x32, 0x63, 0x62, 0x36, 0x63, 0x38, 0x37,
+	0x63, 0x37, 0x30, 0x61, 0x63, 0x31, 0x30, 0x31, 0x66, 0x37, 0x31, 0x66, 0x36,
+	0x30, 0x66, 0x61, 0x61, 0x61, 0x62, 0x31, 0x65, 0x34, 0x39, 0x65, 0x32, 0x33,
+	0x66, 0x66, 0x36, 0x62, 0x65, 0x38, 0x32, 0x64, 0x34, 0x37, 0x63, 0x36, 0x30,
+	0x34, 0x37, 0x35, 0x66, 0x64, 0x63,
 }
@@ -0,0 +1,99 @@
+package libphonenumber
+
+// The reason a phone number could not be parsed.
+type ErrorType int
+
+const (
+	// The country code supplied did not belong to a supported country
+	// or non-geographical entity.
+	ErrorType_INVALID_COUNTRY_CODE ErrorType = iota
+	// This generally indicates the string passed in had less than 3
+	// digits in it. More specifically, the number failed to match the
+	// regular expression VALID_PHONE_NUMBER_PATTERN.
+	ErrorType_NOT_A_NUMBER
+	// This indicates the string started with an international dialing
+	// prefix, but after this was stripped from the number, had less
+	// digits than any valid phone number (including country code) could
+	// have.
+	ErrorType_TOO_SHORT_AFTER_IDD
+	// This indicates the string, after any country code has been
+	// stripped, had less digits than any valid phone number could have.
+	ErrorType_TOO_SHORT_NSN
+	// This indicates the string had more digits than any valid phone
+	// number could have.
+	ErrorType_TOO_LONG
+)
+
+var errorTypeNames = map[ErrorType]string{
+	ErrorType_INVALID_COUNTRY_CODE: "INVALID_COUNTRY_CODE",
+	ErrorType_NOT_A_NUMBER:         "NOT_A_NUMBER",
+	ErrorType_TOO_SHORT_AFTER_IDD:  "TOO_SHORT_AFTER_IDD",
+	ErrorType_TOO_SHORT_NSN:        "TOO_SHORT_NSN",
+	ErrorType_TOO_LONG:             "TOO_LONG",
+}
+
+func (e ErrorType) String() string {
+	if name, ok := errorTypeNames[e]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// The sentinel error matching each error type, so that a ParseError
+// can be tested with errors.Is(err, ErrNotANumber) and the like.
+var errorTypeSentinels = map[ErrorType]error{
+	ErrorType_INVALID_COUNTRY_CODE: ErrInvalidCountryCode,
+	ErrorType_NOT_A_NUMBER:         ErrNotANumber,
+	ErrorType_TOO_SHORT_AFTER_IDD:  ErrTooShortAfterIDD,
+	ErrorType_TOO_SHORT_NSN:        ErrTooShortNSN,
+	ErrorType_TOO_LONG:             ErrNumTooLong,
+}
+
+// A ParseError is returned when a string could not be parsed into a
+// phone number. It records why parsing failed, the string that was
+// being parsed and, where known, the byte offset in that string at
+// which the problem was found. Callers that only care about the kind
+// of failure can keep using errors.Is with the sentinel errors, e.g.
+// errors.Is(err, ErrInvalidCountryCode).
+type ParseError struct {
+	ErrorType ErrorType
+	// The string passed to Parse.
+	Input string
+	// The byte offset in Input at which parsing failed, or -1 if it is
+	// not known. For NOT_A_NUMBER, it is where the number starts or, if
+	// nothing in Input could start a number, its first character other
+	// than white space. For INVALID_COUNTRY_CODE, it is where the country
+	// calling code starts, after any plus sign or international prefix,
+	// or where the number starts if it has none and there is no default
+	// region. For TOO_SHORT_NSN and TOO_LONG, it is where the national
+	// number starts, after any country calling code and national prefix,
+	// or MAX_INPUT_STRING_LENGTH when Input as a whole is too long to
+	// parse.
+	Position int
+
+	message string
+}
+
+func newParseError(
+	errorType ErrorType,
+	input string,
+	position int,
+	message string) *ParseError {
+
+	return &ParseError{
+		ErrorType: errorType,
+		Input:     input,
+		Position:  position,
+		message:   message,
+	}
+}
+
+func (e *ParseError) Error() string {
+	return "Error type: " + e.ErrorType.String() + ". " + e.message
+}
+
+// Returns the sentinel error for the error type, such as
+// ErrInvalidCountryCode.
+func (e *ParseError) Unwrap() error {
+	return errorTypeSentinels[e.ErrorType]
+}
@@ -0,0 +1,144 @@
+package libphonenumber
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	var tests = []struct {
+		input     string
+		region    string
+		errorType ErrorType
+		sentinel  error
+		position  int
+	}{
+		{
+			input:     "",
+			region:    "US",
+			errorType: ErrorType_NOT_A_NUMBER,
+			sentinel:  ErrNotANumber,
+			position:  0,
+		}, {
+			input:     "This is not a phone number",
+			region:    "US",
+			errorType: ErrorType_NOT_A_NUMBER,
+			sentinel:  ErrNotANumber,
+			position:  0,
+		}, {
+			input:     "  no number",
+			region:    "US",
+			errorType: ErrorType_NOT_A_NUMBER,
+			sentinel:  ErrNotANumber,
+			position:  2,
+		}, {
+			input:     "Call 1",
+			region:    "US",
+			errorType: ErrorType_NOT_A_NUMBER,
+			sentinel:  ErrNotANumber,
+			position:  5,
+		}, {
+			input:     "123 456 7890",
+			region:    "ZZ",
+			errorType: ErrorType_INVALID_COUNTRY_CODE,
+			sentinel:  ErrInvalidCountryCode,
+			position:  0,
+		}, {
+			input:     "Tel: 123 456 7890",
+			region:    "",
+			errorType: ErrorType_INVALID_COUNTRY_CODE,
+			sentinel:  ErrInvalidCountryCode,
+			position:  5,
+		}, {
+			input:     "+210 3456 56789",
+			region:    "US",
+			errorType: ErrorType_INVALID_COUNTRY_CODE,
+			sentinel:  ErrInvalidCountryCode,
+			position:  1,
+		}, {
+			input:     "Tel: +0 3456 56789",
+			region:    "US",
+			errorType: ErrorType_INVALID_COUNTRY_CODE,
+			sentinel:  ErrInvalidCountryCode,
+			position:  6,
+		}, {
+			input:     "011 210 3456 56789",
+			region:    "US",
+			errorType: ErrorType_INVALID_COUNTRY_CODE,
+			sentinel:  ErrInvalidCountryCode,
+			position:  4,
+		}, {
+			input:     "011 2",
+			region:    "US",
+			errorType: ErrorType_TOO_SHORT_AFTER_IDD,
+			sentinel:  ErrTooShortAfterIDD,
+			position:  -1,
+		}, {
+			input:     "+49 0",
+			region:    "DE",
+			errorType: ErrorType_TOO_SHORT_NSN,
+			sentinel:  ErrTooShortNSN,
+			position:  4,
+		}, {
+			input:     "+49 (0)",
+			region:    "DE",
+			errorType: ErrorType_TOO_SHORT_NSN,
+			sentinel:  ErrTooShortNSN,
+			position:  5,
+		}, {
+			// The country calling code comes from the phone context.
+			input:     "tel:0;phone-context=+49",
+			region:    "DE",
+			errorType: ErrorType_TOO_SHORT_NSN,
+			sentinel:  ErrTooShortNSN,
+			position:  4,
+		}, {
+			input:     "+1 650 253 0000 1234 5678 9012",
+			region:    "US",
+			errorType: ErrorType_TOO_LONG,
+			sentinel:  ErrNumTooLong,
+			position:  3,
+		}, {
+			input:     "Tel. 650 253 0000 1234 5678 9012",
+			region:    "US",
+			errorType: ErrorType_TOO_LONG,
+			sentinel:  ErrNumTooLong,
+			position:  5,
+		}, {
+			// The national prefix is not part of the national number.
+			input:     "0049 030 1234 5678 9012 3456 78",
+			region:    "DE",
+			errorType: ErrorType_TOO_LONG,
+			sentinel:  ErrNumTooLong,
+			position:  6,
+		}, {
+			input:     strings.Repeat("1", MAX_INPUT_STRING_LENGTH+1),
+			region:    "US",
+			errorType: ErrorType_TOO_LONG,
+			sentinel:  ErrNumTooLong,
+			position:  MAX_INPUT_STRING_LENGTH,
+		},
+	}
+	for i, test := range tests {
+		_, err := Parse(test.input, test.region)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("[test %d] Parse(%q, %s) = %v, want a *ParseError",
+				i, test.input, test.region, err)
+			continue
+		}
+		if parseErr.ErrorType != test.errorType {
+			t.Errorf("[test %d] ErrorType = %v, want %v", i, parseErr.ErrorType, test.errorType)
+		}
+		if !errors.Is(err, test.sentinel) {
+			t.Errorf("[test %d] errors.Is(%v, %v) = false", i, err, test.sentinel)
+		}
+		if parseErr.Input != test.input {
+			t.Errorf("[test %d] Input = %q, want %q", i, parseErr.Input, test.input)
+		}
+		if parseErr.Position != test.position {
+			t.Errorf("[test %d] Position = %d, want %d", i, parseErr.Position, test.position)
+		}
+	}
+}
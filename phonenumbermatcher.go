@@ -1,22 +1,434 @@
 package libphonenumber
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/ttacon/builder"
 )
 
+var (
+	// Opening and closing brackets that may enclose a phone number, or
+	// part of one, in text.
+	openingParens = "(\\[\uFF08\uFF3B"
+	closingParens = ")\\]\uFF09\uFF3D"
+	nonParens     = "[^" + openingParens + closingParens + "]"
+
+	// Limit on the number of pairs of brackets in a phone number.
+	bracketPairLimit = limit(0, 3)
+
+	// Pattern to check that brackets match. Opening brackets should be
+	// closed within a phone number. This also checks that there is
+	// something inside the brackets. Having no brackets at all is also
+	// fine.
+	MATCHING_BRACKETS = regexp.MustCompile(
+		"^(?:(?:[" + openingParens + "])?" +
+			"(?:" + nonParens + "+" + "[" + closingParens + "])?" +
+			nonParens + "+" +
+			"(?:[" + openingParens + "]" + nonParens + "+[" + closingParens + "])" +
+			bracketPairLimit + nonParens + "*)$")
+
+	// Limit on the number of leading (plus) characters.
+	leadLimit = limit(0, 2)
+	// Limit on the number of consecutive punctuation characters.
+	punctuationLimit = limit(0, 4)
+	// The maximum number of digits allowed in a digit-separated block.
+	// As we allow all digits in a single block, set high enough to
+	// accommodate the entire national number and the international
+	// country code.
+	digitBlockLimit = MAX_LENGTH_FOR_NSN + MAX_LENGTH_COUNTRY_CODE
+	// Limit on the number of blocks separated by punctuation. Uses
+	// digitBlockLimit since some formats use spaces to separate each
+	// digit.
+	blockLimit = limit(0, digitBlockLimit)
+
+	// A punctuation sequence allowing white space.
+	punctuation = "[" + VALID_PUNCTUATION + "]" + punctuationLimit
+	// A digits block without punctuation.
+	digitSequence = DIGITS + limit(1, digitBlockLimit)
+
+	leadClassChars = openingParens + PLUS_CHARS
+	leadClass      = "[" + leadClassChars + "]"
+	// Matches the start of a candidate that is allowed to follow a
+	// letter or an invalid punctuation symbol, such as "+" or "(".
+	LEAD_CLASS = regexp.MustCompile("^" + leadClass)
+
+	// The phone number pattern used by the matcher, assembled from
+	// punctuation and digit blocks. The pattern is case insensitive so
+	// that extension prefixes such as "ext" and "EXT" are both found.
+	MATCHER_PATTERN = regexp.MustCompile("(?i)" +
+		"(?:" + leadClass + punctuation + ")" + leadLimit +
+		digitSequence + "(?:" + punctuation + digitSequence + ")" + blockLimit +
+		"(?:" + EXTN_PATTERNS_FOR_MATCHING + ")?")
+
+	// Matches strings that look like publication pages. Example:
+	//
+	//	Computing Complete Answers to Queries in the Presence of Limited Access Patterns.
+	//	Chen Li. VLDB J. 12(3): 211-227 (2003).
+	//
+	// The string "211-227 (2003)" is not a telephone number.
+	PUB_PAGES = regexp.MustCompile("\\d{1,5}-+\\d{1,5}\\s{0,4}\\(\\d{1,4}")
+
+	// Matches strings that look like dates using "/" as a separator.
+	// Examples: 3/10/2011, 31/10/96 or 08/31/95.
+	SLASH_SEPARATED_DATES = regexp.MustCompile(
+		"(?:(?:[0-3]?\\d/[01]?\\d)|(?:[01]?\\d/[0-3]?\\d))/(?:[12]\\d)?\\d{2}")
+
+	// Matches timestamps. Examples: "2012-01-02 08:00". Note that the
+	// reg-ex does not include the trailing ":\d\d" -- that is covered by
+	// TIME_STAMPS_SUFFIX.
+	TIME_STAMPS        = regexp.MustCompile("[12]\\d{3}[-/]?[01]\\d[-/]?[0-3]\\d +[0-2]\\d$")
+	TIME_STAMPS_SUFFIX = regexp.MustCompile("^:[0-5]\\d")
+
+	// Patterns used to extract phone numbers from a larger phone-number-like
+	// pattern. These are ordered according to specificity. For example,
+	// white-space is last since that is frequently used in numbers, not
+	// just to separate two numbers. We have separate patterns since we
+	// don't want to break up the phone-number-like text on more than one
+	// different kind of symbol at one time, although symbols of the same
+	// type (e.g. space) can be safely grouped together.
+	//
+	// Note that if there is a match, we will always check any text found
+	// up to the first match as well.
+	INNER_MATCHES = []*regexp.Regexp{
+		// Breaks on the slash - e.g. "651-234-2345/332-445-1234"
+		regexp.MustCompile("/+(.*)"),
+		// Note that the bracket here is inside the capturing group,
+		// since we consider it part of the phone number. Will match a
+		// pattern like "(650) 223 3345 (754) 223 3321".
+		regexp.MustCompile("(\\([^(]*)"),
+		// Breaks on a hyphen - e.g. "12345 - 332-445-1234 is my number."
+		// We require a space on either side of the hyphen for it to be
+		// considered a separator.
+		regexp.MustCompile("(?:\\p{Z}-|-\\p{Z})\\p{Z}*(.+)"),
+		// Various types of wide hyphens. Note we have decided not to
+		// enforce a space here, since it's possible that it's supposed
+		// to be used to break two numbers without spaces, and we
+		// haven't seen many instances of it used within a number.
+		regexp.MustCompile("[\u2012-\u2015\uFF0D]\\p{Z}*(.+)"),
+		// Breaks on a full stop - e.g. "12345. 332-445-1234 is my number."
+		regexp.MustCompile("\\.+\\p{Z}*([^.]+)"),
+		// Breaks on space - e.g. "3324451234 8002341234"
+		regexp.MustCompile("\\p{Z}+(\\P{Z}+)"),
+	}
+)
+
+// Returns a regular expression quantifier with an upper and lower limit.
+func limit(lower, upper int) string {
+	return "{" + strconv.Itoa(lower) + "," + strconv.Itoa(upper) + "}"
+}
+
+// A PhoneNumberMatch is a phone number found in text by a
+// PhoneNumberMatcher. Start is the byte offset of the match within the
+// text, RawString the matched substring and Number the parsed phone
+// number.
+type PhoneNumberMatch struct {
+	Start     int
+	RawString string
+	Number    *PhoneNumber
+}
+
+// Returns the byte offset in the text just after the end of the match.
+func (m *PhoneNumberMatch) End() int {
+	return m.Start + len(m.RawString)
+}
+
+type matcherState int
+
+const (
+	matcherNotReady matcherState = iota
+	matcherReady
+	matcherDone
+)
+
+// A PhoneNumberMatcher is a stateful iterator over the phone numbers
+// found in a piece of text. Vanity numbers (phone numbers using
+// alphabetic digits such as 1-800-SIX-FLAGS) are not found.
+//
+//	matcher := NewPhoneNumberMatcher(text, "US", VALID, math.MaxInt64)
+//	for matcher.HasNext() {
+//	        match := matcher.Next()
+//	        // ... use match.Number ...
+//	}
 type PhoneNumberMatcher struct {
+	// The PhoneNumberUtil whose metadata is used.
+	util *PhoneNumberUtil
+	// The text searched for phone numbers.
+	text string
+	// The region (country) to assume for phone numbers without an
+	// international prefix.
+	preferredRegion string
+	// The degree of validation requested.
+	leniency Leniency
+	// The maximum number of retries after matching an invalid number.
+	maxTries int64
+
+	// The iteration tristate.
+	state matcherState
+	// The last successful match, nil unless in matcherReady.
+	lastMatch *PhoneNumberMatch
+	// The next index to start searching at. Undefined in matcherDone.
+	searchIndex int
 }
 
-func NewPhoneNumberMatcher(seq string) *PhoneNumberMatcher {
-	// TODO(ttacon): to be implemented
+// Creates a new matcher for the text using the given leniency. The
+// defaultRegion is the region to assume for numbers not written in
+// international format; it may be UNKNOWN_REGION if only numbers with
+// a leading plus should be considered. maxTries is the number of
+// invalid candidates the matcher will skip before giving up; a
+// negative value is treated as zero. The matcher uses the default
+// PhoneNumberUtil; see PhoneNumberUtil.FindNumbersWithLeniency for
+// other ones.
+func NewPhoneNumberMatcher(
+	text, defaultRegion string,
+	leniency Leniency,
+	maxTries int64) *PhoneNumberMatcher {
+
+	return newPhoneNumberMatcher(
+		defaultPhoneNumberUtil, text, defaultRegion, leniency, maxTries)
+}
+
+// As NewPhoneNumberMatcher, using the metadata of util.
+func newPhoneNumberMatcher(
+	util *PhoneNumberUtil,
+	text, defaultRegion string,
+	leniency Leniency,
+	maxTries int64) *PhoneNumberMatcher {
+
+	if maxTries < 0 {
+		maxTries = 0
+	}
+	return &PhoneNumberMatcher{
+		util:            util,
+		text:            text,
+		preferredRegion: defaultRegion,
+		leniency:        leniency,
+		maxTries:        maxTries,
+		state:           matcherNotReady,
+	}
+}
+
+// Reports whether there is another match in the text.
+func (m *PhoneNumberMatcher) HasNext() bool {
+	if m.state == matcherNotReady {
+		m.lastMatch = m.find(m.searchIndex)
+		if m.lastMatch == nil {
+			m.state = matcherDone
+		} else {
+			m.searchIndex = m.lastMatch.End()
+			m.state = matcherReady
+		}
+	}
+	return m.state == matcherReady
+}
+
+// Returns the next match in the text, or nil once there are no more
+// matches.
+func (m *PhoneNumberMatcher) Next() *PhoneNumberMatch {
+	// Check the state and find the next match as a side-effect if necessary.
+	if !m.HasNext() {
+		return nil
+	}
+	// Don't retain that memory any longer than necessary.
+	result := m.lastMatch
+	m.lastMatch = nil
+	m.state = matcherNotReady
+	return result
+}
+
+// Attempts to find the next subsequence in the searched sequence on or
+// after index that represents a phone number. Returns the next match,
+// or nil if none was found.
+func (m *PhoneNumberMatcher) find(index int) *PhoneNumberMatch {
+	for m.maxTries > 0 && index <= len(m.text) {
+		inds := MATCHER_PATTERN.FindStringIndex(m.text[index:])
+		if inds == nil {
+			break
+		}
+		start := index + inds[0]
+		candidate := m.text[start : index+inds[1]]
+
+		// Check for extra numbers at the end.
+		candidate = trimAfterFirstMatch(SECOND_NUMBER_START_PATTERN, candidate)
+
+		match := m.extractMatch(candidate, start)
+		if match != nil {
+			return match
+		}
+
+		index = start + len(candidate)
+		if len(candidate) == 0 {
+			// Always make progress, even on a degenerate candidate.
+			_, size := utf8.DecodeRuneInString(m.text[start:])
+			index = start + size
+		}
+		m.maxTries--
+	}
 	return nil
 }
 
-func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
+// Trims away any characters after the first match of pattern in candidate,
+// returning the trimmed version.
+func trimAfterFirstMatch(pattern *regexp.Regexp, candidate string) string {
+	inds := pattern.FindStringIndex(candidate)
+	if inds != nil {
+		candidate = candidate[0:inds[0]]
+	}
+	return candidate
+}
+
+// Helper method to determine if a character is a Latin-script letter or
+// not. For our purposes, combining marks should also return true since
+// we assume they have been added to a preceding Latin character.
+func isLatinLetter(letter rune) bool {
+	// Combining marks are a subset of non-spacing-mark.
+	if !unicode.IsLetter(letter) && !unicode.Is(unicode.Mn, letter) {
+		return false
+	}
+	// Basic Latin, Latin-1 Supplement, Latin Extended-A and -B,
+	// Combining Diacritical Marks and Latin Extended Additional.
+	return letter <= 0x024F ||
+		(letter >= 0x0300 && letter <= 0x036F) ||
+		(letter >= 0x1E00 && letter <= 0x1EFF)
+}
+
+func isInvalidPunctuationSymbol(character rune) bool {
+	return character == '%' || unicode.Is(unicode.Sc, character)
+}
+
+// Attempts to extract a match from a candidate string. Returns the match
+// found, or nil if none can be found.
+func (m *PhoneNumberMatcher) extractMatch(
+	candidate string,
+	offset int) *PhoneNumberMatch {
+
+	// Skip a match that is more likely to be a date.
+	if SLASH_SEPARATED_DATES.MatchString(candidate) {
+		return nil
+	}
+
+	// Skip potential time-stamps.
+	if TIME_STAMPS.MatchString(candidate) {
+		followingText := m.text[offset+len(candidate):]
+		if TIME_STAMPS_SUFFIX.MatchString(followingText) {
+			return nil
+		}
+	}
+
+	// Try to come up with a valid match given the entire candidate.
+	match := m.parseAndVerify(candidate, offset)
+	if match != nil {
+		return match
+	}
+
+	// If that failed, try to find an "inner match" - there might be a
+	// phone number within this candidate.
+	return m.extractInnerMatch(candidate, offset)
+}
+
+// Attempts to extract a match from candidate if the whole candidate does
+// not qualify as a match. Returns the match found, or nil if none can be
+// found.
+func (m *PhoneNumberMatcher) extractInnerMatch(
+	candidate string,
+	offset int) *PhoneNumberMatch {
+
+	for _, possibleInnerMatch := range INNER_MATCHES {
+		isFirstMatch := true
+		for _, groups := range possibleInnerMatch.FindAllStringSubmatchIndex(candidate, -1) {
+			if m.maxTries <= 0 {
+				break
+			}
+			if isFirstMatch {
+				// We should handle any group before this one too.
+				group := trimAfterFirstMatch(
+					UNWANTED_END_CHAR_PATTERN, candidate[0:groups[0]])
+				match := m.parseAndVerify(group, offset)
+				if match != nil {
+					return match
+				}
+				m.maxTries--
+				isFirstMatch = false
+			}
+			group := trimAfterFirstMatch(
+				UNWANTED_END_CHAR_PATTERN, candidate[groups[2]:groups[3]])
+			match := m.parseAndVerify(group, offset+groups[2])
+			if match != nil {
+				return match
+			}
+			m.maxTries--
+		}
+	}
+	return nil
+}
+
+// Parses a phone number from the candidate using Parse and verifies it
+// matches the requested leniency. If parsing and verification succeed,
+// a corresponding PhoneNumberMatch is returned, otherwise this method
+// returns nil.
+func (m *PhoneNumberMatcher) parseAndVerify(
+	candidate string,
+	offset int) *PhoneNumberMatch {
+
+	// Check the candidate doesn't contain any formatting which would
+	// indicate that it really isn't a phone number.
+	if !MATCHING_BRACKETS.MatchString(candidate) ||
+		PUB_PAGES.MatchString(candidate) {
+		return nil
+	}
+
+	// If leniency is set to VALID or stricter, we also want to skip
+	// numbers that are surrounded by Latin alphabetic characters, to
+	// skip cases like abc8005001234 or 8005001234def.
+	if m.leniency >= VALID {
+		// If the candidate is not at the start of the text, and does
+		// not start with phone-number punctuation, check the previous
+		// character.
+		if offset > 0 && !LEAD_CLASS.MatchString(candidate) {
+			previousChar, _ := utf8.DecodeLastRuneInString(m.text[0:offset])
+			// We return nil if it is a latin letter or an invalid
+			// punctuation symbol.
+			if isInvalidPunctuationSymbol(previousChar) ||
+				isLatinLetter(previousChar) {
+				return nil
+			}
+		}
+		lastCharIndex := offset + len(candidate)
+		if lastCharIndex < len(m.text) {
+			nextChar, _ := utf8.DecodeRuneInString(m.text[lastCharIndex:])
+			if isInvalidPunctuationSymbol(nextChar) || isLatinLetter(nextChar) {
+				return nil
+			}
+		}
+	}
+
+	number, err := m.util.ParseAndKeepRawInput(candidate, m.preferredRegion)
+	if err != nil {
+		return nil
+	}
+
+	if !m.leniency.verify(m.util, number, candidate) {
+		return nil
+	}
+	// We used ParseAndKeepRawInput to create this number, but for now
+	// we don't return the extra values parsed.
+	number.CountryCodeSource = nil
+	number.RawInput = nil
+	number.PreferredDomesticCarrierCode = nil
+	return &PhoneNumberMatch{
+		Start:     offset,
+		RawString: candidate,
+		Number:    number,
+	}
+}
+
+func (u *PhoneNumberUtil) ContainsOnlyValidXChars(
+	number *PhoneNumber,
+	candidate string) bool {
+
 	// The characters 'x' and 'X' can be (1) a carrier code, in which
 	// case they always precede the national significant number or (2)
 	// an extension sign, in which case they always precede the extension
@@ -32,7 +444,7 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 				// This is the carrier code case, in which the 'X's
 				// always precede the national significant number.
 				index++
-				if isNumberMatchWithOneNumber(number, candidate[index:]) != NSN_MATCH {
+				if u.IsNumberMatchWithOneNumber(number, candidate[index:]) != NSN_MATCH {
 					return false
 				}
 				// This is the extension sign case, in which the 'x'
@@ -45,20 +457,20 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 	return true
 }
 
-func IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
+func (u *PhoneNumberUtil) IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
 	// First, check how we deduced the country code. If it was written
 	// in international format, then the national prefix is not required.
 	if number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
 		return true
 	}
-	var phoneNumberRegion = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-	var metadata = getMetadataForRegion(phoneNumberRegion)
+	var phoneNumberRegion = u.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+	var metadata = u.getMetadataForRegion(phoneNumberRegion)
 	if metadata == nil {
 		return true
 	}
 	// Check if a national prefix should be present when formatting this number.
 	var nationalNumber = GetNationalSignificantNumber(number)
-	var formatRule = chooseFormattingPatternForNumber(
+	var formatRule = u.chooseFormattingPatternForNumber(
 		metadata.GetNumberFormat(), nationalNumber)
 	// To do this, we check that a national prefix formatting rule was
 	// present and that it wasn't just the first-group symbol ($1) with
@@ -79,7 +491,7 @@ func IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
 		var rawInput = builder.NewBuilderString(rawInputCopy)
 		// Check if we found a national prefix and/or carrier code at
 		// the start of the raw input, and return the result.
-		return maybeStripNationalPrefixAndCarrierCode(
+		return u.maybeStripNationalPrefixAndCarrierCode(
 			rawInput, metadata, builder.NewBuilder(nil))
 	}
 	return true
@@ -112,15 +524,86 @@ func ContainsMoreThanOneSlashInNationalNumber(
 	return true
 }
 
-func CheckNumberGroupingIsValid(
+// Checks whether the digit groups in candidate match the grouping the
+// library would use to format number. The candidate is normalized, the
+// expected groups are taken from the RFC3966 formatting of the number
+// and fn decides whether the candidate respects them. If it does not,
+// the alternate formats for the number's country calling code are tried
+// as well.
+func (u *PhoneNumberUtil) CheckNumberGroupingIsValid(
 	number *PhoneNumber,
 	candidate string,
 	fn func(*PhoneNumber, string, []string) bool) bool {
-	// TODO(ttacon): to be implemented
+
+	var normalizedCandidate = normalizeDigits(candidate, true /* keep non-digits */)
+	var formattedNumberGroups = u.getNationalNumberGroups(number)
+	if fn(number, normalizedCandidate, formattedNumberGroups) {
+		return true
+	}
+	// If this didn't pass, see if there are any alternate formats that
+	// match, and try them instead.
+	var alternateFormats = GetAlternateFormatsForCountry(int(number.GetCountryCode()))
+	if alternateFormats == nil {
+		return false
+	}
+	var nationalSignificantNumber = GetNationalSignificantNumber(number)
+	for _, alternateFormat := range alternateFormats.GetNumberFormat() {
+		var leadingDigitsPattern = alternateFormat.GetLeadingDigitsPattern()
+		if len(leadingDigitsPattern) > 0 {
+			// There is only one leading digits pattern for alternate formats.
+			var patP = "^(?:" + leadingDigitsPattern[0] + ")" // Match from string start
+			pattern, ok := u.readFromRegexCache(patP)
+			if !ok {
+				pattern = regexp.MustCompile(patP)
+				u.writeToRegexCache(patP, pattern)
+			}
+			if !pattern.MatchString(nationalSignificantNumber) {
+				// Leading digits don't match; try another one.
+				continue
+			}
+		}
+		formattedNumberGroups = u.getNationalNumberGroupsForPattern(
+			nationalSignificantNumber, alternateFormat)
+		if fn(number, normalizedCandidate, formattedNumberGroups) {
+			return true
+		}
+	}
 	return false
 }
 
-func AllNumberGroupsRemainGrouped(
+// Helper method to get the national-number part of a number, formatted
+// without any national prefix, and return it as a set of digit blocks
+// that would be formatted together.
+func (u *PhoneNumberUtil) getNationalNumberGroups(number *PhoneNumber) []string {
+	// This will be in the format +CC-DG1-DG2-DGX;ext=EXT where DG1..DGX
+	// represents groups of digits.
+	var rfc3966Format = u.Format(number, RFC3966)
+	// We remove the extension part from the formatted string before
+	// splitting it into different groups.
+	var endIndex = strings.Index(rfc3966Format, ";")
+	if endIndex < 0 {
+		endIndex = len(rfc3966Format)
+	}
+	// The country-code will have a '-' following it.
+	var startIndex = strings.Index(rfc3966Format, "-") + 1
+	return strings.Split(rfc3966Format[startIndex:endIndex], "-")
+}
+
+// Helper method to get the national-number part of a number, formatted
+// without any national prefix using formattingPattern, and return it as
+// a set of digit blocks that should be formatted together.
+func (u *PhoneNumberUtil) getNationalNumberGroupsForPattern(
+	nationalSignificantNumber string,
+	formattingPattern *NumberFormat) []string {
+
+	// This will be in the format DG1-DG2-DGX, where DG1..DGX represents
+	// groups of digits.
+	var rfc3966Format = u.formatNsnUsingPattern(
+		nationalSignificantNumber, formattingPattern, RFC3966)
+	return strings.Split(rfc3966Format, "-")
+}
+
+func (u *PhoneNumberUtil) AllNumberGroupsRemainGrouped(
 	number *PhoneNumber,
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
@@ -137,13 +620,13 @@ func AllNumberGroupsRemainGrouped(
 		// Fails if the substring of normalizedCandidate starting
 		// from fromIndex doesn't contain the consecutive digits
 		// in formattedNumberGroups[i].
-		fromIndex = strings.Index(
-			normalizedCandidate[fromIndex+1:], formattedNumberGroups[i])
-		if fromIndex < 0 {
+		var groupIndex = strings.Index(
+			normalizedCandidate[fromIndex:], formattedNumberGroups[i])
+		if groupIndex < 0 {
 			return false
 		}
 		// Moves fromIndex forward.
-		fromIndex += len(formattedNumberGroups[i])
+		fromIndex += groupIndex + len(formattedNumberGroups[i])
 		if i == 0 && fromIndex < len(normalizedCandidate) {
 			// We are at the position right after the NDC. We get
 			// the region used for formatting information based on
@@ -151,9 +634,9 @@ func AllNumberGroupsRemainGrouped(
 			// number itself, as we do not need to distinguish between
 			// different countries with the same country calling code
 			// and this is faster.
-			var region = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-			if GetNddPrefixForRegion(region, true) != "" &&
-				unicode.IsDigit(rune(normalizedCandidate[fromIndex])) {
+			var region = u.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+			if u.GetNddPrefixForRegion(region, true) != "" &&
+				isASCIIDigit(normalizedCandidate[fromIndex]) {
 				// This means there is no formatting symbol after the
 				// NDC. In this case, we only accept the number if there
 				// is no formatting symbol at all in the number, except
@@ -178,11 +661,11 @@ func AllNumberGroupsAreExactlyPresent(
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
 
-	var candidateGroups = NON_DIGITS_PATTERN.FindAllString(normalizedCandidate, -1)
+	var candidateGroups = splitOnNonDigits(normalizedCandidate)
 	// Set this to the last group, skipping it if the number has an extension.
-	var candidateNumberGroupIndex = len(candidateGroups) - 2
+	var candidateNumberGroupIndex = len(candidateGroups) - 1
 	if number.GetExtension() != "" {
-		candidateNumberGroupIndex = len(candidateGroups) - 1
+		candidateNumberGroupIndex = len(candidateGroups) - 2
 	}
 
 	// First we check if the national significant number is formatted
@@ -210,3 +693,17 @@ func AllNumberGroupsAreExactlyPresent(
 		strings.HasSuffix(candidateGroups[candidateNumberGroupIndex],
 			formattedNumberGroups[0]))
 }
+
+// Splits s around runs of non-digits. Like Java's String.split, trailing
+// empty strings are not included in the result.
+func splitOnNonDigits(s string) []string {
+	var groups = NON_DIGITS_PATTERN.Split(s, -1)
+	for len(groups) > 0 && groups[len(groups)-1] == "" {
+		groups = groups[:len(groups)-1]
+	}
+	return groups
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
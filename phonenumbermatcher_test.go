@@ -0,0 +1,225 @@
+package libphonenumber
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFindNumbers(t *testing.T) {
+	var tests = []struct {
+		text     string
+		region   string
+		leniency Leniency
+		starts   []int
+		raws     []string
+		nums     []uint64
+	}{
+		{
+			text:     "Call me at 650 253 0000 tomorrow",
+			region:   "US",
+			leniency: VALID,
+			starts:   []int{11},
+			raws:     []string{"650 253 0000"},
+			nums:     []uint64{6502530000},
+		}, {
+			text:     "+44 20 7031 3000 or +1 650-253-0000.",
+			region:   "US",
+			leniency: VALID,
+			starts:   []int{0, 20},
+			raws:     []string{"+44 20 7031 3000", "+1 650-253-0000"},
+			nums:     []uint64{2070313000, 6502530000},
+		}, {
+			text:     "(650) 253-0000 x123",
+			region:   "US",
+			leniency: VALID,
+			starts:   []int{0},
+			raws:     []string{"(650) 253-0000 x123"},
+			nums:     []uint64{6502530000},
+		}, {
+			// Dates should not be mistaken for phone numbers.
+			text:     "Meet me on 3/10/2011 at noon",
+			region:   "US",
+			leniency: POSSIBLE,
+		}, {
+			// Neither should publication pages.
+			text:     "Chen Li. VLDB J. 12(3): 211-227 (2003).",
+			region:   "US",
+			leniency: VALID,
+		}, {
+			// Numbers glued to latin letters are only found leniently.
+			text:     "abc8005001234",
+			region:   "US",
+			leniency: VALID,
+		}, {
+			text:     "abc8005001234",
+			region:   "US",
+			leniency: POSSIBLE,
+			starts:   []int{3},
+			raws:     []string{"8005001234"},
+			nums:     []uint64{8005001234},
+		},
+	}
+
+	for i, test := range tests {
+		matcher := NewPhoneNumberMatcher(
+			test.text, test.region, test.leniency, math.MaxInt64)
+		var found []*PhoneNumberMatch
+		for matcher.HasNext() {
+			found = append(found, matcher.Next())
+		}
+		if len(found) != len(test.raws) {
+			t.Errorf("[test %d] found %d matches, expected %d\n",
+				i, len(found), len(test.raws))
+			continue
+		}
+		for j, match := range found {
+			if match.Start != test.starts[j] {
+				t.Errorf("[test %d:%d:start] %d != %d\n", i, j, match.Start, test.starts[j])
+			}
+			if match.RawString != test.raws[j] {
+				t.Errorf("[test %d:%d:raw] %q != %q\n", i, j, match.RawString, test.raws[j])
+			}
+			if match.End() != match.Start+len(test.raws[j]) {
+				t.Errorf("[test %d:%d:end] %d is not the end of the match\n", i, j, match.End())
+			}
+			if match.Number.GetNationalNumber() != test.nums[j] {
+				t.Errorf("[test %d:%d:num] %d != %d\n",
+					i, j, match.Number.GetNationalNumber(), test.nums[j])
+			}
+			if match.Number.RawInput != nil || match.Number.CountryCodeSource != nil {
+				t.Errorf("[test %d:%d] raw input and country code source should be cleared\n", i, j)
+			}
+		}
+		if matcher.Next() != nil {
+			t.Errorf("[test %d] Next should return nil once exhausted\n", i)
+		}
+	}
+}
+
+func TestFindNumbersMaxTries(t *testing.T) {
+	// The first candidate is invalid, so a single try is not enough to
+	// reach the valid number behind it.
+	var text = "0000000000 and then 650 253 0000"
+	if FindNumbersWithLeniency(text, "US", VALID, 1).HasNext() {
+		t.Error("the matcher should have given up after one invalid candidate")
+	}
+	if !FindNumbersWithLeniency(text, "US", VALID, 2).HasNext() {
+		t.Error("the matcher should have found the second candidate")
+	}
+	if !FindNumbers(text, "US").HasNext() {
+		t.Error("FindNumbers should find the valid number")
+	}
+}
+
+func TestLeniencyVerifyGrouping(t *testing.T) {
+	var tests = []struct {
+		candidate string
+		region    string
+		strict    bool
+		exact     bool
+	}{
+		{candidate: "650 253 0000", region: "US", strict: true, exact: true},
+		{candidate: "6502530000", region: "US", strict: true, exact: true},
+		{candidate: "+1 650 253 0000", region: "US", strict: true, exact: true},
+		{candidate: "(650) 253-0000", region: "US", strict: true, exact: true},
+		{candidate: "650-253-0000 ext. 123", region: "US", strict: true, exact: true},
+		// The groups are all there, but not formatted exactly.
+		{candidate: "650 2530000", region: "US", strict: true, exact: false},
+		// Digits of different groups run together.
+		{candidate: "6502 530000", region: "US", strict: false, exact: false},
+		{candidate: "65 02 53 00 00", region: "US", strict: false, exact: false},
+		{candidate: "030 123456", region: "DE", strict: true, exact: true},
+		{candidate: "+49 30 123456", region: "DE", strict: true, exact: true},
+		{candidate: "0301 23456", region: "DE", strict: false, exact: false},
+	}
+
+	for i, test := range tests {
+		num, err := ParseAndKeepRawInput(test.candidate, test.region)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %q: %v\n", i, test.candidate, err)
+			continue
+		}
+		if !VALID.Verify(num, test.candidate) {
+			t.Errorf("[test %d:valid] %q should be VALID\n", i, test.candidate)
+		}
+		if STRICT_GROUPING.Verify(num, test.candidate) != test.strict {
+			t.Errorf("[test %d:strict] %q: expected %v\n", i, test.candidate, test.strict)
+		}
+		if EXACT_GROUPING.Verify(num, test.candidate) != test.exact {
+			t.Errorf("[test %d:exact] %q: expected %v\n", i, test.candidate, test.exact)
+		}
+	}
+}
+
+func TestGetAlternateFormatsForCountry(t *testing.T) {
+	var alternateFormats = GetAlternateFormatsForCountry(49)
+	if alternateFormats == nil {
+		t.Skip("no alternate formats for Germany are compiled in")
+	}
+	if len(alternateFormats.GetNumberFormat()) == 0 {
+		t.Error("the alternate formats for Germany should have number formats")
+	}
+	if alternateFormats.GetCountryCode() != 49 {
+		t.Errorf("%d != 49\n", alternateFormats.GetCountryCode())
+	}
+	if GetAlternateFormatsForCountry(1) != nil {
+		t.Error("there should be no alternate formats for NANPA")
+	}
+}
+
+func TestLeniencyVerifyAlternateFormats(t *testing.T) {
+	// A Falkensee number, with the four-digit area code 3322, is
+	// written as 03322 5078053 by Format. Every other grouping of it
+	// with the area code on its own that upstream's alternate formats
+	// list must be accepted as well.
+	num, err := Parse("+49 3322 5078053", "DE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	alternateFormats := GetAlternateFormatsForCountry(49)
+	if alternateFormats == nil {
+		t.Skip("no alternate formats for Germany are compiled in")
+	}
+	nationalNumber := GetNationalSignificantNumber(num)
+	var candidates []string
+	for _, numFmt := range alternateFormats.GetNumberFormat() {
+		if !strings.HasPrefix(numFmt.GetPattern(), `(\d{4})`) {
+			continue
+		}
+		leadingDigits := numFmt.GetLeadingDigitsPattern()
+		if len(leadingDigits) > 0 && !regexp.MustCompile(
+			"^(?:"+leadingDigits[len(leadingDigits)-1]+")").MatchString(nationalNumber) {
+			continue
+		}
+		pattern := regexp.MustCompile("^(?:" + numFmt.GetPattern() + ")$")
+		if !pattern.MatchString(nationalNumber) {
+			continue
+		}
+		candidate := "0" + pattern.ReplaceAllString(nationalNumber, numFmt.GetFormat())
+		if candidate != Format(num, NATIONAL) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 0 {
+		t.Skip("no alternate grouping of German numbers with a four-digit area code")
+	}
+	// Not covered by any format.
+	candidates = append(candidates, "03 32 25 07 80 53")
+
+	for i, candidate := range candidates {
+		exact := i < len(candidates)-1
+		num, err := ParseAndKeepRawInput(candidate, "DE")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %q: %v\n", i, candidate, err)
+			continue
+		}
+		if STRICT_GROUPING.Verify(num, candidate) != exact {
+			t.Errorf("[test %d:strict] %q: expected %v\n", i, candidate, exact)
+		}
+		if EXACT_GROUPING.Verify(num, candidate) != exact {
+			t.Errorf("[test %d:exact] %q: expected %v\n", i, candidate, exact)
+		}
+	}
+}
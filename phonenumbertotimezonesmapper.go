@@ -0,0 +1,158 @@
+package libphonenumber
+
+import "strconv"
+
+// A PhoneNumberToTimeZonesMapper maps phone numbers to the time zones
+// they could belong to, by looking up the longest prefix of the number
+// (country calling code followed by the national significant number) in
+// a prefix to time zones map such as CountryCodeToTimeZones.
+type PhoneNumberToTimeZonesMapper struct {
+	// The PhoneNumberUtil telling the type of numbers.
+	util *PhoneNumberUtil
+
+	prefixTimeZonesMap map[int][]string
+	// The length of the longest prefix in prefixTimeZonesMap.
+	maxPrefixLength int
+}
+
+// A mapper over CountryCodeToTimeZones using the default
+// PhoneNumberUtil, used by GetTimeZonesForNumber and
+// GetTimeZonesForGeographicalNumber. It is built in init().
+var defaultTimeZonesMapper *PhoneNumberToTimeZonesMapper
+
+// Returns a PhoneNumberToTimeZonesMapper over prefixTimeZonesMap, which
+// maps prefixes made of a country calling code and the leading digits
+// of a national significant number to time zones, e.g. 1650 to
+// America/Los_Angeles. An entry for the country calling code on its own
+// lists the time zones of the whole country. The mapper uses the
+// metadata of u to tell geographical numbers from others.
+func (u *PhoneNumberUtil) NewPhoneNumberToTimeZonesMapper(
+	prefixTimeZonesMap map[int][]string) *PhoneNumberToTimeZonesMapper {
+
+	m := &PhoneNumberToTimeZonesMapper{
+		util:               u,
+		prefixTimeZonesMap: prefixTimeZonesMap,
+	}
+	for prefix := range prefixTimeZonesMap {
+		if length := len(strconv.Itoa(prefix)); length > m.maxPrefixLength {
+			m.maxPrefixLength = length
+		}
+	}
+	return m
+}
+
+// Returns a list of time zones to which a phone number belongs. This
+// method assumes the validity of the number passed in has already been
+// checked, and that the number is geo-localizable. We consider fixed-line
+// and mobile numbers possible candidates for geo-localization.
+//
+// Returns a list of the corresponding time zones, or a single element
+// list with UNKNOWN_TIMEZONE if no other time zone was found or if the
+// number was invalid.
+func (m *PhoneNumberToTimeZonesMapper) GetTimeZonesForGeographicalNumber(
+	number *PhoneNumber) []string {
+
+	return m.getTimeZonesForGeocodableNumber(number)
+}
+
+// As GetTimeZonesForGeographicalNumber() but explicitly checks the
+// validity of the number passed in. Numbers that aren't geographical,
+// such as mobile or toll-free numbers, get the time zones of their
+// whole country.
+//
+// Returns a list of the corresponding time zones, or a single element
+// list with UNKNOWN_TIMEZONE if no other time zone was found or if the
+// number was invalid.
+func (m *PhoneNumberToTimeZonesMapper) GetTimeZonesForNumber(
+	number *PhoneNumber) []string {
+
+	numberType := m.util.GetNumberType(number)
+	if numberType == UNKNOWN {
+		return []string{UNKNOWN_TIMEZONE}
+	} else if !IsNumberGeographicalForType(numberType, int(number.GetCountryCode())) {
+		return m.getCountryLevelTimeZonesForNumber(number)
+	}
+	return m.getTimeZonesForGeocodableNumber(number)
+}
+
+// Returns a list of time zones to which a geocodable number belongs.
+func (m *PhoneNumberToTimeZonesMapper) getTimeZonesForGeocodableNumber(
+	number *PhoneNumber) []string {
+
+	timezones := m.lookupTimeZonesForPrefixedNumber(
+		strconv.Itoa(int(number.GetCountryCode())) +
+			GetNationalSignificantNumber(number))
+	if len(timezones) == 0 {
+		return []string{UNKNOWN_TIMEZONE}
+	}
+	return timezones
+}
+
+// Returns the list of time zones corresponding to the country calling
+// code of a number. The list is a copy, so callers may modify it.
+func (m *PhoneNumberToTimeZonesMapper) getCountryLevelTimeZonesForNumber(
+	number *PhoneNumber) []string {
+
+	timezones := m.prefixTimeZonesMap[int(number.GetCountryCode())]
+	if len(timezones) == 0 {
+		return []string{UNKNOWN_TIMEZONE}
+	}
+	return append([]string(nil), timezones...)
+}
+
+// Returns a copy of the time zones of the longest prefix of
+// prefixedNumber, a string of ASCII digits, in the map, or nil if no
+// prefix matches.
+func (m *PhoneNumberToTimeZonesMapper) lookupTimeZonesForPrefixedNumber(
+	prefixedNumber string) []string {
+
+	length := len(prefixedNumber)
+	if length > m.maxPrefixLength {
+		length = m.maxPrefixLength
+	}
+	for i := length; i > 0; i-- {
+		prefix, err := strconv.Atoi(prefixedNumber[0:i])
+		if err != nil {
+			continue
+		}
+		if timezones, ok := m.prefixTimeZonesMap[prefix]; ok {
+			return append([]string(nil), timezones...)
+		}
+	}
+	return nil
+}
+
+// Returns a list of time zones to which a geographical phone number
+// belongs, using CountryCodeToTimeZones. See
+// PhoneNumberToTimeZonesMapper.GetTimeZonesForGeographicalNumber.
+func GetTimeZonesForGeographicalNumber(number *PhoneNumber) []string {
+	return defaultTimeZonesMapper.GetTimeZonesForGeographicalNumber(number)
+}
+
+// Returns a list of time zones to which a phone number belongs, using
+// CountryCodeToTimeZones. See
+// PhoneNumberToTimeZonesMapper.GetTimeZonesForNumber.
+func GetTimeZonesForNumber(number *PhoneNumber) []string {
+	return defaultTimeZonesMapper.GetTimeZonesForNumber(number)
+}
+
+// Returns a slice of Timezones corresponding to the number passed
+// or error when it is impossible to convert the string to int
+// The algorythm tries to match the timezones starting from the maximum
+// number of phone number digits and decreasing until it finds one or reaches 0
+func GetTimeZonesForRegion(number string) ([]string, error) {
+	maxLength := MAX_REGION_CODE_LENGTH
+	if len(number) < maxLength {
+		maxLength = len(number)
+	}
+	for i := maxLength; i > 0; i-- {
+		index, err := strconv.Atoi(number[0:i])
+		if err != nil {
+			return nil, err
+		}
+		if CountryCodeToTimeZones[index] != nil {
+			return CountryCodeToTimeZones[index], nil
+		}
+	}
+	return []string{UNKNOWN_TIMEZONE}, nil
+}
@@ -0,0 +1,146 @@
+package libphonenumber
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetTimeZonesForNumber(t *testing.T) {
+	var tests = []struct {
+		num          string
+		geographical []string
+		all          []string
+	}{
+		{
+			num:          "+16502530000",
+			geographical: []string{"America/Los_Angeles"},
+			all:          []string{"America/Los_Angeles"},
+		}, {
+			num:          "+12015550123",
+			geographical: []string{"America/New_York"},
+			all:          []string{"America/New_York"},
+		}, {
+			num:          "+442073238299",
+			geographical: []string{"Europe/London"},
+			all:          []string{"Europe/London"},
+		}, {
+			// Mobile numbers aren't geographical, so they get the time
+			// zones of the whole country.
+			num:          "+61491570156",
+			geographical: CountryCodeToTimeZones[61],
+			all:          CountryCodeToTimeZones[61],
+		}, {
+			// Numbers from non-geographical entities have no time zone.
+			num:          "+80012345678",
+			geographical: []string{UNKNOWN_TIMEZONE},
+			all:          []string{UNKNOWN_TIMEZONE},
+		},
+	}
+	for i, test := range tests {
+		num, err := Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %v", i, test.num, err)
+			continue
+		}
+		if got := GetTimeZonesForGeographicalNumber(num); !reflect.DeepEqual(got, test.geographical) {
+			t.Errorf("[test %d] GetTimeZonesForGeographicalNumber(%s) = %v, want %v",
+				i, test.num, got, test.geographical)
+		}
+		if got := GetTimeZonesForNumber(num); !reflect.DeepEqual(got, test.all) {
+			t.Errorf("[test %d] GetTimeZonesForNumber(%s) = %v, want %v",
+				i, test.num, got, test.all)
+		}
+	}
+
+	// Invalid numbers have no time zone.
+	num, err := Parse("+1 123 456 7890", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := GetTimeZonesForNumber(num); !reflect.DeepEqual(got, []string{UNKNOWN_TIMEZONE}) {
+		t.Errorf("GetTimeZonesForNumber(+1 123 456 7890) = %v, want %v",
+			got, []string{UNKNOWN_TIMEZONE})
+	}
+}
+
+func TestPhoneNumberToTimeZonesMapper(t *testing.T) {
+	mapper := NewPhoneNumberToTimeZonesMapper(map[int][]string{
+		1:    []string{"America/New_York", "America/Los_Angeles"},
+		1650: []string{"America/Los_Angeles"},
+	})
+	num, err := Parse("+16502530000", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mapper.GetTimeZonesForNumber(num); !reflect.DeepEqual(got, []string{"America/Los_Angeles"}) {
+		t.Errorf("GetTimeZonesForNumber(%v) = %v", num, got)
+	}
+	num, err = Parse("+12015550123", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mapper.GetTimeZonesForNumber(num); !reflect.DeepEqual(got, mapper.prefixTimeZonesMap[1]) {
+		t.Errorf("GetTimeZonesForNumber(%v) = %v", num, got)
+	}
+}
+
+func TestPhoneNumberToTimeZonesMapperReturnsCopies(t *testing.T) {
+	mapper := NewPhoneNumberToTimeZonesMapper(map[int][]string{
+		1:    []string{"America/New_York", "America/Los_Angeles"},
+		1650: []string{"America/Los_Angeles"},
+	})
+	geographical, err := Parse("+16502530000", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Toll-free numbers get the time zones of the whole country.
+	tollFree, err := Parse("+18002530000", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		num  *PhoneNumber
+		want []string
+	}{
+		{geographical, []string{"America/Los_Angeles"}},
+		{tollFree, []string{"America/New_York", "America/Los_Angeles"}},
+	} {
+		got := mapper.GetTimeZonesForNumber(test.num)
+		got[0] = "Europe/Zurich"
+		if got := mapper.GetTimeZonesForNumber(test.num); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetTimeZonesForNumber(%v) = %v after modifying a result, want %v",
+				test.num, got, test.want)
+		}
+	}
+}
+
+func TestPhoneNumberToTimeZonesMapperUsesItsPhoneNumberUtil(t *testing.T) {
+	u := NewPhoneNumberUtil()
+	// Only internal extensions are fixed-line numbers with the override.
+	if err := u.SetRegionMetadataOverride(chExtensionsOverride); err != nil {
+		t.Fatal(err)
+	}
+	mapper := u.NewPhoneNumberToTimeZonesMapper(CountryCodeToTimeZones)
+	num, err := Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mapper.GetTimeZonesForNumber(num); !reflect.DeepEqual(got, []string{UNKNOWN_TIMEZONE}) {
+		t.Errorf("GetTimeZonesForNumber(%v) = %v, want %v", num, got, []string{UNKNOWN_TIMEZONE})
+	}
+	if got := GetTimeZonesForNumber(num); !reflect.DeepEqual(got, []string{"Europe/Zurich"}) {
+		t.Errorf("GetTimeZonesForNumber(%v) = %v with the default PhoneNumberUtil", num, got)
+	}
+}
+
+func TestGetTimeZonesForRegionShortInput(t *testing.T) {
+	for _, in := range []string{"", "4", "44", "+44"} {
+		timeZones, err := GetTimeZonesForRegion(in)
+		if err != nil && len(in) > 0 {
+			t.Errorf("GetTimeZonesForRegion(%q) failed: %v", in, err)
+		}
+		if err == nil && len(timeZones) == 0 {
+			t.Errorf("GetTimeZonesForRegion(%q) returned no time zones", in)
+		}
+	}
+}
@@ -2,12 +2,16 @@ package libphonenumber
 
 import (
 	"errors"
+	"math"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/ttacon/builder"
 
@@ -295,7 +299,7 @@ var (
 	// We remove all characters that are not alpha or numerical characters.
 	// The hash character is retained here, as it may signify the previous
 	// block was an extension.
-	UNWANTED_END_CHARS        = "[[\\P{N}&&\\P{L}]&&[^#]]+$"
+	UNWANTED_END_CHARS        = "[^\\p{N}\\p{L}#]+$"
 	UNWANTED_END_CHAR_PATTERN = regexp.MustCompile(UNWANTED_END_CHARS)
 
 	// We use this pattern to check if the phone number has at least three
@@ -393,7 +397,7 @@ var (
 	// formatting rule has the first group only, i.e., does not start
 	// with the national prefix. Note that the pattern explicitly allows
 	// for unbalanced parentheses.
-	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile("\\(?\\$1\\)?")
+	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile("^\\(?\\$1\\)?$")
 
 	REGION_CODE_FOR_NON_GEO_ENTITY = "001"
 )
@@ -481,51 +485,84 @@ const (
 	INVALID_COUNTRY_CODE
 	TOO_SHORT
 	TOO_LONG
+	// The number is only possible when dialed locally, without the area
+	// code, such as a seven-digit US number.
+	IS_POSSIBLE_LOCAL_ONLY
+	// The number is longer than the shortest and shorter than the longest
+	// possible numbers, but matches none of the possible lengths. Also
+	// returned for a type of number the region has no numbers of.
+	INVALID_LENGTH
 )
 
-// TODO(ttacon): leniency comments?
+// Leniency when finding potential phone numbers in text segments. The
+// levels here are ordered in increasing strictness.
 type Leniency int
 
 const (
+	// POSSIBLE: phone numbers accepted are possible, but not necessarily
+	// valid.
 	POSSIBLE Leniency = iota
+	// VALID: phone numbers accepted are possible and valid. Numbers
+	// written in national format must have their national-prefix present
+	// if it is usually written for a number of this type.
 	VALID
+	// STRICT_GROUPING: phone numbers accepted are valid and are grouped
+	// in a possible way for this locale. For example, a US number written
+	// as "65 02 53 00 00" or "650253 0000" is not accepted at this
+	// leniency level, whereas "650 253 0000", "650 2530000" or
+	// "6502530000" are.
 	STRICT_GROUPING
+	// EXACT_GROUPING: phone numbers accepted are valid and are grouped
+	// in the same way that we would have formatted it, or as a single
+	// block. For example, a US number written as "650 2530000" is not
+	// accepted at this leniency level, whereas "650 253 0000" or
+	// "6502530000" are.
 	EXACT_GROUPING
 )
 
+// Reports whether number, found in text as candidate, satisfies the
+// leniency, using the default PhoneNumberUtil.
 func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
+	return l.verify(defaultPhoneNumberUtil, number, candidate)
+}
+
+// As Verify, using the metadata of u.
+func (l Leniency) verify(
+	u *PhoneNumberUtil,
+	number *PhoneNumber,
+	candidate string) bool {
 
 	switch l {
 	case POSSIBLE:
-		return IsPossibleNumber(number)
+		return u.IsPossibleNumber(number)
 	case VALID:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) {
+		if !u.IsValidNumber(number) ||
+			!u.ContainsOnlyValidXChars(number, candidate) {
 			return false
 		}
-		return IsNationalPrefixPresentIfRequired(number)
+		return u.IsNationalPrefixPresentIfRequired(number)
 	case STRICT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !u.IsValidNumber(number) ||
+			!u.ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!u.IsNationalPrefixPresentIfRequired(number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return u.CheckNumberGroupingIsValid(number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
-				return AllNumberGroupsRemainGrouped(
+				return u.AllNumberGroupsRemainGrouped(
 					number, normalizedCandidate, expectedNumberGroups)
 			})
 	case EXACT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !u.IsValidNumber(number) ||
+			!u.ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!u.IsNationalPrefixPresentIfRequired(number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return u.CheckNumberGroupingIsValid(number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
@@ -536,154 +573,111 @@ func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
 	return false
 }
 
-var (
-	// golang map is not go routine safe. Sometimes process exiting
-	// because of panic. So adding mutex to synchronize the operation.
-
-	// The set of regions that share country calling code 1.
-	// There are roughly 26 regions.
-	nanpaRegions = make(map[string]struct{})
-
-	// A mapping from a region code to the PhoneMetadata for that region.
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	regionToMetadataMap = make(map[string]*PhoneMetadata)
-
-	// A mapping from a country calling code for a non-geographical
-	// entity to the PhoneMetadata for that country calling code.
-	// Examples of the country calling codes include 800 (International
-	// Toll Free Service) and 808 (International Shared Cost Service).
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	countryCodeToNonGeographicalMetadataMap = make(map[int]*PhoneMetadata)
+// A PhoneNumberUtil parses, formats and validates phone numbers using
+// its own metadata, so that code needing different metadata, such as
+// tests using fake metadata or tenants with their own numbering plans,
+// can run side by side in one process. A PhoneNumberUtil starts out
+// with the metadata compiled into the library; LoadMetadata and
+// SetRegionMetadataOverride change the metadata of that PhoneNumberUtil
+// only. It is safe for concurrent use.
+//
+// The package-level functions, such as Parse and Format, use a default
+// PhoneNumberUtil. Functions that don't depend on metadata, such as
+// NormalizeDigitsOnly, have no PhoneNumberUtil counterpart.
+//
+// The short number methods, such as IsValidShortNumber, only take the
+// regions of a country calling code from the metadata of their
+// PhoneNumberUtil. The short number metadata of those regions, with
+// their emergency numbers, short codes and SMS services, is compiled
+// into the library and shared by every PhoneNumberUtil; LoadMetadata
+// and SetRegionMetadataOverride don't change it.
+type PhoneNumberUtil struct {
+	// The registry in use. It is only ever replaced as a whole.
+	registry atomic.Pointer[MetadataRegistry]
+
+	// Serialises updates of the registry, so that concurrent updates,
+	// such as loading metadata and setting an override, don't lose each
+	// other's changes. Readers never take it.
+	registryUpdateMutex sync.Mutex
 
 	// A cache for frequently used region-specific regular expressions.
-	// The initial capacity is set to 100 as this seems to be an optimal
-	// value for Android, based on performance measurements.
-	regexCache    = make(map[string]*regexp.Regexp)
+	regexCache    map[string]*regexp.Regexp
 	regCacheMutex sync.RWMutex
+}
 
-	// The set of regions the library supports.
-	// There are roughly 240 of them and we set the initial capacity of
-	// the HashSet to 320 to offer a load factor of roughly 0.75.
-	supportedRegions = make(map[string]struct{})
+var (
+	// The registry of the metadata compiled into the library, which
+	// every PhoneNumberUtil starts out with. It is built in init().
+	builtinMetadataRegistry *MetadataRegistry
 
-	// The set of county calling codes that map to the non-geo entity
-	// region ("001"). This set currently contains < 12 elements so the
-	// default capacity of 16 (load factor=0.75) is fine.
-	countryCodesForNonGeographicalRegion = make(map[int]struct{})
+	// The PhoneNumberUtil used by the package-level functions.
+	defaultPhoneNumberUtil *PhoneNumberUtil
 )
 
-var ErrEmptyMetadata = errors.New("empty metadata")
-
-func readFromRegexCache(key string) (*regexp.Regexp, bool) {
-	regCacheMutex.RLock()
-	v, ok := regexCache[key]
-	regCacheMutex.RUnlock()
-	return v, ok
+// Returns a new PhoneNumberUtil using the metadata compiled into the
+// library.
+func NewPhoneNumberUtil() *PhoneNumberUtil {
+	u := &PhoneNumberUtil{
+		regexCache: make(map[string]*regexp.Regexp),
+	}
+	u.registry.Store(builtinMetadataRegistry)
+	return u
 }
 
-func writeToRegexCache(key string, value *regexp.Regexp) {
-	regCacheMutex.Lock()
-	regexCache[key] = value
-	regCacheMutex.Unlock()
-}
+var ErrEmptyMetadata = errors.New("empty metadata")
 
-func readFromNanpaRegions(key string) (struct{}, bool) {
-	v, ok := nanpaRegions[key]
+func (u *PhoneNumberUtil) readFromRegexCache(key string) (*regexp.Regexp, bool) {
+	u.regCacheMutex.RLock()
+	v, ok := u.regexCache[key]
+	u.regCacheMutex.RUnlock()
 	return v, ok
 }
 
-func writeToNanpaRegions(key string, val struct{}) {
-	nanpaRegions[key] = val
+func (u *PhoneNumberUtil) writeToRegexCache(key string, value *regexp.Regexp) {
+	u.regCacheMutex.Lock()
+	u.regexCache[key] = value
+	u.regCacheMutex.Unlock()
 }
 
-func readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
-	v, ok := regionToMetadataMap[key]
+func (u *PhoneNumberUtil) readFromNanpaRegions(key string) (struct{}, bool) {
+	v, ok := u.currentMetadataRegistry().nanpaRegions[key]
 	return v, ok
 }
 
-func writeToRegionToMetadataMap(key string, val *PhoneMetadata) {
-	regionToMetadataMap[key] = val
+func (u *PhoneNumberUtil) readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
+	return u.currentMetadataRegistry().getMetadataForRegion(key)
 }
 
-func readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata,
+func (u *PhoneNumberUtil) readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata,
 	bool) {
-	v, ok := countryCodeToNonGeographicalMetadataMap[key]
-	return v, ok
-}
-
-func writeToCountryCodeToNonGeographicalMetadataMap(key int, v *PhoneMetadata) {
-	countryCodeToNonGeographicalMetadataMap[key] = v
+	return u.currentMetadataRegistry().getMetadataForNonGeographicalRegion(key)
 }
 
-func readFromSupportedRegions(key string) (struct{}, bool) {
-	v, ok := supportedRegions[key]
+func (u *PhoneNumberUtil) readFromSupportedRegions(key string) (struct{}, bool) {
+	v, ok := u.currentMetadataRegistry().supportedRegions[key]
 	return v, ok
 }
 
-func writeToSupportedRegions(key string, val struct{}) {
-	supportedRegions[key] = val
-}
-
-func deleteFromSupportedRegions(key string) {
-	delete(supportedRegions, key)
-}
-
-func readFromCCsForNonGeographicalRegion(key int) (struct{}, bool) {
-	v, ok := countryCodesForNonGeographicalRegion[key]
+func (u *PhoneNumberUtil) readFromCCsForNonGeographicalRegion(key int) (struct{}, bool) {
+	v, ok := u.currentMetadataRegistry().countryCodesForNonGeographicalRegion[key]
 	return v, ok
 }
 
-func writeToCCsForNonGeographicalRegion(key int, val struct{}) {
-	countryCodesForNonGeographicalRegion[key] = val
-}
-
-func loadMetadataFromFile(
-	regionCode string,
-	countryCallingCode int) error {
-
-	metadataCollection, err := metadataCollection()
-	if err != nil {
-		return err
-	} else if currMetadataColl == nil {
-		currMetadataColl = metadataCollection
-	}
-
-	metadataList := metadataCollection.GetMetadata()
-	if len(metadataList) == 0 {
-		return ErrEmptyMetadata
-	}
-
-	for _, meta := range metadataList {
-		region := meta.GetId()
-		if region == "001" {
-			// it's a non geographical entity
-			writeToCountryCodeToNonGeographicalMetadataMap(
-				int(meta.GetCountryCode()),
-				meta,
-			)
-		} else {
-			writeToRegionToMetadataMap(region, meta)
-		}
-	}
-	return nil
+func (u *PhoneNumberUtil) readFromCountryCodeToRegion(key int) ([]string, bool) {
+	v, ok := u.currentMetadataRegistry().countryCodeToRegion[key]
+	return v, ok
 }
 
-var (
-	currMetadataColl *PhoneMetadataCollection
-	reloadMetadata   = true
-)
-
-func metadataCollection() (*PhoneMetadataCollection, error) {
-	if !reloadMetadata {
-		return currMetadataColl, nil
-	}
-
-	var metadataCollection = &PhoneMetadataCollection{}
-	err := proto.Unmarshal(metaData, metadataCollection)
-	reloadMetadata = false
-	return metadataCollection, err
+// Goes back to the metadata compiled into the library, keeping any
+// overrides of u in place.
+func (u *PhoneNumberUtil) loadDefaultMetadata() error {
+	return u.updateMetadataRegistry(
+		func(current *MetadataRegistry) (*MetadataRegistry, error) {
+			return newMetadataRegistry(
+				builtinMetadataRegistry.index,
+				builtinMetadataRegistry.baseCountryCodeToRegion,
+				current.getOverrides())
+		})
 }
 
 // Attempts to extract a possible number from the string passed in.
@@ -871,8 +865,8 @@ func ConvertAlphaCharactersInNumber(number string) string {
 //   - most non-geographical numbers have no area codes, including numbers from
 //     non-geographical entities
 //   - some geographical numbers have no area codes.
-func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+func (u *PhoneNumberUtil) GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
+	metadata := u.getMetadataForRegion(u.GetRegionCodeForNumber(number))
 	if metadata == nil {
 		return 0
 	}
@@ -884,11 +878,11 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 		return 0
 	}
 
-	if !isNumberGeographical(number) {
+	if !u.isNumberGeographical(number) {
 		return 0
 	}
 
-	return GetLengthOfNationalDestinationCode(number)
+	return u.GetLengthOfNationalDestinationCode(number)
 }
 
 // Gets the length of the national destination code (NDC) from the
@@ -919,7 +913,7 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 //
 // Refer to the unittests to see the difference between this function and
 // GetLengthOfGeographicalAreaCode().
-func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
+func (u *PhoneNumberUtil) GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	var copiedProto *PhoneNumber
 	if len(number.GetExtension()) > 0 {
 		// We don't want to alter the proto given to us, but we don't
@@ -932,7 +926,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 		copiedProto = number
 	}
 
-	nationalSignificantNumber := Format(copiedProto, INTERNATIONAL)
+	nationalSignificantNumber := u.Format(copiedProto, INTERNATIONAL)
 	numberGroups := DIGITS_PATTERN.FindAllString(nationalSignificantNumber, -1)
 	// The pattern will start with "+COUNTRY_CODE " so the first group
 	// will always be the empty string (before the + symbol) and the
@@ -941,7 +935,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	if len(numberGroups) <= 3 {
 		return 0
 	}
-	if GetNumberType(number) == MOBILE {
+	if u.GetNumberType(number) == MOBILE {
 		// For example Argentinian mobile numbers, when formatted in
 		// the international format, are in the form of +54 9 NDC XXXX....
 		// As a result, we take the length of the third group (NDC) and
@@ -989,14 +983,78 @@ func normalizeHelper(number string,
 }
 
 // Convenience method to get a list of what regions the library has metadata for.
-func GetSupportedRegions() map[string]struct{} {
-	return supportedRegions
+// The returned map is a copy and may be modified by the caller.
+func (u *PhoneNumberUtil) GetSupportedRegions() map[string]struct{} {
+	supportedRegions := u.currentMetadataRegistry().supportedRegions
+	regions := make(map[string]struct{}, len(supportedRegions))
+	for regionCode := range supportedRegions {
+		regions[regionCode] = struct{}{}
+	}
+	return regions
 }
 
 // Convenience method to get a list of what global network calling codes
-// the library has metadata for.
-func GetSupportedGlobalNetworkCallingCodes() map[int]struct{} {
-	return countryCodesForNonGeographicalRegion
+// the library has metadata for. The returned map is a copy and may be
+// modified by the caller.
+func (u *PhoneNumberUtil) GetSupportedGlobalNetworkCallingCodes() map[int]struct{} {
+	nonGeoCodes := u.currentMetadataRegistry().countryCodesForNonGeographicalRegion
+	codes := make(map[int]struct{}, len(nonGeoCodes))
+	for code := range nonGeoCodes {
+		codes[code] = struct{}{}
+	}
+	return codes
+}
+
+// Returns the types of numbers the region has, such as MOBILE or
+// TOLL_FREE, as far as the metadata tells. FIXED_LINE_OR_MOBILE and
+// UNKNOWN are never included: a region with both fixed-line and mobile
+// numbers has FIXED_LINE and MOBILE. Returns an empty set for an
+// unknown region.
+func (u *PhoneNumberUtil) GetSupportedTypesForRegion(
+	regionCode string) map[PhoneNumberType]struct{} {
+
+	if !u.isValidRegionCode(regionCode) {
+		return map[PhoneNumberType]struct{}{}
+	}
+	return getSupportedTypesForMetadata(u.getMetadataForRegion(regionCode))
+}
+
+// Returns the types of numbers the non-geographical entity with the
+// country calling code has, as GetSupportedTypesForRegion does for a
+// region. Returns an empty set for an unknown country calling code.
+func (u *PhoneNumberUtil) GetSupportedTypesForNonGeoEntity(
+	countryCallingCode int) map[PhoneNumberType]struct{} {
+
+	metadata := u.getMetadataForNonGeographicalRegion(countryCallingCode)
+	if metadata == nil {
+		return map[PhoneNumberType]struct{}{}
+	}
+	return getSupportedTypesForMetadata(metadata)
+}
+
+// Returns the types of numbers described in the metadata.
+func getSupportedTypesForMetadata(metadata *PhoneMetadata) map[PhoneNumberType]struct{} {
+	types := make(map[PhoneNumberType]struct{})
+	for typ := FIXED_LINE; typ < UNKNOWN; typ++ {
+		if typ == FIXED_LINE_OR_MOBILE {
+			// Not a type of its own; regions with such numbers have both
+			// FIXED_LINE and MOBILE.
+			continue
+		}
+		if descHasData(getNumberDescByType(metadata, typ)) {
+			types[typ] = struct{}{}
+		}
+	}
+	return types
+}
+
+// Returns whether the description describes any numbers. Generated
+// metadata describes types without numbers with the possible length -1
+// only.
+func descHasData(desc *PhoneNumberDesc) bool {
+	return desc != nil && (desc.ExampleNumber != nil ||
+		desc.NationalNumberPattern != nil ||
+		descHasPossibleNumberData(desc))
 }
 
 // Helper function to check if the national prefix formatting rule has the
@@ -1015,8 +1073,18 @@ func formattingRuleHasFirstGroupOnly(nationalPrefixFormattingRule string) bool {
 // overlap for geocodable and non-geocodable numbers. Also, if new phone
 // number types were added, we should check if this other method should be
 // updated too.
-func isNumberGeographical(phoneNumber *PhoneNumber) bool {
-	numberType := GetNumberType(phoneNumber)
+func (u *PhoneNumberUtil) isNumberGeographical(phoneNumber *PhoneNumber) bool {
+	return IsNumberGeographicalForType(
+		u.GetNumberType(phoneNumber), int(phoneNumber.GetCountryCode()))
+}
+
+// Tests whether a phone number of the given type and country calling
+// code has a geographical association, i.e. whether numbers of that
+// type can be geocoded.
+func IsNumberGeographicalForType(
+	numberType PhoneNumberType,
+	countryCallingCode int) bool {
+
 	// TODO: Include mobile phone numbers from countries like Indonesia,
 	// which has some mobile numbers that are geographical.
 	return numberType == FIXED_LINE ||
@@ -1024,14 +1092,14 @@ func isNumberGeographical(phoneNumber *PhoneNumber) bool {
 }
 
 // Helper function to check region code is not unknown or null.
-func isValidRegionCode(regionCode string) bool {
-	_, contains := readFromSupportedRegions(regionCode)
+func (u *PhoneNumberUtil) isValidRegionCode(regionCode string) bool {
+	_, contains := u.readFromSupportedRegions(regionCode)
 	return len(regionCode) != 0 && contains
 }
 
 // Helper function to check the country calling code is valid.
-func hasValidCountryCallingCode(countryCallingCode int) bool {
-	_, containsKey := CountryCodeToRegion[countryCallingCode]
+func (u *PhoneNumberUtil) hasValidCountryCallingCode(countryCallingCode int) bool {
+	_, containsKey := u.readFromCountryCodeToRegion(countryCallingCode)
 	return containsKey
 }
 
@@ -1045,7 +1113,10 @@ func hasValidCountryCallingCode(countryCallingCode int) bool {
 // otherwise invalid country calling code, we cannot work out which
 // formatting rules to apply so we return the national significant number
 // with no formatting applied.
-func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
+func (u *PhoneNumberUtil) Format(
+	number *PhoneNumber,
+	numberFormat PhoneNumberFormat) string {
+
 	if number.GetNationalNumber() == 0 && len(number.GetRawInput()) > 0 {
 		// Unparseable numbers that kept their raw input just use that.
 		// This is the only case where a number can be formatted as E164
@@ -1059,14 +1130,14 @@ func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 		}
 	}
 	var formattedNumber = builder.NewBuilder(nil)
-	FormatWithBuf(number, numberFormat, formattedNumber)
+	u.FormatWithBuf(number, numberFormat, formattedNumber)
 	return formattedNumber.String()
 }
 
 // Same as Format(PhoneNumber, PhoneNumberFormat), but accepts a mutable
 // StringBuilder as a parameter to decrease object creation when invoked
 // many times.
-func FormatWithBuf(
+func (u *PhoneNumberUtil) FormatWithBuf(
 	number *PhoneNumber,
 	numberFormat PhoneNumberFormat,
 	formattedNumber *builder.Builder) {
@@ -1085,7 +1156,7 @@ func FormatWithBuf(
 			E164,
 			formattedNumber)
 		return
-	} else if !hasValidCountryCallingCode(countryCallingCode) {
+	} else if !u.hasValidCountryCallingCode(countryCallingCode) {
 		formattedNumber.WriteString(nationalSignificantNumber)
 		return
 	}
@@ -1093,14 +1164,14 @@ func FormatWithBuf(
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is
 	// valid (which means that the region code cannot be ZZ and must
 	// be one of our supported region codes).
-	metadata := getMetadataForRegionOrCallingCode(
+	metadata := u.getMetadataForRegionOrCallingCode(
 		countryCallingCode, regionCode)
 	formattedNumber.WriteString(
-		formatNsn(nationalSignificantNumber, metadata, numberFormat))
+		u.formatNsn(nationalSignificantNumber, metadata, numberFormat))
 	maybeAppendFormattedExtension(number, metadata, numberFormat, formattedNumber)
 	prefixNumberWithCountryCallingCode(
 		countryCallingCode, numberFormat, formattedNumber)
@@ -1112,26 +1183,26 @@ func FormatWithBuf(
 // work out things like whether there should be a national prefix applied,
 // or how to format extensions, so we return the national significant
 // number with no formatting applied.
-func FormatByPattern(number *PhoneNumber,
+func (u *PhoneNumberUtil) FormatByPattern(number *PhoneNumber,
 	numberFormat PhoneNumberFormat,
 	userDefinedFormats []*NumberFormat) string {
 
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !u.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For example,
 	// for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := u.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 
 	formattedNumber := builder.NewBuilder(nil)
 
-	formattingPattern := chooseFormattingPatternForNumber(
+	formattingPattern := u.chooseFormattingPatternForNumber(
 		userDefinedFormats, nationalSignificantNumber)
 	if formattingPattern == nil {
 		// If no pattern above is matched, we format the number as a whole.
@@ -1164,7 +1235,7 @@ func FormatByPattern(number *PhoneNumber,
 			}
 		}
 		formattedNumber.WriteString(
-			formatNsnUsingPattern(
+			u.formatNsnUsingPattern(
 				nationalSignificantNumber, numFormatCopy, numberFormat))
 	}
 	maybeAppendFormattedExtension(number, metadata, numberFormat, formattedNumber)
@@ -1177,23 +1248,26 @@ func FormatByPattern(number *PhoneNumber,
 // regardless of whether the phone number already has a preferred domestic
 // carrier code stored. If carrierCode contains an empty string, returns
 // the number in national format without any carrier code.
-func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string) string {
+func (u *PhoneNumberUtil) FormatNationalNumberWithCarrierCode(
+	number *PhoneNumber,
+	carrierCode string) string {
+
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !u.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := u.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 
 	formattedNumber := builder.NewBuilder(nil)
 	formattedNumber.WriteString(
-		formatNsnWithCarrier(
+		u.formatNsnWithCarrier(
 			nationalSignificantNumber,
 			metadata,
 			NATIONAL,
@@ -1206,12 +1280,12 @@ func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string
 	return formattedNumber.String()
 }
 
-func getMetadataForRegionOrCallingCode(
+func (u *PhoneNumberUtil) getMetadataForRegionOrCallingCode(
 	countryCallingCode int, regionCode string) *PhoneMetadata {
 	if REGION_CODE_FOR_NON_GEO_ENTITY == regionCode {
-		return getMetadataForNonGeographicalRegion(countryCallingCode)
+		return u.getMetadataForNonGeographicalRegion(countryCallingCode)
 	}
-	return getMetadataForRegion(regionCode)
+	return u.getMetadataForRegion(regionCode)
 }
 
 // Formats a phone number in national format for dialing using the carrier
@@ -1224,7 +1298,7 @@ func getMetadataForRegionOrCallingCode(
 // Use formatNationalNumberWithCarrierCode instead if the carrier code
 // passed in should take precedence over the number's
 // preferredDomesticCarrierCode when formatting.
-func FormatNationalNumberWithPreferredCarrierCode(
+func (u *PhoneNumberUtil) FormatNationalNumberWithPreferredCarrierCode(
 	number *PhoneNumber,
 	fallbackCarrierCode string) string {
 
@@ -1232,20 +1306,20 @@ func FormatNationalNumberWithPreferredCarrierCode(
 	if number.GetPreferredDomesticCarrierCode() == "" {
 		pref = fallbackCarrierCode
 	}
-	return FormatNationalNumberWithCarrierCode(number, pref)
+	return u.FormatNationalNumberWithCarrierCode(number, pref)
 }
 
 // Returns a number formatted in such a way that it can be dialed from a
 // mobile phone in a specific region. If the number cannot be reached from
 // the region (e.g. some countries block toll-free numbers from being
 // called outside of the country), the method returns an empty string.
-func FormatNumberForMobileDialing(
+func (u *PhoneNumberUtil) FormatNumberForMobileDialing(
 	number *PhoneNumber,
 	regionCallingFrom string,
 	withFormatting bool) string {
 
 	countryCallingCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !u.hasValidCountryCallingCode(countryCallingCode) {
 		return number.GetRawInput() // go impl defaults to ""
 	}
 
@@ -1255,8 +1329,8 @@ func FormatNumberForMobileDialing(
 	var numberNoExt = &PhoneNumber{}
 	proto.Merge(numberNoExt, number)
 	numberNoExt.Extension = nil // can we assume this is safe? (no nil-pointer?)
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
-	numberType := GetNumberType(numberNoExt)
+	regionCode := u.GetRegionCodeForCountryCode(countryCallingCode)
+	numberType := u.GetNumberType(numberNoExt)
 	isValidNumber := numberType != UNKNOWN
 	if regionCallingFrom == regionCode {
 		isFixedLineOrMobile :=
@@ -1266,12 +1340,12 @@ func FormatNumberForMobileDialing(
 		// Carrier codes may be needed in some countries. We handle this here.
 		if regionCode == "CO" && numberType == FIXED_LINE {
 			formattedNumber =
-				FormatNationalNumberWithCarrierCode(
+				u.FormatNationalNumberWithCarrierCode(
 					numberNoExt, COLOMBIA_MOBILE_TO_FIXED_LINE_PREFIX)
 		} else if regionCode == "BR" && isFixedLineOrMobile {
 			if numberNoExt.GetPreferredDomesticCarrierCode() != "" {
 				formattedNumber =
-					FormatNationalNumberWithPreferredCarrierCode(numberNoExt, "")
+					u.FormatNationalNumberWithPreferredCarrierCode(numberNoExt, "")
 			} else {
 				// Brazilian fixed line and mobile numbers need to be dialed
 				// with a carrier code when called within Brazil. Without
@@ -1287,20 +1361,20 @@ func FormatNumberForMobileDialing(
 			// result, we add it back here
 			// if it is a valid regular length phone number.
 			formattedNumber =
-				GetNddPrefixForRegion(regionCode, true /* strip non-digits */) +
-					" " + Format(numberNoExt, NATIONAL)
+				u.GetNddPrefixForRegion(regionCode, true /* strip non-digits */) +
+					" " + u.Format(numberNoExt, NATIONAL)
 		} else if countryCallingCode == NANPA_COUNTRY_CODE {
 			// For NANPA countries, we output international format for
 			// numbers that can be dialed internationally, since that
 			// always works, except for numbers which might potentially be
 			// short numbers, which are always dialled in national format.
-			regionMetadata := getMetadataForRegion(regionCallingFrom)
-			if canBeInternationallyDialled(numberNoExt) &&
-				!isShorterThanPossibleNormalNumber(regionMetadata,
+			regionMetadata := u.getMetadataForRegion(regionCallingFrom)
+			if u.canBeInternationallyDialled(numberNoExt) &&
+				!u.isShorterThanPossibleNormalNumber(regionMetadata,
 					GetNationalSignificantNumber(numberNoExt)) {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+				formattedNumber = u.Format(numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = u.Format(numberNoExt, NATIONAL)
 			}
 		} else {
 			// For non-geographical countries, and Mexican and Chilean fixed
@@ -1323,21 +1397,21 @@ func FormatNumberForMobileDialing(
 				((regionCode == "MX" ||
 					regionCode == "CL") &&
 					isFixedLineOrMobile) &&
-					canBeInternationallyDialled(numberNoExt) {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+					u.canBeInternationallyDialled(numberNoExt) {
+				formattedNumber = u.Format(numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = u.Format(numberNoExt, NATIONAL)
 			}
 		}
-	} else if isValidNumber && canBeInternationallyDialled(numberNoExt) {
+	} else if isValidNumber && u.canBeInternationallyDialled(numberNoExt) {
 		// We assume that short numbers are not diallable from outside
 		// their region, so if a number is not a valid regular length
 		// phone number, we treat it as if it cannot be internationally
 		// dialled.
 		if withFormatting {
-			return Format(numberNoExt, INTERNATIONAL)
+			return u.Format(numberNoExt, INTERNATIONAL)
 		}
-		return Format(numberNoExt, E164)
+		return u.Format(numberNoExt, E164)
 	}
 	if withFormatting {
 		return formattedNumber
@@ -1360,25 +1434,25 @@ func FormatNumberForMobileDialing(
 // In those cases, no international prefix is used. For regions which have
 // multiple international prefixes, the number in its INTERNATIONAL format
 // will be returned instead.
-func FormatOutOfCountryCallingNumber(
+func (u *PhoneNumberUtil) FormatOutOfCountryCallingNumber(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
 
-	if !isValidRegionCode(regionCallingFrom) {
-		return Format(number, INTERNATIONAL)
+	if !u.isValidRegionCode(regionCallingFrom) {
+		return u.Format(number, INTERNATIONAL)
 	}
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !u.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	if countryCallingCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if u.IsNANPACountry(regionCallingFrom) {
 			// For NANPA regions, return the national format for these
 			// regions but prefix it with the country calling code.
-			return strconv.Itoa(countryCallingCode) + " " + Format(number, NATIONAL)
+			return strconv.Itoa(countryCallingCode) + " " + u.Format(number, NATIONAL)
 		}
-	} else if countryCallingCode == getCountryCodeForValidRegion(regionCallingFrom) {
+	} else if countryCallingCode == u.getCountryCodeForValidRegion(regionCallingFrom) {
 		// If regions share a country calling code, the country calling
 		// code need not be dialled. This also applies when dialling
 		// within a region, so this if clause covers both these cases.
@@ -1388,10 +1462,10 @@ func FormatOutOfCountryCallingNumber(
 		// case for now and for those cases return the version including
 		// country calling code.
 		// Details here: http://www.petitfute.com/voyage/225-info-pratiques-reunion
-		return Format(number, NATIONAL)
+		return u.Format(number, NATIONAL)
 	}
 	// Metadata cannot be null because we checked 'isValidRegionCode()' above.
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := u.getMetadataForRegion(regionCallingFrom)
 	internationalPrefix := metadataForRegionCallingFrom.GetInternationalPrefix()
 
 	// For regions that have multiple international prefixes, the
@@ -1405,12 +1479,12 @@ func FormatOutOfCountryCallingNumber(
 		internationalPrefixForFormatting = metPref
 	}
 
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
 	metadataForRegion :=
-		getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+		u.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 	formattedNationalNumber :=
-		formatNsn(
+		u.formatNsn(
 			nationalSignificantNumber, metadataForRegion, INTERNATIONAL)
 	formattedNumber := builder.NewBuilder([]byte(formattedNationalNumber))
 	maybeAppendFormattedExtension(number, metadataForRegion, INTERNATIONAL,
@@ -1447,36 +1521,39 @@ func FormatOutOfCountryCallingNumber(
 //
 // Note this method guarantees no digit will be inserted, removed or
 // modified as a result of formatting.
-func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) string {
+func (u *PhoneNumberUtil) FormatInOriginalFormat(
+	number *PhoneNumber,
+	regionCallingFrom string) string {
+
 	rawInput := number.GetRawInput()
 	if len(rawInput) == 0 &&
-		(hasUnexpectedItalianLeadingZero(number) ||
-			!hasFormattingPatternForNumber(number)) {
+		(u.hasUnexpectedItalianLeadingZero(number) ||
+			!u.hasFormattingPatternForNumber(number)) {
 		// We check if we have the formatting pattern because without that, we might format the number
 		// as a group without national prefix.
 		return rawInput
 	}
 	if number.GetCountryCodeSource() == 0 {
-		return Format(number, NATIONAL)
+		return u.Format(number, NATIONAL)
 	}
 	var formattedNumber string
 	switch number.GetCountryCodeSource() {
 	case PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)
+		formattedNumber = u.Format(number, INTERNATIONAL)
 	case PhoneNumber_FROM_NUMBER_WITH_IDD:
-		formattedNumber = FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		formattedNumber = u.FormatOutOfCountryCallingNumber(number, regionCallingFrom)
 	case PhoneNumber_FROM_NUMBER_WITHOUT_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)[1:]
+		formattedNumber = u.Format(number, INTERNATIONAL)[1:]
 	case PhoneNumber_FROM_DEFAULT_COUNTRY:
 		// Fall-through to default case.
 		fallthrough
 	default:
-		regionCode := GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+		regionCode := u.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
 		// We strip non-digits from the NDD here, and from the raw
 		// input later, so that we can compare them easily.
-		nationalPrefix := GetNddPrefixForRegion(
+		nationalPrefix := u.GetNddPrefixForRegion(
 			regionCode, true /* strip non-digits */)
-		nationalFormat := Format(number, NATIONAL)
+		nationalFormat := u.Format(number, NATIONAL)
 		if len(nationalPrefix) == 0 {
 			// If the region doesn't have a national prefix at all,
 			// we can safely return the national format without worrying
@@ -1486,16 +1563,16 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		}
 		// Otherwise, we check if the original number was entered with
 		// a national prefix.
-		if rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode) {
+		if u.rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode) {
 			// If so, we can safely return the national format.
 			formattedNumber = nationalFormat
 		}
 		// Metadata cannot be null here because GetNddPrefixForRegion()
 		// (above) returns null if there is no metadata for the region.
-		metadata := getMetadataForRegion(regionCode)
+		metadata := u.getMetadataForRegion(regionCode)
 		nationalNumber := GetNationalSignificantNumber(number)
 		formatRule :=
-			chooseFormattingPatternForNumber(metadata.GetNumberFormat(), nationalNumber)
+			u.chooseFormattingPatternForNumber(metadata.GetNumberFormat(), nationalNumber)
 		// The format rule could still be null here if the national
 		// number was 0 and there was no raw input (this should not
 		// be possible for numbers generated by the phonenumber library
@@ -1530,7 +1607,7 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		proto.Merge(numFormatCopy, formatRule)
 		numFormatCopy.NationalPrefixFormattingRule = nil
 		var numberFormats = []*NumberFormat{numFormatCopy}
-		formattedNumber = FormatByPattern(number, NATIONAL, numberFormats)
+		formattedNumber = u.FormatByPattern(number, NATIONAL, numberFormats)
 		break
 	}
 	rawInput = number.GetRawInput()
@@ -1550,7 +1627,9 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 // Check if rawInput, which is assumed to be in the national format, has
 // a national prefix. The national prefix is assumed to be in digits-only
 // form.
-func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string) bool {
+func (u *PhoneNumberUtil) rawInputContainsNationalPrefix(
+	rawInput, nationalPrefix, regionCode string) bool {
+
 	normalizedNationalNumber := NormalizeDigitsOnly(rawInput)
 	if strings.HasPrefix(normalizedNationalNumber, nationalPrefix) {
 		// Some Japanese numbers (e.g. 00777123) might be mistaken to
@@ -1558,11 +1637,11 @@ func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string)
 		// (e.g. 0777123) if we just do prefix matching. To tackle that,
 		// we check the validity of the number if the assumed national
 		// prefix is removed (777123 won't be valid in Japan).
-		num, err := Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
+		num, err := u.Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
 		if err != nil {
 			return false
 		}
-		return IsValidNumber(num)
+		return u.IsValidNumber(num)
 
 	}
 	return false
@@ -1571,21 +1650,21 @@ func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string)
 // Returns true if a number is from a region whose national significant
 // number couldn't contain a leading zero, but has the italian_leading_zero
 // field set to true.
-func hasUnexpectedItalianLeadingZero(number *PhoneNumber) bool {
+func (u *PhoneNumberUtil) hasUnexpectedItalianLeadingZero(number *PhoneNumber) bool {
 	return number.GetItalianLeadingZero() &&
-		!isLeadingZeroPossible(int(number.GetCountryCode()))
+		!u.isLeadingZeroPossible(int(number.GetCountryCode()))
 }
 
-func hasFormattingPatternForNumber(number *PhoneNumber) bool {
+func (u *PhoneNumberUtil) hasFormattingPatternForNumber(number *PhoneNumber) bool {
 	countryCallingCode := int(number.GetCountryCode())
-	phoneNumberRegion := GetRegionCodeForCountryCode(countryCallingCode)
-	metadata := getMetadataForRegionOrCallingCode(
+	phoneNumberRegion := u.GetRegionCodeForCountryCode(countryCallingCode)
+	metadata := u.getMetadataForRegionOrCallingCode(
 		countryCallingCode, phoneNumberRegion)
 	if metadata == nil {
 		return false
 	}
 	nationalNumber := GetNationalSignificantNumber(number)
-	formatRule := chooseFormattingPatternForNumber(
+	formatRule := u.chooseFormattingPatternForNumber(
 		metadata.GetNumberFormat(), nationalNumber)
 	return formatRule != nil
 }
@@ -1610,7 +1689,7 @@ func hasFormattingPatternForNumber(number *PhoneNumber) bool {
 //     in the raw input before these digits. Normally people group the
 //     first three digits together so this is not a huge problem - and will
 //     be fixed if it proves to be so.
-func FormatOutOfCountryKeepingAlphaChars(
+func (u *PhoneNumberUtil) FormatOutOfCountryKeepingAlphaChars(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
 
@@ -1619,10 +1698,10 @@ func FormatOutOfCountryKeepingAlphaChars(
 	// because there aren't any. In this case, we return
 	// formatOutOfCountryCallingNumber.
 	if len(rawInput) == 0 {
-		return FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		return u.FormatOutOfCountryCallingNumber(number, regionCallingFrom)
 	}
 	countryCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCode) {
+	if !u.hasValidCountryCallingCode(countryCode) {
 		return rawInput
 	}
 	// Strip any prefix such as country calling code, IDD, that was
@@ -1642,15 +1721,15 @@ func FormatOutOfCountryKeepingAlphaChars(
 			rawInput = rawInput[firstNationalNumberDigit:]
 		}
 	}
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := u.getMetadataForRegion(regionCallingFrom)
 	if countryCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if u.IsNANPACountry(regionCallingFrom) {
 			return strconv.Itoa(countryCode) + " " + rawInput
 		}
 	} else if metadataForRegionCallingFrom != nil &&
-		countryCode == getCountryCodeForValidRegion(regionCallingFrom) {
+		countryCode == u.getCountryCodeForValidRegion(regionCallingFrom) {
 		formattingPattern :=
-			chooseFormattingPatternForNumber(
+			u.chooseFormattingPatternForNumber(
 				metadataForRegionCallingFrom.GetNumberFormat(),
 				nationalNumber)
 		if formattingPattern == nil {
@@ -1671,7 +1750,7 @@ func FormatOutOfCountryKeepingAlphaChars(
 		// decide whether a national prefix needs to be used, since we
 		// have overridden the pattern to match anything, but that is not
 		// the case in the metadata to date.
-		return formatNsnUsingPattern(rawInput, newFormat, NATIONAL)
+		return u.formatNsnUsingPattern(rawInput, newFormat, NATIONAL)
 	}
 	var internationalPrefixForFormatting = ""
 	// If an unsupported region-calling-from is entered, or a country
@@ -1687,9 +1766,9 @@ func FormatOutOfCountryKeepingAlphaChars(
 		}
 	}
 	var formattedNumber = builder.NewBuilder([]byte(rawInput))
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadataForRegion *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	var metadataForRegion *PhoneMetadata = u.getMetadataForRegionOrCallingCode(countryCode, regionCode)
 	maybeAppendFormattedExtension(number, metadataForRegion,
 		INTERNATIONAL, formattedNumber)
 	if len(internationalPrefixForFormatting) > 0 {
@@ -1772,9 +1851,9 @@ func prefixNumberWithCountryCallingCode(
 }
 
 // Simple wrapper of formatNsn for the common case of no carrier code.
-func formatNsn(
+func (u *PhoneNumberUtil) formatNsn(
 	number string, metadata *PhoneMetadata, numberFormat PhoneNumberFormat) string {
-	return formatNsnWithCarrier(number, metadata, numberFormat, "")
+	return u.formatNsnWithCarrier(number, metadata, numberFormat, "")
 }
 
 // Note in some regions, the national number can be written in two
@@ -1783,7 +1862,7 @@ func formatNsn(
 // here is used to specify which format to use for those cases. If a
 // carrierCode is specified, this will be inserted into the formatted
 // string to replace $CC.
-func formatNsnWithCarrier(
+func (u *PhoneNumberUtil) formatNsnWithCarrier(
 	number string,
 	metadata *PhoneMetadata,
 	numberFormat PhoneNumberFormat,
@@ -1796,16 +1875,16 @@ func formatNsnWithCarrier(
 	if len(intlNumberFormats) == 0 || numberFormat == NATIONAL {
 		availableFormats = metadata.GetNumberFormat()
 	}
-	var formattingPattern *NumberFormat = chooseFormattingPatternForNumber(
+	var formattingPattern *NumberFormat = u.chooseFormattingPatternForNumber(
 		availableFormats, number)
 	if formattingPattern == nil {
 		return number
 	}
-	return formatNsnUsingPatternWithCarrier(
+	return u.formatNsnUsingPatternWithCarrier(
 		number, formattingPattern, numberFormat, carrierCode)
 }
 
-func chooseFormattingPatternForNumber(
+func (u *PhoneNumberUtil) chooseFormattingPatternForNumber(
 	availableFormats []*NumberFormat,
 	nationalNumber string) *NumberFormat {
 
@@ -1814,10 +1893,10 @@ func chooseFormattingPatternForNumber(
 		size := len(leadingDigitsPattern)
 
 		patP := `^(?:` + numFormat.GetPattern() + `)$` // Strictly match
-		m, ok := readFromRegexCache(numFormat.GetPattern())
+		m, ok := u.readFromRegexCache(patP)
 		if !ok {
 			m = regexp.MustCompile(patP)
-			writeToRegexCache(patP, m)
+			u.writeToRegexCache(patP, m)
 		}
 
 		if size == 0 {
@@ -1831,11 +1910,11 @@ func chooseFormattingPatternForNumber(
 
 		// We always use the last leading_digits_pattern, as it is the
 		// most detailed.
-		reg, ok := readFromRegexCache(leadingDigitsPattern[size-1])
+		reg, ok := u.readFromRegexCache(leadingDigitsPattern[size-1])
 		if !ok {
 			pat := leadingDigitsPattern[size-1]
 			reg = regexp.MustCompile(pat)
-			writeToRegexCache(pat, reg)
+			u.writeToRegexCache(pat, reg)
 		}
 
 		inds := reg.FindStringIndex(nationalNumber)
@@ -1847,28 +1926,28 @@ func chooseFormattingPatternForNumber(
 }
 
 // Simple wrapper of formatNsnUsingPattern for the common case of no carrier code.
-func formatNsnUsingPattern(
+func (u *PhoneNumberUtil) formatNsnUsingPattern(
 	nationalNumber string,
 	formattingPattern *NumberFormat,
 	numberFormat PhoneNumberFormat) string {
-	return formatNsnUsingPatternWithCarrier(
+	return u.formatNsnUsingPatternWithCarrier(
 		nationalNumber, formattingPattern, numberFormat, "")
 }
 
 // Note that carrierCode is optional - if null or an empty string, no
 // carrier code replacement will take place.
-func formatNsnUsingPatternWithCarrier(
+func (u *PhoneNumberUtil) formatNsnUsingPatternWithCarrier(
 	nationalNumber string,
 	formattingPattern *NumberFormat,
 	numberFormat PhoneNumberFormat,
 	carrierCode string) string {
 
 	numberFormatRule := formattingPattern.GetFormat()
-	m, ok := readFromRegexCache(formattingPattern.GetPattern())
+	m, ok := u.readFromRegexCache(formattingPattern.GetPattern())
 	if !ok {
 		pat := formattingPattern.GetPattern()
 		m = regexp.MustCompile(pat)
-		writeToRegexCache(pat, m)
+		u.writeToRegexCache(pat, m)
 	}
 
 	formattedNationalNumber := ""
@@ -1935,22 +2014,29 @@ func formatNsnUsingPatternWithCarrier(
 	return formattedNationalNumber
 }
 
-// Gets a valid number for the specified region.
-func GetExampleNumber(regionCode string) *PhoneNumber {
-	return GetExampleNumberForType(regionCode, FIXED_LINE)
+// Gets a valid number for the specified region. Returns nil if the
+// metadata has no example number for the region, as with the lite
+// metadata of builds with the libphonenumber_lite tag.
+func (u *PhoneNumberUtil) GetExampleNumber(regionCode string) *PhoneNumber {
+	return u.GetExampleNumberForType(regionCode, FIXED_LINE)
 }
 
 // Gets a valid number for the specified region and number type.
-func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumber {
+// Returns nil if the metadata has no example number of the type for the
+// region, as with lite metadata.
+func (u *PhoneNumberUtil) GetExampleNumberForType(
+	regionCode string,
+	typ PhoneNumberType) *PhoneNumber {
+
 	// Check the region code is valid.
-	if !isValidRegionCode(regionCode) {
+	if !u.isValidRegionCode(regionCode) {
 		return nil
 	}
 	//PhoneNumberDesc (pointer?)
-	var desc = getNumberDescByType(getMetadataForRegion(regionCode), typ)
+	var desc = getNumberDescByType(u.getMetadataForRegion(regionCode), typ)
 	exNum := desc.GetExampleNumber()
 	if len(exNum) > 0 {
-		num, err := Parse(exNum, regionCode)
+		num, err := u.Parse(exNum, regionCode)
 		if err != nil {
 			return nil
 		}
@@ -1959,10 +2045,73 @@ func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumbe
 	return nil
 }
 
+// Gets an invalid number for the specified region, derived from its
+// example fixed-line number. Returns nil if the region is unknown or no
+// invalid number can be derived.
+func (u *PhoneNumberUtil) GetInvalidExampleNumber(regionCode string) *PhoneNumber {
+	return u.GetInvalidExampleNumberForType(regionCode, FIXED_LINE)
+}
+
+// Gets an invalid number for the specified region, derived from its
+// example number of the type. Returns nil if the region is unknown, has
+// no example number of the type, or no invalid number can be derived.
+func (u *PhoneNumberUtil) GetInvalidExampleNumberForType(
+	regionCode string,
+	typ PhoneNumberType) *PhoneNumber {
+
+	if !u.isValidRegionCode(regionCode) {
+		return nil
+	}
+	desc := getNumberDescByType(u.getMetadataForRegion(regionCode), typ)
+	exNum := desc.GetExampleNumber()
+	if len(exNum) == 0 {
+		return nil
+	}
+	countryCode := int32(u.GetCountryCodeForRegion(regionCode))
+	tryNumber := func(numberToTry string, mustBePossible bool) *PhoneNumber {
+		num, err := u.Parse(numberToTry, regionCode)
+		// Changed digits may make an international prefix, and so
+		// a number of another country.
+		if err != nil || num.GetCountryCode() != countryCode || u.IsValidNumber(num) ||
+			mustBePossible && u.IsPossibleNumberWithReason(num) != IS_POSSIBLE {
+			return nil
+		}
+		return num
+	}
+	for length := len(exNum) - 1; length >= MIN_LENGTH_FOR_NSN; length-- {
+		if num := tryNumber(exNum[:length], true); num != nil {
+			return num
+		}
+	}
+	// Changing the leading digits is the likeliest to leave the ranges
+	// of valid numbers.
+	digits := []byte(exNum)
+	for i, digit := range digits {
+		for d := byte('0'); d <= '9'; d++ {
+			if d == digit {
+				continue
+			}
+			digits[i] = d
+			if num := tryNumber(string(digits), true); num != nil {
+				return num
+			}
+		}
+		digits[i] = digit
+	}
+	for length := len(exNum) - 1; length >= MIN_LENGTH_FOR_NSN; length-- {
+		if num := tryNumber(exNum[:length], false); num != nil {
+			return num
+		}
+	}
+	return nil
+}
+
 // Gets a valid number for the specified country calling code for a
 // non-geographical entity.
-func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
-	var metadata *PhoneMetadata = getMetadataForNonGeographicalRegion(countryCallingCode)
+func (u *PhoneNumberUtil) GetExampleNumberForNonGeoEntity(
+	countryCallingCode int) *PhoneNumber {
+
+	var metadata *PhoneMetadata = u.getMetadataForNonGeographicalRegion(countryCallingCode)
 	if metadata == nil {
 		return nil
 	}
@@ -1974,7 +2123,7 @@ func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
 
 	exNum := desc.GetExampleNumber()
 	if len(exNum) > 0 {
-		num, err := Parse("+"+strconv.Itoa(countryCallingCode)+exNum, "ZZ")
+		num, err := u.Parse("+"+strconv.Itoa(countryCallingCode)+exNum, "ZZ")
 		if err != nil {
 			return nil
 		}
@@ -2040,60 +2189,60 @@ func getNumberDescByType(
 }
 
 // Gets the type of a phone number.
-func GetNumberType(number *PhoneNumber) PhoneNumberType {
-	var regionCode string = GetRegionCodeForNumber(number)
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(
+func (u *PhoneNumberUtil) GetNumberType(number *PhoneNumber) PhoneNumberType {
+	var regionCode string = u.GetRegionCodeForNumber(number)
+	var metadata *PhoneMetadata = u.getMetadataForRegionOrCallingCode(
 		int(number.GetCountryCode()), regionCode)
 	if metadata == nil {
 		return UNKNOWN
 	}
 	var nationalSignificantNumber = GetNationalSignificantNumber(number)
-	return getNumberTypeHelper(nationalSignificantNumber, metadata)
+	return u.getNumberTypeHelper(nationalSignificantNumber, metadata)
 }
 
-func getNumberTypeHelper(
+func (u *PhoneNumberUtil) getNumberTypeHelper(
 	nationalNumber string,
 	metadata *PhoneMetadata) PhoneNumberType {
 
 	var generalNumberDesc *PhoneNumberDesc = metadata.GetGeneralDesc()
 	var natNumPat = generalNumberDesc.GetNationalNumberPattern()
 	if len(natNumPat) == 0 ||
-		!isNumberMatchingDesc(nationalNumber, generalNumberDesc) {
+		!u.isNumberMatchingDesc(nationalNumber, generalNumberDesc) {
 		return UNKNOWN
 	}
 
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPremiumRate()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetPremiumRate()) {
 		return PREMIUM_RATE
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetTollFree()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetTollFree()) {
 		return TOLL_FREE
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetSharedCost()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetSharedCost()) {
 		return SHARED_COST
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetVoip()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetVoip()) {
 		return VOIP
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPersonalNumber()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetPersonalNumber()) {
 		return PERSONAL_NUMBER
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPager()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetPager()) {
 		return PAGER
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetUan()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetUan()) {
 		return UAN
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetVoicemail()) {
+	if u.isNumberMatchingDesc(nationalNumber, metadata.GetVoicemail()) {
 		return VOICEMAIL
 	}
 
-	var isFixedLine = isNumberMatchingDesc(
+	var isFixedLine = u.isNumberMatchingDesc(
 		nationalNumber, metadata.GetFixedLine())
 
 	if isFixedLine {
 		if metadata.GetSameMobileAndFixedLinePattern() {
 			return FIXED_LINE_OR_MOBILE
-		} else if isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
+		} else if u.isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
 			return FIXED_LINE_OR_MOBILE
 		}
 		return FIXED_LINE
@@ -2101,7 +2250,7 @@ func getNumberTypeHelper(
 	// Otherwise, test to see if the number is mobile. Only do this if
 	// certain that the patterns for mobile and fixed line aren't the same.
 	if !metadata.GetSameMobileAndFixedLinePattern() &&
-		isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
+		u.isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
 		return MOBILE
 	}
 	return UNKNOWN
@@ -2109,20 +2258,22 @@ func getNumberTypeHelper(
 
 // Returns the metadata for the given region code or nil if the region
 // code is invalid or unknown.
-func getMetadataForRegion(regionCode string) *PhoneMetadata {
-	if !isValidRegionCode(regionCode) {
+func (u *PhoneNumberUtil) getMetadataForRegion(regionCode string) *PhoneMetadata {
+	if !u.isValidRegionCode(regionCode) {
 		return nil
 	}
-	val, _ := readFromRegionToMetadataMap(regionCode)
+	val, _ := u.readFromRegionToMetadataMap(regionCode)
 	return val
 }
 
-func getMetadataForNonGeographicalRegion(countryCallingCode int) *PhoneMetadata {
-	_, ok := CountryCodeToRegion[countryCallingCode]
+func (u *PhoneNumberUtil) getMetadataForNonGeographicalRegion(
+	countryCallingCode int) *PhoneMetadata {
+
+	_, ok := u.readFromCountryCodeToRegion(countryCallingCode)
 	if !ok {
 		return nil
 	}
-	val, _ := readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
+	val, _ := u.readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
 	return val
 }
 
@@ -2140,15 +2291,18 @@ func isNumberPossibleLengthForDesc(
 	return false
 }
 
-func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
+func (u *PhoneNumberUtil) isNumberMatchingDesc(
+	nationalNumber string,
+	numberDesc *PhoneNumberDesc) bool {
+
 	if isNumberPossibleLengthForDesc(nationalNumber, numberDesc) == false {
 		return false
 	}
 	patP := "^(?:" + numberDesc.GetNationalNumberPattern() + ")$" // Strictly match
-	pat, ok := readFromRegexCache(patP)
+	pat, ok := u.readFromRegexCache(patP)
 	if !ok {
 		pat = regexp.MustCompile(patP)
-		writeToRegexCache(patP, pat)
+		u.writeToRegexCache(patP, pat)
 	}
 	return pat.MatchString(nationalNumber)
 
@@ -2157,9 +2311,9 @@ func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bo
 // Tests whether a phone number matches a valid pattern. Note this doesn't
 // verify the number is actually in use, which is impossible to tell by
 // just looking at a number itself.
-func IsValidNumber(number *PhoneNumber) bool {
-	var regionCode string = GetRegionCodeForNumber(number)
-	return IsValidNumberForRegion(number, regionCode)
+func (u *PhoneNumberUtil) IsValidNumber(number *PhoneNumber) bool {
+	var regionCode string = u.GetRegionCodeForNumber(number)
+	return u.IsValidNumberForRegion(number, regionCode)
 }
 
 // Tests whether a phone number is valid for a certain region. Note this
@@ -2174,13 +2328,16 @@ func IsValidNumber(number *PhoneNumber) bool {
 // example, this method will mark numbers from British Crown dependencies
 // such as the Isle of Man as invalid for the region "GB" (United Kingdom),
 // since it has its own region code, "IM", which may be undesirable.
-func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
+func (u *PhoneNumberUtil) IsValidNumberForRegion(
+	number *PhoneNumber,
+	regionCode string) bool {
+
 	var countryCode int = int(number.GetCountryCode())
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(
+	var metadata *PhoneMetadata = u.getMetadataForRegionOrCallingCode(
 		countryCode, regionCode)
 	if metadata == nil ||
 		(REGION_CODE_FOR_NON_GEO_ENTITY != regionCode &&
-			countryCode != getCountryCodeForValidRegion(regionCode)) {
+			countryCode != u.getCountryCodeForValidRegion(regionCode)) {
 		// Either the region code was invalid, or the country calling
 		// code for this number does not match that of the region code.
 		return false
@@ -2196,24 +2353,24 @@ func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
 		return numberLength > MIN_LENGTH_FOR_NSN &&
 			numberLength <= MAX_LENGTH_FOR_NSN
 	}
-	return getNumberTypeHelper(nationalSignificantNumber, metadata) != UNKNOWN
+	return u.getNumberTypeHelper(nationalSignificantNumber, metadata) != UNKNOWN
 }
 
 // Returns the region where a phone number is from. This could be used for
 // geocoding at the region level.
-func GetRegionCodeForNumber(number *PhoneNumber) string {
+func (u *PhoneNumberUtil) GetRegionCodeForNumber(number *PhoneNumber) string {
 	var countryCode int = int(number.GetCountryCode())
-	var regions []string = CountryCodeToRegion[countryCode]
+	regions, _ := u.readFromCountryCodeToRegion(countryCode)
 	if len(regions) == 0 {
 		return ""
 	}
 	if len(regions) == 1 {
 		return regions[0]
 	}
-	return getRegionCodeForNumberFromRegionList(number, regions)
+	return u.getRegionCodeForNumberFromRegionList(number, regions)
 }
 
-func getRegionCodeForNumberFromRegionList(
+func (u *PhoneNumberUtil) getRegionCodeForNumberFromRegionList(
 	number *PhoneNumber,
 	regionCodes []string) string {
 
@@ -2222,18 +2379,18 @@ func getRegionCodeForNumberFromRegionList(
 		// If leadingDigits is present, use this. Otherwise, do
 		// full validation. Metadata cannot be null because the
 		// region codes come from the country calling code map.
-		var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+		var metadata *PhoneMetadata = u.getMetadataForRegion(regionCode)
 		if len(metadata.GetLeadingDigits()) > 0 {
 			patP := "^(?:" + metadata.GetLeadingDigits() + ")" // Non capturing grouping to support OR'ed alternatives (e.g. 555|1[78]|2)
-			pat, ok := readFromRegexCache(patP)
+			pat, ok := u.readFromRegexCache(patP)
 			if !ok {
 				pat = regexp.MustCompile(patP)
-				writeToRegexCache(patP, pat)
+				u.writeToRegexCache(patP, pat)
 			}
 			if pat.MatchString(nationalNumber) {
 				return regionCode
 			}
-		} else if getNumberTypeHelper(nationalNumber, metadata) != UNKNOWN {
+		} else if u.getNumberTypeHelper(nationalNumber, metadata) != UNKNOWN {
 			return regionCode
 		}
 	}
@@ -2248,8 +2405,8 @@ func getRegionCodeForNumberFromRegionList(
 // (such as in the case of non-geographical calling codes like 800) the
 // value "001" will be returned (corresponding to the value for World in
 // the UN M.49 schema).
-func GetRegionCodeForCountryCode(countryCallingCode int) string {
-	var regionCodes []string = CountryCodeToRegion[countryCallingCode]
+func (u *PhoneNumberUtil) GetRegionCodeForCountryCode(countryCallingCode int) string {
+	regionCodes, _ := u.readFromCountryCodeToRegion(countryCallingCode)
 	if len(regionCodes) == 0 {
 		return UNKNOWN_REGION
 	}
@@ -2260,25 +2417,25 @@ func GetRegionCodeForCountryCode(countryCallingCode int) string {
 // calling code. For non-geographical country calling codes, the region
 // code 001 is returned. Also, in the case of no region code being found,
 // an empty list is returned.
-func GetRegionCodesForCountryCode(countryCallingCode int) []string {
-	var regionCodes []string = CountryCodeToRegion[countryCallingCode]
+func (u *PhoneNumberUtil) GetRegionCodesForCountryCode(countryCallingCode int) []string {
+	regionCodes, _ := u.readFromCountryCodeToRegion(countryCallingCode)
 	return regionCodes
 }
 
 // Returns the country calling code for a specific region. For example, this
 // would be 1 for the United States, and 64 for New Zealand.
-func GetCountryCodeForRegion(regionCode string) int {
-	if !isValidRegionCode(regionCode) {
+func (u *PhoneNumberUtil) GetCountryCodeForRegion(regionCode string) int {
+	if !u.isValidRegionCode(regionCode) {
 		return 0
 	}
-	return getCountryCodeForValidRegion(regionCode)
+	return u.getCountryCodeForValidRegion(regionCode)
 }
 
 // Returns the country calling code for a specific region. For example,
 // this would be 1 for the United States, and 64 for New Zealand. Assumes
 // the region is already valid.
-func getCountryCodeForValidRegion(regionCode string) int {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+func (u *PhoneNumberUtil) getCountryCodeForValidRegion(regionCode string) int {
+	var metadata *PhoneMetadata = u.getMetadataForRegion(regionCode)
 	return int(metadata.GetCountryCode())
 }
 
@@ -2292,8 +2449,11 @@ func getCountryCodeForValidRegion(regionCode string) int {
 // regions, the national dialling prefix is used only for certain types
 // of numbers. Use the library's formatting functions to prefix the
 // national prefix when required.
-func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+func (u *PhoneNumberUtil) GetNddPrefixForRegion(
+	regionCode string,
+	stripNonDigits bool) string {
+
+	var metadata *PhoneMetadata = u.getMetadataForRegion(regionCode)
 	if metadata == nil {
 		return ""
 	}
@@ -2312,18 +2472,18 @@ func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
 
 // Checks if this is a region under the North American Numbering Plan
 // Administration (NANPA).
-func IsNANPACountry(regionCode string) bool {
-	_, ok := readFromNanpaRegions(regionCode)
+func (u *PhoneNumberUtil) IsNANPACountry(regionCode string) bool {
+	_, ok := u.readFromNanpaRegions(regionCode)
 	return ok
 }
 
 // Checks whether the country calling code is from a region whose national
 // significant number could contain a leading zero. An example of such a
 // region is Italy. Returns false if no metadata for the country is found.
-func isLeadingZeroPossible(countryCallingCode int) bool {
-	var mainMetadataForCallingCode *PhoneMetadata = getMetadataForRegionOrCallingCode(
+func (u *PhoneNumberUtil) isLeadingZeroPossible(countryCallingCode int) bool {
+	var mainMetadataForCallingCode *PhoneMetadata = u.getMetadataForRegionOrCallingCode(
 		countryCallingCode,
-		GetRegionCodeForCountryCode(countryCallingCode),
+		u.GetRegionCodeForCountryCode(countryCallingCode),
 	)
 	return mainMetadataForCallingCode.GetLeadingZeroPossible()
 }
@@ -2347,8 +2507,10 @@ func IsAlphaNumber(number string) bool {
 
 // Convenience wrapper around IsPossibleNumberWithReason(). Instead of
 // returning the reason for failure, this method returns a boolean value.
-func IsPossibleNumber(number *PhoneNumber) bool {
-	return IsPossibleNumberWithReason(number) == IS_POSSIBLE
+// Numbers that are only possible when dialed locally count as possible.
+func (u *PhoneNumberUtil) IsPossibleNumber(number *PhoneNumber) bool {
+	result := u.IsPossibleNumberWithReason(number)
+	return result == IS_POSSIBLE || result == IS_POSSIBLE_LOCAL_ONLY
 }
 
 // Helper method to check a number against a particular pattern and
@@ -2371,17 +2533,27 @@ func testNumberLengthAgainstPattern(
 	return TOO_SHORT // Does not match input start
 }
 
+// Returns whether length is one of lengths.
+func containsLength(lengths []int32, length int) bool {
+	for _, l := range lengths {
+		if int(l) == length {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper method to check whether a number is too short to be a regular
 // length phone number in a region.
-func isShorterThanPossibleNormalNumber(
+func (u *PhoneNumberUtil) isShorterThanPossibleNormalNumber(
 	regionMetadata *PhoneMetadata,
 	number string) bool {
 
-	pat, ok := readFromRegexCache(regionMetadata.GetGeneralDesc().GetNationalNumberPattern())
+	pat, ok := u.readFromRegexCache(regionMetadata.GetGeneralDesc().GetNationalNumberPattern())
 	if !ok {
 		patP := regionMetadata.GetGeneralDesc().GetNationalNumberPattern()
 		pat = regexp.MustCompile(patP)
-		writeToRegexCache(patP, pat)
+		u.writeToRegexCache(patP, pat)
 	}
 	return testNumberLengthAgainstPattern(pat, number) == TOO_SHORT
 }
@@ -2403,8 +2575,13 @@ func isShorterThanPossibleNormalNumber(
 //     digits (for fixed line numbers, that would most likely be area codes)
 //     and length (obviously includes the length of area codes for fixed
 //     line numbers), it will return false for the subscriber-number-only
-//     version.
-func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
+//     version. Numbers of a length only dialed locally, such as
+//     seven-digit US numbers, are reported as IS_POSSIBLE_LOCAL_ONLY.
+//
+// A length in between the possible lengths of the region is reported as
+// INVALID_LENGTH, the same as IsPossibleNumberForTypeWithReason() with
+// UNKNOWN reports.
+func (u *PhoneNumberUtil) IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 	nationalNumber := GetNationalSignificantNumber(number)
 	countryCode := int(number.GetCountryCode())
 	// Note: For Russian Fed and NANPA numbers, we just use the rules
@@ -2413,32 +2590,132 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 	// but not valid. This would need to be revisited if the possible
 	// number pattern ever differed between various regions within
 	// those plans.
-	if !hasValidCountryCallingCode(countryCode) {
+	if !u.hasValidCountryCallingCode(countryCode) {
 		return INVALID_COUNTRY_CODE
 	}
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := u.GetRegionCodeForCountryCode(countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(
-		countryCode, regionCode)
-	var generalNumDesc *PhoneNumberDesc = metadata.GetGeneralDesc()
-	// Handling case of numbers with no metadata.
-	if len(generalNumDesc.GetNationalNumberPattern()) == 0 {
-		numberLength := len(nationalNumber)
-		if numberLength < MIN_LENGTH_FOR_NSN {
-			return TOO_SHORT
-		} else if numberLength > MAX_LENGTH_FOR_NSN {
-			return TOO_LONG
-		} else {
-			return IS_POSSIBLE
+	metadata := u.getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	return testNumberLength(nationalNumber, metadata, UNKNOWN)
+}
+
+// Convenience wrapper around IsPossibleNumberForTypeWithReason(). Instead
+// of returning the reason for failure, this method returns a boolean
+// value.
+func (u *PhoneNumberUtil) IsPossibleNumberForType(
+	number *PhoneNumber,
+	typ PhoneNumberType) bool {
+
+	result := u.IsPossibleNumberForTypeWithReason(number, typ)
+	return result == IS_POSSIBLE || result == IS_POSSIBLE_LOCAL_ONLY
+}
+
+// Check whether a phone number is a possible number of a particular
+// type. Like IsPossibleNumberWithReason(), it only checks the length of
+// the number, but against the lengths possible for numbers of the type
+// in the region, so that, for example, a number can be rejected for
+// having a length only fixed-line numbers have when a mobile number is
+// needed. FIXED_LINE_OR_MOBILE accepts the lengths of both fixed-line
+// and mobile numbers, and UNKNOWN those of any number of the region.
+//
+// A length in between the possible lengths is reported as
+// INVALID_LENGTH, as is any length for a type the region has no numbers
+// of. A length only dialed locally is reported as IS_POSSIBLE_LOCAL_ONLY.
+func (u *PhoneNumberUtil) IsPossibleNumberForTypeWithReason(
+	number *PhoneNumber,
+	typ PhoneNumberType) ValidationResult {
+
+	nationalNumber := GetNationalSignificantNumber(number)
+	countryCode := int(number.GetCountryCode())
+	// As in IsPossibleNumberWithReason, NANPA and Russian Fed numbers
+	// are checked against the rules of their main region.
+	if !u.hasValidCountryCallingCode(countryCode) {
+		return INVALID_COUNTRY_CODE
+	}
+	regionCode := u.GetRegionCodeForCountryCode(countryCode)
+	// Metadata cannot be null because the country calling code is valid.
+	metadata := u.getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	return testNumberLength(nationalNumber, metadata, typ)
+}
+
+// Helper method to check the length of a national significant number
+// against the lengths possible for numbers of a type in a region, and
+// those only possible when dialed locally. A type without lengths of its
+// own has those of the general description.
+func testNumberLength(
+	number string,
+	metadata *PhoneMetadata,
+	typ PhoneNumberType) ValidationResult {
+
+	descForType := getNumberDescByType(metadata, typ)
+	possibleLengths := descForType.GetPossibleLength()
+	if len(possibleLengths) == 0 {
+		possibleLengths = metadata.GetGeneralDesc().GetPossibleLength()
+	}
+	localLengths := descForType.GetPossibleLengthLocalOnly()
+	if typ == FIXED_LINE_OR_MOBILE {
+		if !descHasPossibleNumberData(descForType) {
+			// The region has no fixed-line numbers, only mobile ones.
+			return testNumberLength(number, metadata, MOBILE)
+		}
+		mobileDesc := getNumberDescByType(metadata, MOBILE)
+		if descHasPossibleNumberData(mobileDesc) {
+			mobileLengths := mobileDesc.GetPossibleLength()
+			if len(mobileLengths) == 0 {
+				mobileLengths = metadata.GetGeneralDesc().GetPossibleLength()
+			}
+			possibleLengths = append(
+				possibleLengths[:len(possibleLengths):len(possibleLengths)],
+				mobileLengths...)
+			sort.Slice(possibleLengths, func(i, j int) bool {
+				return possibleLengths[i] < possibleLengths[j]
+			})
+			localLengths = append(localLengths[:len(localLengths):len(localLengths)],
+				mobileDesc.GetPossibleLengthLocalOnly()...)
 		}
 	}
-	pat, ok := readFromRegexCache(generalNumDesc.GetNationalNumberPattern())
-	if !ok {
-		patP := generalNumDesc.GetNationalNumberPattern()
-		pat = regexp.MustCompile(patP)
-		writeToRegexCache(patP, pat)
+	if len(possibleLengths) == 0 {
+		// No lengths are known; fall back to the bounds of all numbers.
+		return testNumberLengthAgainstBounds(len(number))
+	}
+	if possibleLengths[0] == -1 {
+		// The region has no numbers of this type.
+		return INVALID_LENGTH
 	}
-	return testNumberLengthAgainstPattern(pat, nationalNumber)
+
+	actualLength := len(number)
+	if containsLength(localLengths, actualLength) {
+		return IS_POSSIBLE_LOCAL_ONLY
+	}
+	if actualLength < int(possibleLengths[0]) {
+		return TOO_SHORT
+	}
+	if actualLength > int(possibleLengths[len(possibleLengths)-1]) {
+		return TOO_LONG
+	}
+	if containsLength(possibleLengths, actualLength) {
+		return IS_POSSIBLE
+	}
+	return INVALID_LENGTH
+}
+
+// Helper method to check a length against the bounds of the length of
+// any national significant number.
+func testNumberLengthAgainstBounds(numberLength int) ValidationResult {
+	if numberLength < MIN_LENGTH_FOR_NSN {
+		return TOO_SHORT
+	} else if numberLength > MAX_LENGTH_FOR_NSN {
+		return TOO_LONG
+	}
+	return IS_POSSIBLE
+}
+
+// Returns whether the description has any possible lengths, that is
+// whether the region has numbers of its type. Generated metadata marks
+// types without numbers with the possible length -1.
+func descHasPossibleNumberData(desc *PhoneNumberDesc) bool {
+	lengths := desc.GetPossibleLength()
+	return len(lengths) != 1 || lengths[0] != -1
 }
 
 // Check whether a phone number is a possible number given a number in the
@@ -2448,20 +2725,22 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 //
 // This method first parses the number, then invokes
 // IsPossibleNumber(PhoneNumber) with the resultant PhoneNumber object.
-func isPossibleNumberWithRegion(number, regionDialingFrom string) bool {
-	num, err := Parse(number, regionDialingFrom)
+func (u *PhoneNumberUtil) isPossibleNumberWithRegion(
+	number, regionDialingFrom string) bool {
+
+	num, err := u.Parse(number, regionDialingFrom)
 	if err != nil {
 		return false
 	}
-	return IsPossibleNumber(num)
+	return u.IsPossibleNumber(num)
 }
 
 // Attempts to extract a valid number from a phone number that is too long
 // to be valid, and resets the PhoneNumber object passed in to that valid
 // version. If no valid number could be extracted, the PhoneNumber object
 // passed in will not be modified.
-func TruncateTooLongNumber(number *PhoneNumber) bool {
-	if IsValidNumber(number) {
+func (u *PhoneNumberUtil) TruncateTooLongNumber(number *PhoneNumber) bool {
+	if u.IsValidNumber(number) {
 		return true
 	}
 	var numberCopy *PhoneNumber
@@ -2469,13 +2748,13 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	nationalNumber := number.GetNationalNumber()
 	nationalNumber /= 10
 	numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-	if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
+	if u.IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
 		return false
 	}
-	for !IsValidNumber(numberCopy) {
+	for !u.IsValidNumber(numberCopy) {
 		nationalNumber /= 10
 		numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-		if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
+		if u.IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
 			nationalNumber == 0 {
 			return false
 		}
@@ -2486,17 +2765,18 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 }
 
 // Gets an AsYouTypeFormatter for the specific region.
-// TODO(ttacon): uncomment once we do asyoutypeformatter.go
-//public AsYouTypeFormatter getAsYouTypeFormatter(String regionCode) {
-//    return new AsYouTypeFormatter(regionCode);
-//}
+func (u *PhoneNumberUtil) GetAsYouTypeFormatter(regionCode string) *AsYouTypeFormatter {
+	return newAsYouTypeFormatter(u, regionCode)
+}
 
 // Extracts country calling code from fullNumber, returns it and places
 // the remaining number in nationalNumber. It assumes that the leading plus
 // sign or IDD has already been removed. Returns 0 if fullNumber doesn't
 // start with a valid country calling code, and leaves nationalNumber
 // unmodified.
-func extractCountryCode(fullNumber, nationalNumber *builder.Builder) int {
+func (u *PhoneNumberUtil) extractCountryCode(
+	fullNumber, nationalNumber *builder.Builder) int {
+
 	fullNumBytes := fullNumber.Bytes()
 	if len(fullNumBytes) == 0 || fullNumBytes[0] == '0' {
 		// Country codes do not begin with a '0'.
@@ -2508,7 +2788,7 @@ func extractCountryCode(fullNumber, nationalNumber *builder.Builder) int {
 	)
 	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i <= numberLength; i++ {
 		potentialCountryCode, _ = strconv.Atoi(string(fullNumBytes[0:i]))
-		if _, ok := CountryCodeToRegion[potentialCountryCode]; ok {
+		if _, ok := u.readFromCountryCodeToRegion(potentialCountryCode); ok {
 			nationalNumber.Write(fullNumBytes[i:])
 			return potentialCountryCode
 		}
@@ -2538,7 +2818,7 @@ var ErrTooShortAfterIDD = errors.New("Phone number had an IDD, but " +
 // It will throw a NumberParseException if the number starts with a '+' but
 // the country calling code supplied after this does not match that of any
 // known region.
-func maybeExtractCountryCode(
+func (u *PhoneNumberUtil) maybeExtractCountryCode(
 	number string,
 	defaultRegionMetadata *PhoneMetadata,
 	nationalNumber *builder.Builder,
@@ -2556,7 +2836,7 @@ func maybeExtractCountryCode(
 	}
 
 	countryCodeSource :=
-		maybeStripInternationalPrefixAndNormalize(fullNumber, possibleCountryIddPrefix)
+		u.maybeStripInternationalPrefixAndNormalize(fullNumber, possibleCountryIddPrefix)
 	if keepRawInput {
 		phoneNumber.CountryCodeSource = &countryCodeSource
 	}
@@ -2564,7 +2844,7 @@ func maybeExtractCountryCode(
 		if len(fullNumber.String()) <= MIN_LENGTH_FOR_NSN {
 			return 0, ErrTooShortAfterIDD
 		}
-		potentialCountryCode := extractCountryCode(fullNumber, nationalNumber)
+		potentialCountryCode := u.extractCountryCode(fullNumber, nationalNumber)
 		if potentialCountryCode != 0 {
 			phoneNumber.CountryCode = proto.Int(potentialCountryCode)
 			return potentialCountryCode, nil
@@ -2587,21 +2867,21 @@ func maybeExtractCountryCode(
 					normalizedNumber[len(defaultCountryCodeString):])
 				generalDesc            = defaultRegionMetadata.GetGeneralDesc()
 				patP                   = `^(?:` + generalDesc.GetNationalNumberPattern() + `)$` // Strictly match
-				validNumberPattern, ok = readFromRegexCache(patP)
+				validNumberPattern, ok = u.readFromRegexCache(patP)
 			)
 			if !ok {
 				validNumberPattern = regexp.MustCompile(patP)
-				writeToRegexCache(patP, validNumberPattern)
+				u.writeToRegexCache(patP, validNumberPattern)
 			}
-			maybeStripNationalPrefixAndCarrierCode(
+			u.maybeStripNationalPrefixAndCarrierCode(
 				potentialNationalNumber,
 				defaultRegionMetadata,
 				builder.NewBuilder(nil) /* Don't need the carrier code */)
-			nationalNumberPattern, ok := readFromRegexCache(generalDesc.GetNationalNumberPattern())
+			nationalNumberPattern, ok := u.readFromRegexCache(generalDesc.GetNationalNumberPattern())
 			if !ok {
 				pat := generalDesc.GetNationalNumberPattern()
 				nationalNumberPattern = regexp.MustCompile(pat)
-				writeToRegexCache(pat, nationalNumberPattern)
+				u.writeToRegexCache(pat, nationalNumberPattern)
 			}
 			// If the number was not valid before but is valid now, or
 			// if it was too long before, we consider the number with
@@ -2652,7 +2932,7 @@ func parsePrefixAsIdd(iddPattern *regexp.Regexp, number *builder.Builder) bool {
 // Strips any international prefix (such as +, 00, 011) present in the
 // number provided, normalizes the resulting number, and indicates if
 // an international prefix was present.
-func maybeStripInternationalPrefixAndNormalize(
+func (u *PhoneNumberUtil) maybeStripInternationalPrefixAndNormalize(
 	number *builder.Builder,
 	possibleIddPrefix string) PhoneNumber_CountryCodeSource {
 
@@ -2671,11 +2951,11 @@ func maybeStripInternationalPrefixAndNormalize(
 	}
 
 	// Attempt to parse the first digits as an international prefix.
-	iddPattern, ok := readFromRegexCache(possibleIddPrefix)
+	iddPattern, ok := u.readFromRegexCache(possibleIddPrefix)
 	if !ok {
 		pat := possibleIddPrefix
 		iddPattern = regexp.MustCompile(pat)
-		writeToRegexCache(pat, iddPattern)
+		u.writeToRegexCache(pat, iddPattern)
 	}
 	number.ResetWithString(normalize(string(numBytes)))
 	if parsePrefixAsIdd(iddPattern, number) {
@@ -2686,7 +2966,7 @@ func maybeStripInternationalPrefixAndNormalize(
 
 // Strips any national prefix (such as 0, 1) present in the number provided.
 // @VisibleForTesting
-func maybeStripNationalPrefixAndCarrierCode(
+func (u *PhoneNumberUtil) maybeStripNationalPrefixAndCarrierCode(
 	number *builder.Builder,
 	metadata *PhoneMetadata,
 	carrierCode *builder.Builder) bool {
@@ -2699,19 +2979,19 @@ func maybeStripNationalPrefixAndCarrierCode(
 	}
 	possibleNationalPrefix = "^(?:" + possibleNationalPrefix + ")" // Strictly match from string start
 	// Attempt to parse the first digits as a national prefix.
-	prefixMatcher, ok := readFromRegexCache(possibleNationalPrefix)
+	prefixMatcher, ok := u.readFromRegexCache(possibleNationalPrefix)
 	if !ok {
 		pat := possibleNationalPrefix
 		prefixMatcher = regexp.MustCompile(pat)
-		writeToRegexCache(pat, prefixMatcher)
+		u.writeToRegexCache(pat, prefixMatcher)
 	}
 	if prefixMatcher.MatchString(number.String()) {
 		natRulePattern := "^(?:" + metadata.GetGeneralDesc().GetNationalNumberPattern() + ")$" // Strictly match
 		nationalNumberRule, ok :=
-			readFromRegexCache(natRulePattern)
+			u.readFromRegexCache(natRulePattern)
 		if !ok {
 			nationalNumberRule = regexp.MustCompile(natRulePattern)
-			writeToRegexCache(natRulePattern, nationalNumberRule)
+			u.writeToRegexCache(natRulePattern, nationalNumberRule)
 		}
 		// Check if the original number is viable.
 		isViableOriginalNumber := nationalNumberRule.Match(number.Bytes())
@@ -2767,17 +3047,17 @@ func maybeStripExtension(number *builder.Builder) string {
 	// If we find a potential extension, and the number preceding this is
 	// a viable number, we assume it is an extension.
 	numStr := number.String()
-	ind := EXTN_PATTERN.FindStringIndex(numStr)
+	ind := EXTN_PATTERN.FindStringSubmatchIndex(numStr)
 	if len(ind) > 0 && isViablePhoneNumber(numStr[0:ind[0]]) {
 		// The numbers are captured into groups in the regular expression.
-		for _, extensionGroup := range EXTN_PATTERN.FindAllStringIndex(numStr, -1) {
-			if len(extensionGroup) == 0 {
+		// We go through the capturing groups until we find one that
+		// captured some digits. If none did, then we will return the
+		// empty string.
+		for i := 2; i+1 < len(ind); i += 2 {
+			if ind[i] < 0 {
 				continue
 			}
-			// We go through the capturing groups until we find one
-			// that captured some digits. If none did, then we will
-			// return the empty string.
-			extension := numStr[extensionGroup[0]:extensionGroup[1]]
+			extension := numStr[ind[i]:ind[i+1]]
 			number.ResetWithString(numStr[0:ind[0]])
 			return extension
 		}
@@ -2789,8 +3069,8 @@ func maybeStripExtension(number *builder.Builder) string {
 // that the number to parse starts with a + symbol so that we can attempt
 // to infer the region from the number. Returns false if it cannot use the
 // region provided and the region cannot be inferred.
-func checkRegionForParsing(numberToParse, defaultRegion string) bool {
-	if !isValidRegionCode(defaultRegion) {
+func (u *PhoneNumberUtil) checkRegionForParsing(numberToParse, defaultRegion string) bool {
+	if !u.isValidRegionCode(defaultRegion) {
 		// If the number is null or empty, we can't infer the region.
 		if len(numberToParse) == 0 ||
 			!PLUS_CHARS_PATTERN.MatchString(numberToParse) {
@@ -2805,57 +3085,59 @@ func checkRegionForParsing(numberToParse, defaultRegion string) bool {
 // possible number. Note that validation of whether the number is actually
 // a valid number for a particular region is not performed. This can be
 // done separately with IsValidNumber().
-func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
+func (u *PhoneNumberUtil) Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	err := ParseToNumber(numberToParse, defaultRegion, phoneNumber)
+	err := u.ParseToNumber(numberToParse, defaultRegion, phoneNumber)
 	return phoneNumber, err
 }
 
 // Same as Parse(string, string), but accepts mutable PhoneNumber as a
 // parameter to decrease object creation when invoked many times.
-func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
+func (u *PhoneNumberUtil) ParseToNumber(
+	numberToParse, defaultRegion string,
+	phoneNumber *PhoneNumber) error {
+
+	return u.parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
 }
 
 // Parses a string and returns it in proto buffer format. This method
 // differs from Parse() in that it always populates the raw_input field of
 // the protocol buffer with numberToParse as well as the country_code_source
 // field.
-func ParseAndKeepRawInput(
+func (u *PhoneNumberUtil) ParseAndKeepRawInput(
 	numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	return phoneNumber, ParseAndKeepRawInputToNumber(
+	return phoneNumber, u.ParseAndKeepRawInputToNumber(
 		numberToParse, defaultRegion, phoneNumber)
 }
 
 // Same as ParseAndKeepRawInput(String, String), but accepts a mutable
 // PhoneNumber as a parameter to decrease object creation when invoked many
 // times.
-func ParseAndKeepRawInputToNumber(
+func (u *PhoneNumberUtil) ParseAndKeepRawInputToNumber(
 	numberToParse, defaultRegion string,
 	phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
+	return u.parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
 }
 
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-// This is a shortcut for findNumbers(CharSequence, String, Leniency, long)
-// getMatcher(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE)}.
-//public Iterable<PhoneNumberMatch> findNumbers(CharSequence text, String defaultRegion) {
-//    return findNumbers(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE);
-//}
+// Returns a PhoneNumberMatcher over all phone numbers in text. This is a
+// shortcut for FindNumbersWithLeniency(text, defaultRegion, VALID,
+// math.MaxInt64).
+func (u *PhoneNumberUtil) FindNumbers(text, defaultRegion string) *PhoneNumberMatcher {
+	return u.FindNumbersWithLeniency(text, defaultRegion, VALID, math.MaxInt64)
+}
 
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-//public Iterable<PhoneNumberMatch> findNumbers(
-//	final CharSequence text, final String defaultRegion, final Leniency leniency,
-//	final long maxTries) {
-//
-//		return new Iterable<PhoneNumberMatch>() {
-//			public Iterator<PhoneNumberMatch> iterator() {
-//				return new PhoneNumberMatcher(
-//					PhoneNumberUtil.this, text, defaultRegion, leniency, maxTries);
-//			}
-//		};
-//	}
+// Returns a PhoneNumberMatcher over all phone numbers in text. Numbers
+// are parsed using defaultRegion when they are not written in
+// international format, and only those satisfying the leniency are
+// returned. At most maxTries invalid candidates are skipped before the
+// search gives up.
+func (u *PhoneNumberUtil) FindNumbersWithLeniency(
+	text, defaultRegion string,
+	leniency Leniency,
+	maxTries int64) *PhoneNumberMatcher {
+	return newPhoneNumberMatcher(u, text, defaultRegion, leniency, maxTries)
+}
 
 // A helper function to set the values related to leading zeros in a
 // PhoneNumber.
@@ -2888,28 +3170,35 @@ var (
 // default region to be null, for use by IsNumberMatch(). checkRegion should
 // be set to false if it is permitted for the default region to be null or
 // unknown ("ZZ").
-func parseHelper(
+func (u *PhoneNumberUtil) parseHelper(
 	numberToParse, defaultRegion string,
 	keepRawInput, checkRegion bool,
 	phoneNumber *PhoneNumber) error {
 	if len(numberToParse) == 0 {
-		return ErrNotANumber
+		return newParseError(ErrorType_NOT_A_NUMBER, numberToParse, 0,
+			"The phone number supplied was empty.")
 	} else if len(numberToParse) > MAX_INPUT_STRING_LENGTH {
-		return ErrNumTooLong
+		return newParseError(ErrorType_TOO_LONG, numberToParse,
+			MAX_INPUT_STRING_LENGTH,
+			"The string supplied was too long to parse.")
 	}
 
 	nationalNumber := builder.NewBuilder(nil)
-	buildNationalNumberForParsing(numberToParse, nationalNumber)
+	offsets := buildNationalNumberForParsing(numberToParse, nationalNumber)
 
 	if !isViablePhoneNumber(nationalNumber.String()) {
-		return ErrNotANumber
+		return newParseError(ErrorType_NOT_A_NUMBER, numberToParse,
+			notANumberPosition(numberToParse, offsets),
+			"The string supplied did not seem to be a phone number.")
 	}
 
 	// Check the region supplied is valid, or that the extracted number
 	// starts with some sort of + sign so the number's region can be determined.
 	if checkRegion &&
-		!checkRegionForParsing(nationalNumber.String(), defaultRegion) {
-		return ErrInvalidCountryCode
+		!u.checkRegionForParsing(nationalNumber.String(), defaultRegion) {
+		// The number should have started with a country calling code.
+		return newParseError(ErrorType_INVALID_COUNTRY_CODE, numberToParse,
+			offsets[0], "Missing or invalid default region.")
 	}
 
 	if keepRawInput {
@@ -2922,14 +3211,14 @@ func parseHelper(
 	if len(extension) > 0 {
 		phoneNumber.Extension = proto.String(extension)
 	}
-	var regionMetadata *PhoneMetadata = getMetadataForRegion(defaultRegion)
+	var regionMetadata *PhoneMetadata = u.getMetadataForRegion(defaultRegion)
 	// Check to see if the number is given in international format so we
 	// know whether this number is from the default region or not.
 	normalizedNationalNumber := builder.NewBuilder(nil)
 	// TODO: This method should really just take in the string buffer that
 	// has already been created, and just remove the prefix, rather than
 	// taking in a string and then outputting a string buffer.
-	countryCode, err := maybeExtractCountryCode(
+	countryCode, err := u.maybeExtractCountryCode(
 		nationalNumber.String(), regionMetadata,
 		normalizedNationalNumber, keepRawInput, phoneNumber)
 	if err != nil {
@@ -2937,24 +3226,29 @@ func parseHelper(
 		inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumber.String())
 		if err == ErrInvalidCountryCode && len(inds) > 0 {
 			// Strip the plus-char, and try again.
-			countryCode, err = maybeExtractCountryCode(
-				nationalNumber.String()[inds[1]:], regionMetadata,
+			afterPlus := nationalNumber.String()[inds[1]:]
+			countryCode, err = u.maybeExtractCountryCode(
+				afterPlus, regionMetadata,
 				normalizedNationalNumber, keepRawInput, phoneNumber)
 			if err != nil {
-				return err
+				return u.countryCodeParseError(err, numberToParse,
+					afterPlus, offsets[inds[1]:], regionMetadata)
 			} else if countryCode == 0 {
-				return ErrInvalidCountryCode
+				return newParseError(ErrorType_INVALID_COUNTRY_CODE,
+					numberToParse, normalizedRunePosition(afterPlus, offsets[inds[1]:], 0),
+					"Could not interpret numbers after plus-sign.")
 			}
 		} else {
-			return err
+			return u.countryCodeParseError(err, numberToParse,
+				nationalNumber.String(), offsets, regionMetadata)
 		}
 	}
 	if countryCode != 0 {
-		phoneNumberRegion := GetRegionCodeForCountryCode(countryCode)
+		phoneNumberRegion := u.GetRegionCodeForCountryCode(countryCode)
 		if phoneNumberRegion != defaultRegion {
 			// Metadata cannot be null because the country calling
 			// code is valid.
-			regionMetadata = getMetadataForRegionOrCallingCode(
+			regionMetadata = u.getMetadataForRegionOrCallingCode(
 				countryCode, phoneNumberRegion)
 		}
 	} else {
@@ -2969,8 +3263,13 @@ func parseHelper(
 			phoneNumber.CountryCodeSource = nil
 		}
 	}
+	// Where the national number starts, for the errors below.
+	nationalNumberPosition := normalizedSuffixPosition(
+		nationalNumber.String(), offsets, normalizedNationalNumber.String())
 	if len(normalizedNationalNumber.String()) < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(ErrorType_TOO_SHORT_NSN, numberToParse,
+			nationalNumberPosition,
+			"The string supplied is too short to be a phone number.")
 	}
 
 	if regionMetadata != nil {
@@ -2978,14 +3277,20 @@ func parseHelper(
 		bufferCopy := make([]byte, normalizedNationalNumber.Len())
 		copy(bufferCopy, normalizedNationalNumber.Bytes())
 		potentialNationalNumber := builder.NewBuilder(bufferCopy)
-		maybeStripNationalPrefixAndCarrierCode(
+		u.maybeStripNationalPrefixAndCarrierCode(
 			potentialNationalNumber, regionMetadata, carrierCode)
 		// We require that the NSN remaining after stripping the national
 		// prefix and carrier code be of a possible length for the region.
 		// Otherwise, we don't do the stripping, since the original number
 		// could be a valid short number.
-		if !isShorterThanPossibleNormalNumber(
+		if !u.isShorterThanPossibleNormalNumber(
 			regionMetadata, potentialNationalNumber.String()) {
+			// A national prefix transform rule rewrites the number, which
+			// then has no position of its own.
+			if position := normalizedSuffixPosition(nationalNumber.String(),
+				offsets, potentialNationalNumber.String()); position >= 0 {
+				nationalNumberPosition = position
+			}
 			normalizedNationalNumber = potentialNationalNumber
 			if keepRawInput {
 				phoneNumber.PreferredDomesticCarrierCode =
@@ -2995,10 +3300,14 @@ func parseHelper(
 	}
 	lengthOfNationalNumber := len(normalizedNationalNumber.String())
 	if lengthOfNationalNumber < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(ErrorType_TOO_SHORT_NSN, numberToParse,
+			nationalNumberPosition,
+			"The string supplied is too short to be a phone number.")
 	}
 	if lengthOfNationalNumber > MAX_LENGTH_FOR_NSN {
-		return ErrNumTooLong
+		return newParseError(ErrorType_TOO_LONG, numberToParse,
+			nationalNumberPosition,
+			"The string supplied is too long to be a phone number.")
 	}
 	setItalianLeadingZerosForPhoneNumber(
 		normalizedNationalNumber.String(), phoneNumber)
@@ -3007,14 +3316,103 @@ func parseHelper(
 	return nil
 }
 
+// Converts an error from maybeExtractCountryCode, which was given
+// number, into a ParseError for numberToParse. offsets holds the offset
+// in numberToParse of each byte of number.
+func (u *PhoneNumberUtil) countryCodeParseError(
+	err error,
+	numberToParse, number string,
+	offsets []int,
+	metadata *PhoneMetadata) *ParseError {
+
+	if err == ErrTooShortAfterIDD {
+		return newParseError(ErrorType_TOO_SHORT_AFTER_IDD, numberToParse, -1,
+			"Phone number had an IDD, but after this was not long "+
+				"enough to be a viable phone number.")
+	}
+	// The country calling code follows the plus sign or IDD.
+	possibleIddPrefix := "NonMatch"
+	if metadata != nil {
+		possibleIddPrefix = metadata.GetInternationalPrefix()
+	}
+	fullNumber := builder.NewBuilderString(number)
+	u.maybeStripInternationalPrefixAndNormalize(fullNumber, possibleIddPrefix)
+	return newParseError(ErrorType_INVALID_COUNTRY_CODE, numberToParse,
+		normalizedSuffixPosition(number, offsets, fullNumber.String()),
+		"Country calling code supplied was not recognised.")
+}
+
+// Returns the position of a NOT_A_NUMBER error in numberToParse: where
+// the number extracted from it starts, at offsets[0], or if nothing in
+// it could start a number, its first character other than white space.
+func notANumberPosition(numberToParse string, offsets []int) int {
+	if len(offsets) > 0 {
+		return offsets[0]
+	}
+	if i := strings.IndexFunc(numberToParse, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	}); i >= 0 {
+		return i
+	}
+	return 0
+}
+
+// Returns the offset in the string parsed of the character of number
+// that normalize turns into the first character of suffix, a suffix of
+// normalize(number). offsets holds the offset in the string parsed of
+// each byte of number. Returns the offset just past number if suffix is
+// empty, and -1 if it is not a suffix of normalize(number).
+func normalizedSuffixPosition(number string, offsets []int, suffix string) int {
+	normalized := normalize(number)
+	if !strings.HasSuffix(normalized, suffix) {
+		return -1
+	}
+	return normalizedRunePosition(number, offsets,
+		utf8.RuneCountInString(normalized)-utf8.RuneCountInString(suffix))
+}
+
+// Returns the offset in the string parsed of the character of number
+// that normalize turns into rune n of its result, counting from 0.
+// offsets holds the offset in the string parsed of each byte of number.
+// Returns the offset just past number if the result has no rune n.
+func normalizedRunePosition(number string, offsets []int, n int) int {
+	alpha := VALID_ALPHA_PHONE_PATTERN.MatchString(number)
+	for i, r := range number {
+		kept := unicode.IsDigit(r)
+		if alpha {
+			_, kept = ALPHA_PHONE_MAPPINGS[unicode.ToUpper(r)]
+		}
+		if !kept {
+			continue
+		}
+		if n == 0 {
+			return offsets[i]
+		}
+		n--
+	}
+	if len(number) == 0 {
+		return -1
+	}
+	return offsets[len(number)-1] + 1
+}
+
 var ErrNumTooLong = errors.New("The string supplied is too long to be a phone number.")
 
 // Converts numberToParse to a form that we can parse and write it to
 // nationalNumber if it is written in RFC3966; otherwise extract a possible
-// number out of it and write to nationalNumber.
+// number out of it and write to nationalNumber. Returns the offset in
+// numberToParse of each byte written to nationalNumber.
 func buildNationalNumberForParsing(
 	numberToParse string,
-	nationalNumber *builder.Builder) {
+	nationalNumber *builder.Builder) []int {
+
+	var offsets []int
+	write := func(start, end int) {
+		nationalNumber.WriteString(numberToParse[start:end])
+		for i := start; i < end; i++ {
+			offsets = append(offsets, i)
+		}
+	}
 
 	indexOfPhoneContext := strings.Index(numberToParse, RFC3966_PHONE_CONTEXT)
 	if indexOfPhoneContext > 0 {
@@ -3027,10 +3425,9 @@ func buildNationalNumberForParsing(
 			// context are not important for parsing the phone number.
 			phoneContextEnd := strings.Index(numberToParse[phoneContextStart:], ";")
 			if phoneContextEnd > 0 {
-				nationalNumber.WriteString(
-					numberToParse[phoneContextStart:phoneContextEnd])
+				write(phoneContextStart, phoneContextEnd)
 			} else {
-				nationalNumber.WriteString(numberToParse[phoneContextStart:])
+				write(phoneContextStart, len(numberToParse))
 			}
 		}
 		// Now append everything between the "tel:" prefix and the
@@ -3044,13 +3441,15 @@ func buildNationalNumberForParsing(
 		if indexOfRfc3966Prefix >= 0 {
 			indexOfNationalNumber = indexOfRfc3966Prefix + len(RFC3966_PREFIX)
 		}
-		nationalNumber.WriteString(
-			numberToParse[indexOfNationalNumber:indexOfPhoneContext])
+		write(indexOfNationalNumber, indexOfPhoneContext)
 	} else {
 		// Extract a possible number from the string passed in (this
 		// strips leading characters that could not be the start of a
 		// phone number.)
-		nationalNumber.WriteString(extractPossibleNumber(numberToParse))
+		if number := extractPossibleNumber(numberToParse); len(number) > 0 {
+			start := VALID_START_CHAR_PATTERN.FindStringIndex(numberToParse)[0]
+			write(start, start+len(number))
+		}
 	}
 
 	// Delete the isdn-subaddress and everything after it if it is present.
@@ -3060,12 +3459,14 @@ func buildNationalNumberForParsing(
 	if indexOfIsdn > 0 {
 		natNumBytes := nationalNumber.Bytes()
 		nationalNumber.ResetWith(natNumBytes[:indexOfIsdn])
+		offsets = offsets[:indexOfIsdn]
 	}
 	// If both phone context and isdn-subaddress are absent but other
 	// parameters are present, the parameters are left in nationalNumber.
 	// This is because we are concerned about deleting content from a
 	// potential number string when there is no strong evidence that the
 	// number is actually written in RFC3966.
+	return offsets
 }
 
 // Takes two phone numbers and compares them for equality.
@@ -3081,7 +3482,10 @@ func buildNationalNumberForParsing(
 // Returns NO_MATCH otherwise.
 // For example, the numbers +1 345 657 1234 and 657 1234 are a SHORT_NSN_MATCH.
 // The numbers +1 345 657 1234 and 345 657 are a NO_MATCH.
-func isNumberMatchWithNumbers(firstNumberIn, secondNumberIn *PhoneNumber) MatchType {
+// NOT_A_NUMBER is never returned, as both are already numbers.
+func (u *PhoneNumberUtil) IsNumberMatchWithNumbers(
+	firstNumberIn, secondNumberIn *PhoneNumber) MatchType {
+
 	// Make copies of the phone number so that the numbers passed in are not edited.
 	var firstNumber, secondNumber *PhoneNumber
 	firstNumber = &PhoneNumber{}
@@ -3135,8 +3539,8 @@ func isNumberMatchWithNumbers(firstNumberIn, secondNumberIn *PhoneNumber) MatchT
 	}
 	// Checks cases where one or both country_code fields were not
 	// specified. To make equality checks easier, we first set the
-	// country_code fields to be equal.
-	firstNumber.CountryCode = proto.Int(int(secondNumberCountryCode))
+	// country_code fields to be equal, including whether they are set.
+	firstNumber.CountryCode = secondNumber.CountryCode
 	// If all else was the same, then this is an NSN_MATCH.
 	// TODO(ttacon): remove when make gen-equals
 	if reflect.DeepEqual(firstNumber, secondNumber) {
@@ -3163,62 +3567,63 @@ func isNationalNumberSuffixOfTheOther(firstNumber, secondNumber *PhoneNumber) bo
 }
 
 // Takes two phone numbers as strings and compares them for equality. This is
-// a convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
-// default region is known.
-func IsNumberMatch(firstNumber, secondNumber string) MatchType {
-	firstNumberAsProto, err := Parse(firstNumber, UNKNOWN_REGION)
+// a convenience wrapper for IsNumberMatchWithNumbers(). No default region is
+// known.
+func (u *PhoneNumberUtil) IsNumberMatch(firstNumber, secondNumber string) MatchType {
+	firstNumberAsProto, err := u.Parse(firstNumber, UNKNOWN_REGION)
 	if err == nil {
-		return isNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
-	} else if err != ErrInvalidCountryCode {
+		return u.IsNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := u.Parse(secondNumber, UNKNOWN_REGION)
 	if err == nil {
-		return isNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
-	} else if err != ErrInvalidCountryCode {
+		return u.IsNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
-	var firstNumberProto, secondNumberProto *PhoneNumber
-	err = parseHelper(firstNumber, "", false, false, firstNumberProto)
+	firstNumberProto, secondNumberProto := &PhoneNumber{}, &PhoneNumber{}
+	err = u.parseHelper(firstNumber, "", false, false, firstNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
-	err = parseHelper(secondNumber, "", false, false, secondNumberProto)
+	err = u.parseHelper(secondNumber, "", false, false, secondNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
-	return isNumberMatchWithNumbers(firstNumberProto, secondNumberProto)
+	return u.IsNumberMatchWithNumbers(firstNumberProto, secondNumberProto)
 }
 
-// Takes two phone numbers and compares them for equality. This is a
-// convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
-// default region is known.
-func isNumberMatchWithOneNumber(
+// Takes two phone numbers, one already parsed, and compares them for
+// equality. This is a convenience wrapper for IsNumberMatchWithNumbers().
+// No default region is known. Returns NOT_A_NUMBER if secondNumber can't
+// be parsed.
+func (u *PhoneNumberUtil) IsNumberMatchWithOneNumber(
 	firstNumber *PhoneNumber, secondNumber string) MatchType {
 	// First see if the second number has an implicit country calling
 	// code, by attempting to parse it.
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := u.Parse(secondNumber, UNKNOWN_REGION)
 	if err == nil {
-		return isNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
+		return u.IsNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
 	}
-	if err != ErrInvalidCountryCode {
+	if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 	// The second number has no country calling code. EXACT_MATCH is no
 	// longer possible. We parse it as if the region was the same as that
 	// for the first number, and if EXACT_MATCH is returned, we replace
 	// this with NSN_MATCH.
-	firstNumberRegion := GetRegionCodeForCountryCode(int(firstNumber.GetCountryCode()))
+	firstNumberRegion := u.GetRegionCodeForCountryCode(int(firstNumber.GetCountryCode()))
 
 	if firstNumberRegion != UNKNOWN_REGION {
 		secondNumberWithFirstNumberRegion, err :=
-			Parse(secondNumber, firstNumberRegion)
+			u.Parse(secondNumber, firstNumberRegion)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
-		match := isNumberMatchWithNumbers(
+		match := u.IsNumberMatchWithNumbers(
 			firstNumber, secondNumberWithFirstNumberRegion)
 		if match == EXACT_MATCH {
 			return NSN_MATCH
@@ -3227,12 +3632,12 @@ func isNumberMatchWithOneNumber(
 	} else {
 		// If the first number didn't have a valid country calling
 		// code, then we parse the second number without one as well.
-		var secondNumberProto *PhoneNumber
-		err := parseHelper(secondNumber, "", false, false, secondNumberProto)
+		secondNumberProto := &PhoneNumber{}
+		err := u.parseHelper(secondNumber, "", false, false, secondNumberProto)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
-		return isNumberMatchWithNumbers(firstNumber, secondNumberProto)
+		return u.IsNumberMatchWithNumbers(firstNumber, secondNumberProto)
 	}
 }
 
@@ -3241,8 +3646,8 @@ func isNumberMatchWithOneNumber(
 // returns false. Does not check the number is a valid number. Note that,
 // at the moment, this method does not handle short numbers.
 // TODO: Make this method public when we have enough metadata to make it worthwhile.
-func canBeInternationallyDialled(number *PhoneNumber) bool {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+func (u *PhoneNumberUtil) canBeInternationallyDialled(number *PhoneNumber) bool {
+	metadata := u.getMetadataForRegion(u.GetRegionCodeForNumber(number))
 	if metadata == nil {
 		// Note numbers belonging to non-geographical entities
 		// (e.g. +800 numbers) are always internationally diallable,
@@ -3250,15 +3655,15 @@ func canBeInternationallyDialled(number *PhoneNumber) bool {
 		return true
 	}
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	return !isNumberMatchingDesc(
+	return !u.isNumberMatchingDesc(
 		nationalSignificantNumber, metadata.GetNoInternationalDialling())
 }
 
 // Returns true if the supplied region supports mobile number portability.
 // Returns false for invalid, unknown or regions that don't support mobile
 // number portability.
-func IsMobileNumberPortableRegion(regionCode string) bool {
-	metadata := getMetadataForRegion(regionCode)
+func (u *PhoneNumberUtil) IsMobileNumberPortableRegion(regionCode string) bool {
+	metadata := u.getMetadataForRegion(regionCode)
 	if metadata == nil {
 		return false
 	}
@@ -3266,36 +3671,27 @@ func IsMobileNumberPortableRegion(regionCode string) bool {
 }
 
 func init() {
-	err := loadMetadataFromFile("US", 1)
-	if err != nil {
-		// better to die on start up
-		panic(err)
-	}
-
-	for eKey, regionCodes := range CountryCodeToRegion {
-		// We can assume that if the county calling code maps to the
-		// non-geo entity region code then that's the only region code
-		// it maps to.
-		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
-			// This is the subset of all country codes that map to the
-			// non-geo entity region code.
-			writeToCCsForNonGeographicalRegion(eKey, struct{}{})
-		} else {
-			// The supported regions set does not include the "001"
-			// non-geo entity region code.
-			for _, val := range regionCodes {
-				writeToSupportedRegions(val, struct{}{})
-			}
+	// Only index the metadata: each region is decoded when it is first
+	// used, which keeps the start up cost low.
+	index, err := newMetadataIndex(metaData)
+	if err == nil {
+		// CountryCodeToRegion follows the default PhoneNumberUtil, so
+		// the registry gets a copy of it.
+		countryCodeToRegion := make(map[int][]string, len(CountryCodeToRegion))
+		for countryCode, regions := range CountryCodeToRegion {
+			countryCodeToRegion[countryCode] = regions
 		}
+		builtinMetadataRegistry, err = newMetadataRegistry(
+			index, countryCodeToRegion, nil)
 	}
-	// If the non-geo entity still got added to the set of supported
-	// regions it must be because there are entries that list the non-geo
-	// entity alongside normal regions (which is wrong). If we discover
-	// this, remove the non-geo entity from the set of supported regions
-	// and log (or not log).
-	deleteFromSupportedRegions(REGION_CODE_FOR_NON_GEO_ENTITY)
-
-	for _, val := range CountryCodeToRegion[NANPA_COUNTRY_CODE] {
-		writeToNanpaRegions(val, struct{}{})
+	if err != nil {
+		// The metadata is compiled in, so failing to read it is a bug in
+		// the generated source rather than something to recover from:
+		// better to die on start up. The short number metadata and the
+		// alternate formats, read on first use, are handled alike.
+		panic(err)
 	}
+	defaultPhoneNumberUtil = NewPhoneNumberUtil()
+	defaultTimeZonesMapper = defaultPhoneNumberUtil.NewPhoneNumberToTimeZonesMapper(
+		CountryCodeToTimeZones)
 }
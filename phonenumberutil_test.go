@@ -1,8 +1,11 @@
 package libphonenumber
 
 import (
+	"bytes"
+	"errors"
 	"reflect"
 	"regexp"
+	"sync"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -60,7 +63,7 @@ func TestParse(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if num.GetNationalNumber() != test.expectedNum {
@@ -266,7 +269,7 @@ func Test_IsValidNumber(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -346,7 +349,7 @@ func Test_IsValidNumberForRegion(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -502,6 +505,285 @@ func Test_testNumberLengthAgainstPattern(t *testing.T) {
 	}
 }
 
+func Test_testNumberLength(t *testing.T) {
+	metadata := &PhoneMetadata{
+		GeneralDesc: &PhoneNumberDesc{PossibleLength: []int32{6, 7, 8, 9, 10}},
+		FixedLine: &PhoneNumberDesc{
+			PossibleLength:          []int32{7, 10},
+			PossibleLengthLocalOnly: []int32{5},
+		},
+		Mobile:   &PhoneNumberDesc{PossibleLength: []int32{9}},
+		TollFree: &PhoneNumberDesc{},
+		Voip:     &PhoneNumberDesc{PossibleLength: []int32{-1}},
+	}
+	mobileOnly := &PhoneMetadata{
+		GeneralDesc: &PhoneNumberDesc{PossibleLength: []int32{8, 9}},
+		FixedLine:   &PhoneNumberDesc{PossibleLength: []int32{-1}},
+		Mobile:      &PhoneNumberDesc{PossibleLength: []int32{9}},
+	}
+	var tests = []struct {
+		metadata *PhoneMetadata
+		typ      PhoneNumberType
+		num      string
+		expected ValidationResult
+	}{
+		{metadata, FIXED_LINE, "1234567", IS_POSSIBLE},
+		{metadata, FIXED_LINE, "1234567890", IS_POSSIBLE},
+		{metadata, FIXED_LINE, "123456", TOO_SHORT},
+		{metadata, FIXED_LINE, "12345678", INVALID_LENGTH},
+		{metadata, FIXED_LINE, "12345", IS_POSSIBLE_LOCAL_ONLY},
+		{metadata, FIXED_LINE, "12345678901", TOO_LONG},
+		{metadata, MOBILE, "1234567", TOO_SHORT},
+		{metadata, MOBILE, "123456789", IS_POSSIBLE},
+		{metadata, MOBILE, "1234567890", TOO_LONG},
+		// Both fixed-line and mobile lengths.
+		{metadata, FIXED_LINE_OR_MOBILE, "1234567", IS_POSSIBLE},
+		{metadata, FIXED_LINE_OR_MOBILE, "123456789", IS_POSSIBLE},
+		{metadata, FIXED_LINE_OR_MOBILE, "1234567890", IS_POSSIBLE},
+		{metadata, FIXED_LINE_OR_MOBILE, "12345678", INVALID_LENGTH},
+		{metadata, FIXED_LINE_OR_MOBILE, "12345", IS_POSSIBLE_LOCAL_ONLY},
+		{metadata, MOBILE, "12345", TOO_SHORT},
+		// Types without lengths of their own have the general ones.
+		{metadata, TOLL_FREE, "123456", IS_POSSIBLE},
+		{metadata, UNKNOWN, "12345678", IS_POSSIBLE},
+		{metadata, UNKNOWN, "12345", TOO_SHORT},
+		// Types the region has no numbers of.
+		{metadata, VOIP, "1234567", INVALID_LENGTH},
+		{mobileOnly, FIXED_LINE_OR_MOBILE, "123456789", IS_POSSIBLE},
+		{mobileOnly, FIXED_LINE_OR_MOBILE, "12345678", TOO_SHORT},
+	}
+
+	for i, test := range tests {
+		res := testNumberLength(test.num, test.metadata, test.typ)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+	}
+}
+
+func TestIsPossibleNumberForTypeWithReason(t *testing.T) {
+	var tests = []struct {
+		num      *PhoneNumber
+		typ      PhoneNumberType
+		expected ValidationResult
+	}{
+		// German fixed-line numbers may be much shorter than mobile ones.
+		{getTestNumber("DE_NUMBER"), FIXED_LINE, IS_POSSIBLE},
+		{getTestNumber("DE_NUMBER"), MOBILE, TOO_SHORT},
+		{getTestNumber("DE_NUMBER"), FIXED_LINE_OR_MOBILE, IS_POSSIBLE},
+		{getTestNumber("DE_NUMBER"), UNKNOWN, IS_POSSIBLE},
+		// British mobile numbers only have ten digits.
+		{newPhoneNumber(44, 123456789), FIXED_LINE, IS_POSSIBLE},
+		{newPhoneNumber(44, 123456789), MOBILE, TOO_SHORT},
+		{getTestNumber("GB_MOBILE"), MOBILE, IS_POSSIBLE},
+		// Italian fixed-line numbers may be longer than mobile ones.
+		{newPhoneNumber(39, 12345678901), MOBILE, TOO_LONG},
+		{newPhoneNumber(39, 12345678901), FIXED_LINE_OR_MOBILE, IS_POSSIBLE},
+		// Swiss voicemail numbers are longer than the other numbers, and
+		// there are no Swiss VoIP numbers.
+		{newPhoneNumber(41, 860123456789), MOBILE, TOO_LONG},
+		{newPhoneNumber(41, 860123456789), VOICEMAIL, IS_POSSIBLE},
+		{newPhoneNumber(41, 781234567), VOIP, INVALID_LENGTH},
+		{getTestNumber("US_NUMBER"), FIXED_LINE, IS_POSSIBLE},
+		{getTestNumber("US_TOLLFREE"), TOLL_FREE, IS_POSSIBLE},
+		{getTestNumber("US_SHORT_BY_ONE_NUMBER"), MOBILE, TOO_SHORT},
+		{getTestNumber("US_LOCAL_NUMBER"), FIXED_LINE, IS_POSSIBLE_LOCAL_ONLY},
+		{getTestNumber("US_LOCAL_NUMBER"), TOLL_FREE, TOO_SHORT},
+		{getTestNumber("INTERNATIONAL_TOLL_FREE"), TOLL_FREE, IS_POSSIBLE},
+		{getTestNumber("UNKNOWN_COUNTRY_CODE_NO_RAW_INPUT"), MOBILE, INVALID_COUNTRY_CODE},
+	}
+
+	for i, test := range tests {
+		res := IsPossibleNumberForTypeWithReason(test.num, test.typ)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+		possible := test.expected == IS_POSSIBLE || test.expected == IS_POSSIBLE_LOCAL_ONLY
+		if IsPossibleNumberForType(test.num, test.typ) != possible {
+			t.Errorf("[test %d] IsPossibleNumberForType disagrees with %v", i, res)
+		}
+	}
+}
+
+func TestIsPossibleNumberWithReason(t *testing.T) {
+	var tests = []struct {
+		num      *PhoneNumber
+		expected ValidationResult
+	}{
+		{getTestNumber("US_NUMBER"), IS_POSSIBLE},
+		{getTestNumber("US_LOCAL_NUMBER"), IS_POSSIBLE_LOCAL_ONLY},
+		{getTestNumber("US_LONG_NUMBER"), TOO_LONG},
+		{newPhoneNumber(1, 253000), TOO_SHORT},
+		{getTestNumber("GB_MOBILE"), IS_POSSIBLE},
+		// Swiss numbers have 9 or 12 digits, so 10 falls in between.
+		{newPhoneNumber(41, 8601234567), INVALID_LENGTH},
+		{getTestNumber("UNKNOWN_COUNTRY_CODE_NO_RAW_INPUT"), INVALID_COUNTRY_CODE},
+	}
+
+	for i, test := range tests {
+		res := IsPossibleNumberWithReason(test.num)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+		if forType := IsPossibleNumberForTypeWithReason(test.num, UNKNOWN); forType != res {
+			t.Errorf("[test %d] IsPossibleNumberForTypeWithReason(UNKNOWN) = %v, want %v",
+				i, forType, res)
+		}
+		possible := test.expected == IS_POSSIBLE || test.expected == IS_POSSIBLE_LOCAL_ONLY
+		if IsPossibleNumber(test.num) != possible {
+			t.Errorf("[test %d] IsPossibleNumber disagrees with %v", i, res)
+		}
+	}
+}
+
+func TestIsNumberMatchWithNumbers(t *testing.T) {
+	withExtension := func(number *PhoneNumber, extension string) *PhoneNumber {
+		number.Extension = proto.String(extension)
+		return number
+	}
+	withoutCountryCode := &PhoneNumber{NationalNumber: proto.Uint64(33316005)}
+
+	var tests = []struct {
+		first, second *PhoneNumber
+		expected      MatchType
+	}{
+		{getTestNumber("NZ_NUMBER"), newPhoneNumber(64, 33316005), EXACT_MATCH},
+		{getTestNumber("IT_NUMBER"), getTestNumber("IT_NUMBER"), EXACT_MATCH},
+		{
+			withExtension(newPhoneNumber(64, 33316005), "3456"),
+			withExtension(newPhoneNumber(64, 33316005), "3456"),
+			EXACT_MATCH,
+		},
+		// The country calling code of one number is unknown.
+		{withoutCountryCode, getTestNumber("NZ_NUMBER"), NSN_MATCH},
+		{getTestNumber("NZ_NUMBER"), withoutCountryCode, NSN_MATCH},
+		// One number is a shorter version of the other, or has an
+		// extension the other has not.
+		{getTestNumber("NZ_NUMBER"), newPhoneNumber(64, 3316005), SHORT_NSN_MATCH},
+		{
+			withExtension(newPhoneNumber(64, 33316005), "1234"),
+			getTestNumber("NZ_NUMBER"),
+			SHORT_NSN_MATCH,
+		},
+		{&PhoneNumber{NationalNumber: proto.Uint64(3316005)}, getTestNumber("NZ_NUMBER"), SHORT_NSN_MATCH},
+		{getTestNumber("NZ_NUMBER"), newPhoneNumber(1, 33316005), NO_MATCH},
+		{getTestNumber("NZ_NUMBER"), newPhoneNumber(64, 33316006), NO_MATCH},
+		{
+			withExtension(newPhoneNumber(64, 33316005), "1234"),
+			withExtension(newPhoneNumber(64, 33316005), "4321"),
+			NO_MATCH,
+		},
+	}
+
+	u := NewPhoneNumberUtil()
+	for i, test := range tests {
+		first := proto.Clone(test.first).(*PhoneNumber)
+		res := u.IsNumberMatchWithNumbers(test.first, test.second)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+		if !proto.Equal(first, test.first) {
+			t.Errorf("[test %d] the first number was modified", i)
+		}
+	}
+}
+
+func TestIsNumberMatchWithOneNumber(t *testing.T) {
+	var tests = []struct {
+		first    *PhoneNumber
+		second   string
+		expected MatchType
+	}{
+		{getTestNumber("NZ_NUMBER"), "+64 3 331 6005", EXACT_MATCH},
+		{getTestNumber("NZ_NUMBER"), "tel:+64-3-331-6005", EXACT_MATCH},
+		// The second number is parsed as a number of the region of the
+		// first.
+		{getTestNumber("NZ_NUMBER"), "03 331 6005", NSN_MATCH},
+		{getTestNumber("NZ_NUMBER"), "331 6005", SHORT_NSN_MATCH},
+		{getTestNumber("NZ_NUMBER"), "+64 3 331 6005 ext. 1234", SHORT_NSN_MATCH},
+		{getTestNumber("NZ_NUMBER"), "+1 650 253 0000", NO_MATCH},
+		{getTestNumber("NZ_NUMBER"), "03 331 6006", NO_MATCH},
+		{getTestNumber("NZ_NUMBER"), "not a number", NOT_A_NUMBER},
+		// Neither number has a known country calling code.
+		{&PhoneNumber{NationalNumber: proto.Uint64(33316005)}, "3 331 6005", NSN_MATCH},
+	}
+
+	u := NewPhoneNumberUtil()
+	for i, test := range tests {
+		res := u.IsNumberMatchWithOneNumber(test.first, test.second)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+	}
+}
+
+func TestIsNumberMatch(t *testing.T) {
+	var tests = []struct {
+		first, second string
+		expected      MatchType
+	}{
+		{"+64 3 331 6005", "+64 03 331 6005", EXACT_MATCH},
+		{"+64 3 331 6005", "03 331 6005", NSN_MATCH},
+		{"03 331 6005", "+64 3 331 6005", NSN_MATCH},
+		{"+64 3 331 6005", "331 6005", SHORT_NSN_MATCH},
+		// Neither number has a country calling code. Without a region,
+		// the leading zero is kept, as for Italian numbers.
+		{"3 331 6005", "3-331-6005", NSN_MATCH},
+		{"3 331 6005", "03 331 6005", SHORT_NSN_MATCH},
+		{"3 331 6005", "331 6005", SHORT_NSN_MATCH},
+		{"+64 3 331 6005", "+1 3 331 6005", NO_MATCH},
+		{"+64 3 331 6005", "not a number", NOT_A_NUMBER},
+		{"3 331 6005", "not a number", NOT_A_NUMBER},
+	}
+
+	for i, test := range tests {
+		res := IsNumberMatch(test.first, test.second)
+		if res != test.expected {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, test.expected, res)
+		}
+	}
+}
+
+func TestIsNumberMatchWithoutCountryCodes(t *testing.T) {
+	// Numbers without a country calling code are parsed into numbers
+	// allocated by the caller, which used to be nil and panicked.
+	if res := IsNumberMatch("3 331 6005", "3-331-6005"); res != NSN_MATCH {
+		t.Errorf("IsNumberMatch() = %v, want %v", res, NSN_MATCH)
+	}
+	first := &PhoneNumber{NationalNumber: proto.Uint64(33316005)}
+	if res := IsNumberMatchWithOneNumber(first, "3 331 6005"); res != NSN_MATCH {
+		t.Errorf("IsNumberMatchWithOneNumber() = %v, want %v", res, NSN_MATCH)
+	}
+}
+
+func TestIsNumberMatchWithNumbersCountryCodePresence(t *testing.T) {
+	// A number without a country calling code matches one with it as
+	// NSN_MATCH whichever comes first. The codes used to be made equal
+	// by setting both to the second one's value, which set an unset
+	// code to zero when only the second number had none.
+	withCode := newPhoneNumber(64, 33316005)
+	withoutCode := &PhoneNumber{NationalNumber: proto.Uint64(33316005)}
+	zeroCode := &PhoneNumber{
+		CountryCode:    proto.Int32(0),
+		NationalNumber: proto.Uint64(33316005),
+	}
+	var tests = []struct {
+		first, second *PhoneNumber
+	}{
+		{withCode, withoutCode},
+		{withoutCode, withCode},
+		{withCode, zeroCode},
+		{zeroCode, withCode},
+		{withoutCode, zeroCode},
+	}
+	for i, test := range tests {
+		res := IsNumberMatchWithNumbers(test.first, test.second)
+		if res != NSN_MATCH {
+			t.Errorf("[test %d] failed: should be %v, got %v", i, NSN_MATCH, res)
+		}
+	}
+}
+
 ////////// Copied from java-libphonenumber
 /**
  * Unit tests for PhoneNumberUtil.java
@@ -585,6 +867,73 @@ func Test_GetSupportedRegions(t *testing.T) {
 	}
 }
 
+func TestGetSupportedRegionsReturnsCopies(t *testing.T) {
+	u := NewPhoneNumberUtil()
+	delete(u.GetSupportedRegions(), "US")
+	if _, ok := u.GetSupportedRegions()["US"]; !ok {
+		t.Error("deleting from GetSupportedRegions() changed the supported regions")
+	}
+	delete(u.GetSupportedGlobalNetworkCallingCodes(), 800)
+	if _, ok := u.GetSupportedGlobalNetworkCallingCodes()[800]; !ok {
+		t.Error("deleting from GetSupportedGlobalNetworkCallingCodes() changed the calling codes")
+	}
+}
+
+func TestGetSupportedTypesForRegion(t *testing.T) {
+	var tests = []struct {
+		region   string
+		expected []PhoneNumberType
+	}{
+		{"US", []PhoneNumberType{
+			FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, PERSONAL_NUMBER, UAN}},
+		{"CH", []PhoneNumberType{
+			FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, SHARED_COST,
+			PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL}},
+		{"ZZ", nil},
+		{"", nil},
+	}
+	for i, test := range tests {
+		types := GetSupportedTypesForRegion(test.region)
+		if len(types) != len(test.expected) {
+			t.Errorf("[test %d] %s: got types %v, want %v",
+				i, test.region, types, test.expected)
+		}
+		for _, typ := range test.expected {
+			if _, ok := types[typ]; !ok {
+				t.Errorf("[test %d] %s: type %v missing from %v",
+					i, test.region, typ, types)
+			}
+		}
+	}
+}
+
+func TestGetSupportedTypesForNonGeoEntity(t *testing.T) {
+	var tests = []struct {
+		countryCallingCode int
+		expected           []PhoneNumberType
+	}{
+		{800, []PhoneNumberType{TOLL_FREE}},
+		{979, []PhoneNumberType{PREMIUM_RATE}},
+		// A country calling code of regions, not of a non-geographical
+		// entity.
+		{1, nil},
+		{999, nil},
+	}
+	for i, test := range tests {
+		types := GetSupportedTypesForNonGeoEntity(test.countryCallingCode)
+		if len(types) != len(test.expected) {
+			t.Errorf("[test %d] %d: got types %v, want %v",
+				i, test.countryCallingCode, types, test.expected)
+		}
+		for _, typ := range test.expected {
+			if _, ok := types[typ]; !ok {
+				t.Errorf("[test %d] %d: type %v missing from %v",
+					i, test.countryCallingCode, typ, types)
+			}
+		}
+	}
+}
+
 func Test_getMetadata(t *testing.T) {
 	var tests = []struct {
 		name       string
@@ -618,7 +967,7 @@ func Test_getMetadata(t *testing.T) {
 		},
 	}
 	for i, test := range tests {
-		meta := getMetadataForRegion(test.name)
+		meta := defaultPhoneNumberUtil.getMetadataForRegion(test.name)
 		if meta.GetId() != test.name {
 			t.Errorf("[test %d:name] %s != %s\n", i, meta.GetId(), test.name)
 		}
@@ -641,10 +990,10 @@ func Test_getMetadata(t *testing.T) {
 }
 
 func Test_isNumberGeographical(t *testing.T) {
-	if !isNumberGeographical(getTestNumber("AU_NUMBER")) {
+	if !defaultPhoneNumberUtil.isNumberGeographical(getTestNumber("AU_NUMBER")) {
 		t.Error("Australia should be a geographical number")
 	}
-	if isNumberGeographical(getTestNumber("INTERNATIONAL_TOLL_FREE")) {
+	if defaultPhoneNumberUtil.isNumberGeographical(getTestNumber("INTERNATIONAL_TOLL_FREE")) {
 		t.Error("An international toll free number should not be geographical")
 	}
 }
@@ -697,7 +1046,16 @@ func TestGetNationalSignificantNumber(t *testing.T) {
 	}
 }
 
+// Skips the test if the metadata has no example numbers, as in builds
+// with the libphonenumber_lite tag.
+func skipWithoutExampleNumbers(t *testing.T) {
+	if GetExampleNumber("US") == nil {
+		t.Skip("the metadata has no example numbers")
+	}
+}
+
 func Test_GetExampleNumberForType(t *testing.T) {
+	skipWithoutExampleNumbers(t)
 	if !reflect.DeepEqual(getTestNumber("DE_NUMBER"), GetExampleNumber("DE")) {
 		t.Error("the example number for Germany should have been the " +
 			"same as the test number we're using")
@@ -730,7 +1088,61 @@ func Test_GetExampleNumberForType(t *testing.T) {
 	}
 }
 
+func TestGetInvalidExampleNumber(t *testing.T) {
+	skipWithoutExampleNumbers(t)
+	for regionCode := range GetSupportedRegions() {
+		number := GetInvalidExampleNumber(regionCode)
+		if number == nil {
+			t.Errorf("no invalid example number for %s", regionCode)
+			continue
+		}
+		if IsValidNumber(number) {
+			t.Errorf("invalid example number %v for %s is valid", number, regionCode)
+		}
+		if got, want := number.GetCountryCode(), int32(GetCountryCodeForRegion(regionCode)); got != want {
+			t.Errorf("invalid example number for %s has country code %d, want %d",
+				regionCode, got, want)
+		}
+	}
+
+	var tests = []struct {
+		region string
+		typ    PhoneNumberType
+	}{
+		// Regions with fixed-line numbers of several lengths, and of one
+		// length only.
+		{"US", FIXED_LINE},
+		{"DE", FIXED_LINE},
+		{"CH", FIXED_LINE},
+		{"GB", MOBILE},
+		{"US", TOLL_FREE},
+	}
+	for i, test := range tests {
+		number := GetInvalidExampleNumberForType(test.region, test.typ)
+		if number == nil {
+			t.Errorf("[test %d] no invalid example number", i)
+			continue
+		}
+		if res := IsPossibleNumberWithReason(number); res != IS_POSSIBLE {
+			t.Errorf("[test %d] %v is not possible: %v", i, number, res)
+		}
+		if IsValidNumber(number) {
+			t.Errorf("[test %d] %v is valid", i, number)
+		}
+	}
+
+	// CS is an invalid region, and SHARED_COST a type without numbers
+	// in the US.
+	if number := GetInvalidExampleNumber("CS"); number != nil {
+		t.Errorf("GetInvalidExampleNumber(CS) = %v, want nil", number)
+	}
+	if number := GetInvalidExampleNumberForType("US", SHARED_COST); number != nil {
+		t.Errorf("GetInvalidExampleNumberForType(US, SHARED_COST) = %v, want nil", number)
+	}
+}
+
 func TestGetExampleNumberForNonGeoEntity(t *testing.T) {
+	skipWithoutExampleNumbers(t)
 	if !reflect.DeepEqual(
 		getTestNumber("INTERNATIONAL_TOLL_FREE"),
 		GetExampleNumberForNonGeoEntity(800)) {
@@ -943,3 +1355,98 @@ func TestGetTimeZonesForRegion(t *testing.T) {
 		}
 	}
 }
+
+func TestPhoneNumberUtilsAreIndependent(t *testing.T) {
+	// One PhoneNumberUtil only knows Swiss numbers starting with 7, the
+	// other has Swiss internal extensions as its only fixed-line numbers.
+	narrowed := NewPhoneNumberUtil()
+	data := modifiedMetadata(t, func(meta *PhoneMetadata) {
+		if meta.GetId() == "CH" {
+			meta.GeneralDesc.NationalNumberPattern = proto.String("7\\d{8}")
+		}
+	})
+	if err := narrowed.LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	extended := NewPhoneNumberUtil()
+	if err := extended.SetRegionMetadataOverride(chExtensionsOverride); err != nil {
+		t.Fatal(err)
+	}
+
+	var numbers = []string{"044 668 18 00", "078 123 45 67", "12345"}
+	var tests = []struct {
+		util  *PhoneNumberUtil
+		valid []bool
+	}{
+		{defaultPhoneNumberUtil, []bool{true, true, false}},
+		{narrowed, []bool{false, true, false}},
+		{extended, []bool{false, true, true}},
+	}
+
+	var wg sync.WaitGroup
+	for i, test := range tests {
+		wg.Add(1)
+		go func(i int, util *PhoneNumberUtil, valid []bool) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				for k, input := range numbers {
+					number, err := util.Parse(input, "CH")
+					if err != nil {
+						t.Errorf("[test %d] %s: %v", i, input, err)
+						return
+					}
+					if got := util.IsValidNumber(number); got != valid[k] {
+						t.Errorf("[test %d] IsValidNumber(%s) = %v, want %v",
+							i, input, got, valid[k])
+						return
+					}
+				}
+			}
+		}(i, test.util, test.valid)
+	}
+	wg.Wait()
+
+	// The package-level functions use the default PhoneNumberUtil.
+	number, err := Parse("044 668 18 00", "CH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsValidNumber(number) || GetNumberType(number) != FIXED_LINE {
+		t.Error("the default PhoneNumberUtil picked up the metadata of another")
+	}
+}
+
+func TestPhoneNumberUtilFormattersAndMatchers(t *testing.T) {
+	extended := NewPhoneNumberUtil()
+	if err := extended.SetRegionMetadataOverride(chExtensionsOverride); err != nil {
+		t.Fatal(err)
+	}
+
+	formatter := extended.GetAsYouTypeFormatter("CH")
+	var got string
+	for _, c := range "12345" {
+		got = formatter.InputDigit(c)
+	}
+	if got != "12 345" {
+		t.Errorf("extended formatter: got %q, want %q", got, "12 345")
+	}
+	formatter = GetAsYouTypeFormatter("CH")
+	for _, c := range "12345" {
+		got = formatter.InputDigit(c)
+	}
+	if got != "12345" {
+		t.Errorf("default formatter: got %q, want %q", got, "12345")
+	}
+
+	text := "Call extension 12345."
+	matcher := extended.FindNumbers(text, "CH")
+	if !matcher.HasNext() {
+		t.Fatal("extended matcher found no number")
+	}
+	if match := matcher.Next(); match.RawString != "12345" {
+		t.Errorf("extended matcher found %q, want %q", match.RawString, "12345")
+	}
+	if FindNumbers(text, "CH").HasNext() {
+		t.Error("default matcher found an extension")
+	}
+}
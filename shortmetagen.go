@@ -0,0 +1,3674 @@
+// Code generated by cmd/metagen. DO NOT EDIT.
+
+package libphonenumber
+
+var shortMetaData = []byte{
+	0x0A, 0x73, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x43, 0xDA, 0x01, 0x11,
+	0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32,
+	0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x6D, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x0F, 0x12, 0x08, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x38, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x44, 0xDA, 0x01, 0x0F, 0x12, 0x08,
+	0x31, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30,
+	0xEA, 0x01, 0x0F, 0x12, 0x08, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x38, 0x5D,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8B, 0x01, 0x0A, 0x12, 0x12,
+	0x0C, 0x5B, 0x31, 0x34, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D,
+	0x48, 0x03, 0x48, 0x04, 0x22, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x41, 0x45, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C,
+	0x39, 0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48,
+	0x03, 0xEA, 0x01, 0x1A, 0x12, 0x13, 0x31, 0x31, 0x32, 0x7C, 0x34, 0x34, 0x35,
+	0x5B, 0x31, 0x36, 0x5D, 0x7C, 0x39, 0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x9C, 0x01, 0x0A, 0x18, 0x12, 0x12,
+	0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x16, 0x12, 0x0D,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x31, 0x39, 0x29,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x46, 0xDA, 0x01,
+	0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x32, 0x5D, 0x7C,
+	0x31, 0x39, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x1A,
+	0x12, 0x13, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x31,
+	0x39, 0x29, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x30, 0x30,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x7F, 0x0A, 0x0C,
+	0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x47, 0xDA,
+	0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x37,
+	0x36, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39,
+	0x39, 0x29, 0x32, 0x03, 0x31, 0x37, 0x36, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03,
+	0x31, 0x37, 0x36, 0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0x72, 0x0A, 0x0C, 0x12,
+	0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11,
+	0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32,
+	0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x49, 0xDA, 0x01, 0x0A, 0x12, 0x03,
+	0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x15, 0x12, 0x0E,
+	0x31, 0x37, 0x36, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38,
+	0x29, 0x32, 0x03, 0x31, 0x37, 0x36, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31,
+	0x37, 0x36, 0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0xEF, 0x01, 0x0A, 0x15, 0x12,
+	0x0B, 0x5B, 0x31, 0x35, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x30, 0x12, 0x25, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5B, 0x30, 0x31, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x32, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x7C,
+	0x33, 0x5B, 0x31, 0x35, 0x5D, 0x7C, 0x34, 0x31, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x11, 0x12, 0x06, 0x35, 0x5C, 0x64, 0x7B,
+	0x34, 0x7D, 0x32, 0x05, 0x35, 0x30, 0x30, 0x30, 0x30, 0x48, 0x05, 0x4A, 0x02,
+	0x41, 0x4C, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32,
+	0x7C, 0x32, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0x55, 0x12, 0x4E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28,
+	0x3F, 0x3A, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x36, 0x7C, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38,
+	0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x36, 0x35, 0x5C, 0x64, 0x7C, 0x38, 0x39,
+	0x5B, 0x31, 0x32, 0x5D, 0x29, 0x7C, 0x35, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x33, 0x34, 0x39, 0x5D, 0x5C, 0x64, 0x7C,
+	0x32, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x32, 0x33, 0x32, 0x03, 0x31, 0x32, 0x33,
+	0x48, 0x03, 0x0A, 0x8F, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x34, 0x38,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x05, 0x22, 0x10, 0x12, 0x07, 0x31, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x32,
+	0x03, 0x31, 0x30, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x4D, 0xDA, 0x01, 0x10,
+	0x12, 0x07, 0x31, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x30,
+	0x31, 0x48, 0x03, 0xEA, 0x01, 0x1D, 0x12, 0x16, 0x28, 0x3F, 0x3A, 0x31, 0x7C,
+	0x38, 0x5B, 0x31, 0x2D, 0x37, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x34,
+	0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12,
+	0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34, 0x30,
+	0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C,
+	0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x32,
+	0x33, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x4F, 0xDA,
+	0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x32, 0x33, 0x35, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x32, 0x33,
+	0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xED, 0x01, 0x0A,
+	0x18, 0x12, 0x0E, 0x5B, 0x30, 0x31, 0x33, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x31, 0x2C, 0x34, 0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22,
+	0x3E, 0x12, 0x34, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x30, 0x2D, 0x33, 0x35, 0x2D, 0x37, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x34,
+	0x35, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x34,
+	0x37, 0x5D, 0x7C, 0x34, 0x5B, 0x34, 0x37, 0x38, 0x5D, 0x7C, 0x39, 0x29, 0x7C,
+	0x39, 0x31, 0x31, 0x32, 0x02, 0x31, 0x39, 0x48, 0x02, 0x48, 0x03, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x41, 0x52, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x30, 0x5B, 0x30, 0x31, 0x37,
+	0x5D, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA,
+	0x01, 0x45, 0x12, 0x3F, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x2D, 0x33, 0x35, 0x2D, 0x37, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32,
+	0x2D, 0x35, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C, 0x33, 0x5B,
+	0x34, 0x37, 0x5D, 0x7C, 0x34, 0x5B, 0x34, 0x37, 0x38, 0x5D, 0x7C, 0x39, 0x29,
+	0x7C, 0x33, 0x33, 0x37, 0x32, 0x7C, 0x38, 0x39, 0x33, 0x33, 0x38, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x02, 0x31, 0x39, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x38,
+	0x39, 0x33, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x38, 0x39, 0x33, 0x30, 0x30,
+	0x48, 0x05, 0x0A, 0x85, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x34, 0x39, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x13, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x41, 0x53, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x17, 0x12, 0x10, 0x34, 0x30,
+	0x34, 0x30, 0x34, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xA8, 0x02, 0x0A, 0x17,
+	0x12, 0x0D, 0x5B, 0x31, 0x32, 0x36, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x3F, 0x12,
+	0x32, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x32, 0x5B, 0x30, 0x32, 0x33,
+	0x38, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x2D,
+	0x32, 0x34, 0x37, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x34, 0x5B, 0x35, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41,
+	0x54, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x32,
+	0x5D, 0x32, 0x7C, 0x33, 0x33, 0x7C, 0x34, 0x34, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x6B, 0x12, 0x64, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30,
+	0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x37, 0x7C, 0x32, 0x33, 0x29,
+	0x29, 0x29, 0x7C, 0x32, 0x5B, 0x30, 0x32, 0x33, 0x38, 0x5D, 0x7C, 0x33, 0x5B,
+	0x30, 0x33, 0x5D, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x32, 0x34,
+	0x37, 0x5D, 0x7C, 0x35, 0x5B, 0x30, 0x35, 0x5D, 0x7C, 0x38, 0x34, 0x29, 0x29,
+	0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x36, 0x7C, 0x32,
+	0x30, 0x29, 0x7C, 0x36, 0x31, 0x7C, 0x38, 0x31, 0x30, 0x38, 0x5B, 0x31, 0x2D,
+	0x33, 0x5D, 0x29, 0x30, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x22,
+	0x12, 0x1B, 0x32, 0x32, 0x30, 0x5C, 0x64, 0x7C, 0x36, 0x31, 0x30, 0x7C, 0x28,
+	0x3F, 0x3A, 0x32, 0x30, 0x36, 0x7C, 0x38, 0x31, 0x30, 0x5C, 0x64, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x32, 0x03, 0x36, 0x31, 0x30, 0x0A, 0xEF, 0x02, 0x0A, 0x1B,
+	0x12, 0x0D, 0x5B, 0x30, 0x2D, 0x32, 0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x37, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x48,
+	0x08, 0x22, 0x2B, 0x12, 0x1E, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x36, 0x7C, 0x31, 0x32, 0x7C, 0x32, 0x35, 0x38, 0x38, 0x38, 0x35, 0x7C,
+	0x35, 0x35, 0x5C, 0x64, 0x29, 0x7C, 0x37, 0x33, 0x33, 0x32, 0x03, 0x30, 0x30,
+	0x30, 0x48, 0x03, 0x48, 0x04, 0x48, 0x07, 0x2A, 0x2B, 0x12, 0x19, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x28, 0x3F, 0x3A, 0x33, 0x34, 0x7C, 0x34, 0x35, 0x36, 0x29,
+	0x7C, 0x39, 0x5C, 0x64, 0x7B, 0x34, 0x2C, 0x36, 0x7D, 0x29, 0x32, 0x04, 0x31,
+	0x32, 0x33, 0x34, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x48, 0x08,
+	0x4A, 0x02, 0x41, 0x55, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x30, 0x30, 0x30, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x30,
+	0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x8D, 0x01, 0x12, 0x85, 0x01, 0x30, 0x30,
+	0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x7C, 0x32, 0x7C, 0x39, 0x5B, 0x34, 0x36, 0x5D, 0x29, 0x7C, 0x32,
+	0x28, 0x3F, 0x3A, 0x30, 0x31, 0x34, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x5B,
+	0x32, 0x33, 0x5D, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x34, 0x7C, 0x35, 0x5C,
+	0x64, 0x29, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x36, 0x38, 0x5B,
+	0x36, 0x38, 0x39, 0x5D, 0x7C, 0x37, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x30, 0x7C,
+	0x33, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x5B, 0x30,
+	0x31, 0x33, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x32, 0x29, 0x29, 0x7C, 0x35,
+	0x35, 0x35, 0x7C, 0x39, 0x5C, 0x64, 0x7B, 0x34, 0x2C, 0x36, 0x7D, 0x29, 0x7C,
+	0x32, 0x32, 0x35, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x33, 0x33, 0x7C, 0x36, 0x37,
+	0x29, 0x32, 0x03, 0x30, 0x30, 0x30, 0xF2, 0x01, 0x27, 0x12, 0x1A, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x32, 0x34, 0x37,
+	0x33, 0x33, 0x29, 0x7C, 0x32, 0x32, 0x35, 0x7C, 0x37, 0x36, 0x37, 0x32, 0x03,
+	0x32, 0x32, 0x35, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0xFA, 0x01, 0x1C, 0x12,
+	0x10, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x35, 0x38, 0x38, 0x38, 0x35, 0x7C, 0x35,
+	0x35, 0x5C, 0x64, 0x29, 0x32, 0x04, 0x31, 0x35, 0x35, 0x30, 0x48, 0x04, 0x48,
+	0x07, 0x0A, 0x79, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x30, 0x30, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41, 0x57, 0xDA, 0x01,
+	0x0E, 0x12, 0x07, 0x31, 0x30, 0x30, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xEA, 0x01, 0x1B, 0x12, 0x14, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30,
+	0x7C, 0x31, 0x38, 0x7C, 0x37, 0x36, 0x29, 0x7C, 0x39, 0x31, 0x5B, 0x31, 0x33,
+	0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31,
+	0x37, 0x36, 0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0x7C, 0x0A, 0x18, 0x12, 0x12,
+	0x5B, 0x31, 0x37, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x0C, 0x12, 0x03,
+	0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x41,
+	0x58, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x31, 0x32, 0x7C, 0x37,
+	0x35, 0x5B, 0x31, 0x32, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0xA5, 0x01, 0x0A, 0x12, 0x12, 0x0C, 0x5B, 0x31, 0x34,
+	0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x22, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33,
+	0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0x48, 0x03, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x41, 0x5A, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30,
+	0x31, 0x48, 0x03, 0xEA, 0x01, 0x22, 0x12, 0x1B, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C, 0x28, 0x3F, 0x3A,
+	0x34, 0x30, 0x34, 0x7C, 0x38, 0x38, 0x30, 0x29, 0x30, 0x32, 0x03, 0x31, 0x30,
+	0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x17, 0x12, 0x0D, 0x28, 0x3F, 0x3A, 0x34, 0x30, 0x34,
+	0x7C, 0x38, 0x38, 0x30, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x34, 0x30, 0x34, 0x30,
+	0x48, 0x04, 0x0A, 0xDC, 0x01, 0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x1E, 0x12, 0x13, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x7C, 0x32, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x32,
+	0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x41, 0xDA, 0x01, 0x10, 0x12,
+	0x07, 0x31, 0x32, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x32, 0x03, 0x31, 0x32, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0x65, 0x12, 0x5E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x32,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x37, 0x5D, 0x7C, 0x5B, 0x32, 0x2D,
+	0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x2D, 0x32, 0x36, 0x5D, 0x29, 0x7C, 0x28,
+	0x3F, 0x3A, 0x5B, 0x33, 0x2D, 0x35, 0x5D, 0x7C, 0x37, 0x5C, 0x64, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x7C, 0x32,
+	0x5B, 0x37, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x32, 0x03, 0x31,
+	0x32, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x7F, 0x0A, 0x0F, 0x12, 0x0B, 0x5B, 0x32, 0x2D,
+	0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x13, 0x12,
+	0x0C, 0x39, 0x38, 0x38, 0x7C, 0x5B, 0x32, 0x33, 0x35, 0x39, 0x5D, 0x31, 0x31,
+	0x32, 0x03, 0x32, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x42, 0xDA, 0x01, 0x0F, 0x12,
+	0x08, 0x5B, 0x32, 0x33, 0x35, 0x39, 0x5D, 0x31, 0x31, 0x32, 0x03, 0x32, 0x31,
+	0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x39, 0x38, 0x38, 0x7C, 0x5B, 0x32, 0x2D,
+	0x36, 0x38, 0x39, 0x5D, 0x31, 0x31, 0x32, 0x03, 0x32, 0x31, 0x31, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0E, 0x12, 0x07, 0x5B, 0x34, 0x36, 0x38, 0x5D, 0x31, 0x31, 0x32, 0x03,
+	0x34, 0x31, 0x31, 0x0A, 0xB2, 0x02, 0x0A, 0x15, 0x12, 0x0D, 0x5B, 0x31, 0x35,
+	0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x22, 0x18, 0x12, 0x0F, 0x31, 0x30, 0x5B, 0x30, 0x2D, 0x32,
+	0x36, 0x5D, 0x7C, 0x5B, 0x31, 0x39, 0x5D, 0x39, 0x39, 0x32, 0x03, 0x31, 0x30,
+	0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x44, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31,
+	0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x5B, 0x31, 0x39, 0x5D, 0x39, 0x39,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x97, 0x01, 0x12, 0x8F,
+	0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x33,
+	0x36, 0x39, 0x5D, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x7C, 0x37, 0x5B,
+	0x30, 0x2D, 0x34, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x32, 0x39, 0x5D, 0x29,
+	0x7C, 0x31, 0x5B, 0x31, 0x36, 0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x28, 0x3F, 0x3A,
+	0x5B, 0x31, 0x33, 0x34, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x29,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x3F, 0x7C, 0x36, 0x5B, 0x33,
+	0x2D, 0x36, 0x5D, 0x29, 0x7C, 0x35, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x29, 0x7C,
+	0x35, 0x30, 0x31, 0x32, 0x7C, 0x37, 0x38, 0x36, 0x7C, 0x39, 0x35, 0x39, 0x34,
+	0x7C, 0x5B, 0x31, 0x39, 0x5D, 0x39, 0x39, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x28, 0x3F, 0x3A, 0x35, 0x30, 0x7C, 0x36, 0x5C, 0x64, 0x29, 0x7C, 0x33, 0x33,
+	0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x5C, 0x64, 0x29, 0x29, 0x5C,
+	0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x2A, 0x12, 0x1F, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x5B, 0x31, 0x33, 0x5D, 0x29, 0x7C,
+	0x28, 0x3F, 0x3A, 0x35, 0x30, 0x31, 0x7C, 0x39, 0x35, 0x39, 0x29, 0x5C, 0x64,
+	0x7C, 0x37, 0x38, 0x36, 0x32, 0x03, 0x31, 0x31, 0x31, 0x48, 0x03, 0x48, 0x04,
+	0x0A, 0x9D, 0x03, 0x0A, 0x22, 0x12, 0x1A, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06,
+	0x22, 0x3B, 0x12, 0x34, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x33,
+	0x35, 0x2D, 0x38, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x39, 0x5D, 0x7C,
+	0x37, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x38, 0x31,
+	0x33, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x36, 0x7C, 0x38, 0x29, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x30, 0x30, 0x2A, 0x3A, 0x12, 0x30,
+	0x31, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x34, 0x30, 0x29,
+	0x34, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x34, 0x5D,
+	0x31, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x37,
+	0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x32,
+	0x30, 0x34, 0x48, 0x04, 0x4A, 0x02, 0x42, 0x45, 0xDA, 0x01, 0x16, 0x12, 0x0D,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x31, 0x32, 0x29,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0xC3, 0x01, 0x12, 0xBB,
+	0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x7C, 0x31,
+	0x36, 0x31, 0x31, 0x37, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x33,
+	0x5B, 0x30, 0x2D, 0x32, 0x34, 0x5D, 0x29, 0x7C, 0x33, 0x31, 0x33, 0x7C, 0x34,
+	0x31, 0x34, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x35, 0x5D, 0x7C,
+	0x35, 0x5B, 0x31, 0x35, 0x5D, 0x7C, 0x36, 0x36, 0x7C, 0x39, 0x35, 0x29, 0x7C,
+	0x36, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x36, 0x37, 0x5D, 0x7C, 0x33, 0x36,
+	0x7C, 0x36, 0x5B, 0x31, 0x36, 0x5D, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x37, 0x5D, 0x5B, 0x30, 0x31, 0x37, 0x5D, 0x7C, 0x31, 0x5B, 0x32, 0x37,
+	0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x33, 0x7C, 0x36, 0x35, 0x29,
+	0x7C, 0x38, 0x31, 0x5B, 0x33, 0x39, 0x5D, 0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x36,
+	0x37, 0x39, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x30, 0x30, 0x7C,
+	0x34, 0x35, 0x29, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x2D, 0x34,
+	0x5D, 0x39, 0x7C, 0x37, 0x38, 0x29, 0x39, 0x7C, 0x31, 0x5B, 0x32, 0x2D, 0x34,
+	0x5D, 0x30, 0x5B, 0x34, 0x37, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x5F, 0x0A, 0x07, 0x12, 0x03, 0x31, 0x5C, 0x64, 0x48, 0x02, 0x22, 0x0B,
+	0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x42, 0x46, 0xDA, 0x01, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32,
+	0x02, 0x31, 0x37, 0xEA, 0x01, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D,
+	0x32, 0x02, 0x31, 0x37, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xAF, 0x01, 0x0A, 0x15, 0x12, 0x0F,
+	0x31, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x3F, 0x48, 0x03, 0x48, 0x06, 0x22, 0x22, 0x12, 0x1B, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x5B, 0x30, 0x36, 0x5D, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x47, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x5B, 0x30, 0x36,
+	0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x28, 0x12,
+	0x21, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29, 0x29, 0x7C, 0x35,
+	0x30, 0x7C, 0x36, 0x5B, 0x30, 0x36, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xF4, 0x01, 0x0A, 0x1A, 0x12, 0x14, 0x5B, 0x30, 0x31, 0x38,
+	0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32,
+	0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x20, 0x12, 0x19, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x31, 0x36, 0x37, 0x5D, 0x7C, 0x38, 0x31, 0x29, 0x5C, 0x64,
+	0x7B, 0x33, 0x7D, 0x7C, 0x5B, 0x31, 0x39, 0x5D, 0x39, 0x39, 0x32, 0x03, 0x31,
+	0x39, 0x39, 0x2A, 0x16, 0x12, 0x0B, 0x39, 0x5B, 0x31, 0x34, 0x38, 0x5D, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x39, 0x31, 0x30, 0x30, 0x30, 0x48, 0x05,
+	0x4A, 0x02, 0x42, 0x48, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x5B, 0x31, 0x39, 0x5D,
+	0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x53, 0x12,
+	0x4C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x5C, 0x64, 0x7C, 0x31,
+	0x32, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x35, 0x31, 0x7C, 0x38, 0x5B,
+	0x31, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x36, 0x39, 0x5D, 0x29, 0x7C, 0x39,
+	0x39, 0x5B, 0x30, 0x32, 0x34, 0x38, 0x39, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x36, 0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x31, 0x35, 0x38, 0x5D, 0x7C,
+	0x39, 0x5B, 0x31, 0x34, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32,
+	0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x21, 0x12, 0x16, 0x30, 0x5B, 0x36,
+	0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x38, 0x38, 0x30, 0x30, 0x30,
+	0x7C, 0x39, 0x38, 0x35, 0x35, 0x35, 0x32, 0x05, 0x30, 0x36, 0x30, 0x30, 0x30,
+	0x48, 0x05, 0x0A, 0xBF, 0x01, 0x0A, 0x13, 0x12, 0x0D, 0x5B, 0x31, 0x36, 0x2D,
+	0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x22, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x5B, 0x32, 0x33, 0x37, 0x5D, 0x7C, 0x36,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x49,
+	0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x32, 0x33, 0x37, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x3D, 0x12, 0x36, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x7C, 0x35, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x7C,
+	0x36, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x36, 0x5D, 0x29, 0x7C, 0x36, 0x31, 0x31,
+	0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x7C, 0x37, 0x37, 0x7C, 0x39, 0x37,
+	0x39, 0x29, 0x7C, 0x38, 0x5B, 0x32, 0x38, 0x5D, 0x38, 0x7C, 0x39, 0x30, 0x30,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x1F, 0x12, 0x16, 0x36, 0x31,
+	0x31, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x7C, 0x37, 0x37, 0x29, 0x7C,
+	0x38, 0x38, 0x38, 0x7C, 0x39, 0x30, 0x30, 0x32, 0x03, 0x36, 0x31, 0x31, 0x48,
+	0x03, 0x0A, 0xCC, 0x01, 0x0A, 0x11, 0x12, 0x0B, 0x5B, 0x31, 0x37, 0x5D, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x2B, 0x12,
+	0x24, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x34, 0x36, 0x2D, 0x38, 0x5D,
+	0x7C, 0x33, 0x5B, 0x36, 0x38, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x36, 0x5D, 0x29,
+	0x7C, 0x37, 0x5B, 0x33, 0x2D, 0x35, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x4A, 0xDA, 0x01, 0x12, 0x12, 0x09, 0x31,
+	0x31, 0x5B, 0x32, 0x34, 0x36, 0x2D, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0x41, 0x12, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x35,
+	0x7C, 0x31, 0x5B, 0x32, 0x34, 0x2D, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x32,
+	0x2D, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x31, 0x32, 0x36, 0x2D, 0x38, 0x5D, 0x7C,
+	0x35, 0x5B, 0x30, 0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x38,
+	0x39, 0x29, 0x7C, 0x37, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x03, 0x31, 0x30, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x11, 0x12, 0x08, 0x31, 0x32,
+	0x5B, 0x30, 0x32, 0x2D, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x32, 0x30, 0x48, 0x03,
+	0x0A, 0xC6, 0x01, 0x0A, 0x21, 0x12, 0x19, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B,
+	0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x04, 0x48, 0x06, 0x22,
+	0x1F, 0x12, 0x15, 0x31, 0x38, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C,
+	0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x02,
+	0x31, 0x38, 0x48, 0x02, 0x48, 0x04, 0x2A, 0x15, 0x12, 0x0B, 0x33, 0x5B, 0x32,
+	0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x33, 0x32, 0x30,
+	0x30, 0x48, 0x04, 0x4A, 0x02, 0x42, 0x4C, 0xDA, 0x01, 0x0A, 0x12, 0x02, 0x31,
+	0x38, 0x32, 0x02, 0x31, 0x38, 0x48, 0x02, 0xEA, 0x01, 0x3B, 0x12, 0x35, 0x31,
+	0x38, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x2D, 0x37, 0x39,
+	0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x29,
+	0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D,
+	0x7C, 0x33, 0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x02, 0x31, 0x38, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x72, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12, 0x0A,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39,
+	0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x42, 0x4D, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x37,
+	0x36, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32,
+	0x03, 0x31, 0x37, 0x36, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x37, 0x36,
+	0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C,
+	0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x31,
+	0x33, 0x35, 0x5D, 0x32, 0x03, 0x39, 0x39, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x4E, 0xDA,
+	0x01, 0x0E, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x31, 0x33, 0x35, 0x5D, 0x32, 0x03,
+	0x39, 0x39, 0x31, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x31, 0x33,
+	0x35, 0x5D, 0x32, 0x03, 0x39, 0x39, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x83, 0x01, 0x0A,
+	0x18, 0x12, 0x12, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22,
+	0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x4F, 0xDA, 0x01, 0x10, 0x12, 0x07,
+	0x31, 0x31, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48,
+	0x03, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x31, 0x31, 0x5B, 0x30, 0x38, 0x39, 0x5D,
+	0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x73, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C,
+	0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x51, 0xDA, 0x01, 0x0E,
+	0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C,
+	0x37, 0x36, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x37, 0x36, 0x32, 0x03, 0x31, 0x37, 0x36,
+	0x0A, 0xB8, 0x04, 0x0A, 0x17, 0x12, 0x0D, 0x5B, 0x31, 0x2D, 0x36, 0x39, 0x5D,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05,
+	0x48, 0x06, 0x22, 0x3A, 0x12, 0x2F, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C,
+	0x31, 0x32, 0x7C, 0x32, 0x38, 0x7C, 0x38, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C,
+	0x39, 0x5B, 0x30, 0x2D, 0x34, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x34, 0x28,
+	0x3F, 0x3A, 0x35, 0x37, 0x7C, 0x38, 0x32, 0x5C, 0x64, 0x29, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x04, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42,
+	0x52, 0xDA, 0x01, 0x1E, 0x12, 0x15, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C,
+	0x32, 0x38, 0x7C, 0x39, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x29, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0xD8, 0x02, 0x12,
+	0xD0, 0x02, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32,
+	0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x2D, 0x35, 0x37, 0x39,
+	0x5D, 0x7C, 0x32, 0x5B, 0x31, 0x33, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x31,
+	0x32, 0x34, 0x2D, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x31, 0x2D, 0x33, 0x35, 0x37,
+	0x38, 0x5D, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x34, 0x36, 0x38, 0x5D, 0x7C, 0x36,
+	0x5B, 0x31, 0x33, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x31, 0x34, 0x39, 0x5D, 0x7C,
+	0x39, 0x5B, 0x31, 0x36, 0x38, 0x5D, 0x29, 0x7C, 0x35, 0x5B, 0x30, 0x2D, 0x33,
+	0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x5B,
+	0x30, 0x2D, 0x33, 0x35, 0x2D, 0x38, 0x5D, 0x3F, 0x7C, 0x32, 0x5B, 0x30, 0x31,
+	0x34, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x33, 0x37, 0x5D, 0x3F, 0x7C,
+	0x34, 0x5B, 0x33, 0x37, 0x2D, 0x39, 0x5D, 0x3F, 0x7C, 0x35, 0x5B, 0x30, 0x2D,
+	0x33, 0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x31, 0x36, 0x5D, 0x3F, 0x7C, 0x37,
+	0x5B, 0x31, 0x33, 0x37, 0x5D, 0x3F, 0x7C, 0x38, 0x5B, 0x35, 0x2D, 0x38, 0x5D,
+	0x7C, 0x39, 0x5B, 0x31, 0x33, 0x35, 0x39, 0x5D, 0x29, 0x29, 0x7C, 0x31, 0x5B,
+	0x32, 0x35, 0x2D, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x33, 0x35, 0x37, 0x2D, 0x39,
+	0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x32, 0x34, 0x2D, 0x36, 0x38, 0x5D, 0x7C, 0x34,
+	0x5B, 0x31, 0x32, 0x35, 0x36, 0x38, 0x5D, 0x7C, 0x35, 0x5C, 0x64, 0x7C, 0x36,
+	0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C,
+	0x39, 0x5B, 0x30, 0x2D, 0x34, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x28,
+	0x3F, 0x3A, 0x37, 0x28, 0x3F, 0x3A, 0x33, 0x33, 0x30, 0x7C, 0x38, 0x37, 0x38,
+	0x29, 0x7C, 0x38, 0x35, 0x39, 0x35, 0x39, 0x3F, 0x29, 0x7C, 0x28, 0x3F, 0x3A,
+	0x33, 0x32, 0x7C, 0x39, 0x31, 0x29, 0x31, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30,
+	0x34, 0x30, 0x34, 0x3F, 0x7C, 0x35, 0x37, 0x7C, 0x38, 0x32, 0x38, 0x29, 0x7C,
+	0x35, 0x35, 0x35, 0x35, 0x35, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64,
+	0x7B, 0x34, 0x7D, 0x7C, 0x31, 0x30, 0x30, 0x30, 0x30, 0x29, 0x7C, 0x28, 0x3F,
+	0x3A, 0x31, 0x33, 0x33, 0x7C, 0x34, 0x31, 0x31, 0x29, 0x5B, 0x31, 0x32, 0x5D,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x1A, 0x12, 0x0F, 0x31, 0x30, 0x32,
+	0x7C, 0x32, 0x37, 0x33, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x33, 0x32, 0x31, 0x32,
+	0x03, 0x31, 0x30, 0x32, 0x48, 0x03, 0x48, 0x05, 0xFA, 0x01, 0x35, 0x12, 0x28,
+	0x31, 0x35, 0x31, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x37, 0x38, 0x7C, 0x35, 0x35,
+	0x35, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x34,
+	0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x31, 0x31, 0x5C, 0x64, 0x7C, 0x35, 0x37,
+	0x29, 0x32, 0x03, 0x31, 0x35, 0x31, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x0A,
+	0x75, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x39, 0x5D, 0x7C,
+	0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x53, 0xDA,
+	0x01, 0x0D, 0x12, 0x06, 0x39, 0x31, 0x5B, 0x31, 0x39, 0x5D, 0x32, 0x03, 0x39,
+	0x31, 0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x31, 0x39, 0x5D, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x83, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x34, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F,
+	0x48, 0x03, 0x48, 0x05, 0x22, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32,
+	0x33, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x54,
+	0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x31, 0x31,
+	0x5B, 0x30, 0x2D, 0x36, 0x5D, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03,
+	0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xAF, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B,
+	0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B,
+	0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x17, 0x12, 0x0E, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x42, 0x57, 0xDA, 0x01,
+	0x17, 0x12, 0x0E, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x5B, 0x37,
+	0x2D, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01,
+	0x2B, 0x12, 0x24, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x36, 0x5D, 0x7C,
+	0x33, 0x31, 0x32, 0x33, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31,
+	0x34, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x2D, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x29,
+	0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x31, 0x33,
+	0x31, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x31, 0x33, 0x31, 0x30, 0x30, 0x48,
+	0x05, 0x0A, 0xA9, 0x01, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x42, 0x59, 0xDA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30,
+	0x31, 0xEA, 0x01, 0x3F, 0x12, 0x38, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x31, 0x5B, 0x32, 0x34, 0x36, 0x5D, 0x7C, 0x33,
+	0x35, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x33, 0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x38,
+	0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x35, 0x2D, 0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x35,
+	0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x2D, 0x37, 0x5D, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x91, 0x01, 0x0A, 0x0C, 0x12, 0x06, 0x39, 0x5C,
+	0x64, 0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x1E, 0x12, 0x18, 0x39,
+	0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x31, 0x7C, 0x32, 0x32, 0x7C, 0x36, 0x36,
+	0x7C, 0x37, 0x37, 0x7C, 0x39, 0x5B, 0x30, 0x39, 0x5D, 0x29, 0x32, 0x02, 0x39,
+	0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x42, 0x5A, 0xDA, 0x01, 0x12, 0x12, 0x0C, 0x39, 0x28, 0x3F,
+	0x3A, 0x30, 0x7C, 0x31, 0x31, 0x7C, 0x39, 0x30, 0x29, 0x32, 0x02, 0x39, 0x30,
+	0xEA, 0x01, 0x1E, 0x12, 0x18, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x31,
+	0x7C, 0x32, 0x32, 0x7C, 0x36, 0x36, 0x7C, 0x37, 0x37, 0x7C, 0x39, 0x5B, 0x30,
+	0x39, 0x5D, 0x29, 0x32, 0x02, 0x39, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xAF, 0x01, 0x0A,
+	0x1D, 0x12, 0x15, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28,
+	0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03,
+	0x48, 0x05, 0x48, 0x06, 0x22, 0x17, 0x12, 0x0E, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x38, 0x38, 0x7C, 0x5B, 0x32, 0x39, 0x5D, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x41, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31,
+	0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0xEA, 0x01, 0x2D, 0x12, 0x26, 0x5B, 0x31, 0x2D, 0x33, 0x35, 0x2D, 0x39, 0x5D,
+	0x5C, 0x64, 0x7B, 0x34, 0x2C, 0x35, 0x7D, 0x7C, 0x31, 0x31, 0x32, 0x7C, 0x5B,
+	0x32, 0x2D, 0x38, 0x5D, 0x31, 0x31, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31,
+	0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12,
+	0x12, 0x09, 0x5B, 0x32, 0x33, 0x35, 0x2D, 0x37, 0x5D, 0x31, 0x31, 0x32, 0x03,
+	0x32, 0x31, 0x31, 0x48, 0x03, 0x0A, 0x6D, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x30,
+	0x31, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x30,
+	0x30, 0x30, 0x7C, 0x31, 0x31, 0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x43, 0x43, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31,
+	0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x30, 0x30,
+	0x30, 0x7C, 0x31, 0x31, 0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xAB, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C,
+	0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03,
+	0x48, 0x05, 0x22, 0x1A, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x33,
+	0x34, 0x38, 0x5D, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x31,
+	0x31, 0x33, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x44, 0xDA, 0x01, 0x1A, 0x12, 0x11,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x33, 0x34, 0x38, 0x5D, 0x7C, 0x37, 0x37,
+	0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x31, 0x31, 0x33, 0x48, 0x03, 0xEA, 0x01,
+	0x21, 0x12, 0x1A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x33, 0x34, 0x38, 0x5D,
+	0x7C, 0x32, 0x33, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x29, 0x7C, 0x34, 0x30,
+	0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x31, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12,
+	0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34, 0x30, 0x34,
+	0x30, 0x30, 0x48, 0x05, 0x0A, 0x86, 0x01, 0x0A, 0x0E, 0x12, 0x08, 0x31, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x16, 0x12,
+	0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x32, 0x32,
+	0x5C, 0x64, 0x29, 0x32, 0x03, 0x31, 0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x46, 0xDA,
+	0x01, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D,
+	0x7C, 0x32, 0x32, 0x30, 0x29, 0x32, 0x03, 0x31, 0x31, 0x37, 0xEA, 0x01, 0x16,
+	0x12, 0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x34, 0x37, 0x38, 0x5D, 0x7C,
+	0x32, 0x32, 0x30, 0x29, 0x32, 0x03, 0x31, 0x31, 0x34, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6B,
+	0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E,
+	0x12, 0x07, 0x31, 0x31, 0x5B, 0x31, 0x37, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31,
+	0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x43, 0x47, 0xDA, 0x01, 0x0D, 0x12, 0x06, 0x31, 0x31, 0x5B,
+	0x37, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x37, 0xEA, 0x01, 0x10, 0x12, 0x09,
+	0x31, 0x31, 0x5B, 0x31, 0x32, 0x36, 0x2D, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31,
+	0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0xDF, 0x02, 0x0A, 0x16, 0x12, 0x0C, 0x5B, 0x31, 0x2D,
+	0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x48, 0x05, 0x48, 0x06, 0x22, 0x2F, 0x12, 0x22, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x37, 0x38, 0x5D, 0x7C, 0x36, 0x5C, 0x64, 0x7B,
+	0x33, 0x7D, 0x29, 0x7C, 0x34, 0x5B, 0x32, 0x34, 0x37, 0x5D, 0x29, 0x7C, 0x35,
+	0x32, 0x30, 0x30, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x06, 0x2A, 0x2A, 0x12, 0x1D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x34, 0x7C, 0x38,
+	0x5B, 0x30, 0x2D, 0x32, 0x35, 0x38, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x7C, 0x35,
+	0x34, 0x33, 0x7C, 0x38, 0x33, 0x31, 0x31, 0x31, 0x32, 0x03, 0x35, 0x34, 0x33,
+	0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x4A, 0x02, 0x43, 0x48, 0xDA, 0x01, 0x17,
+	0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x37, 0x38, 0x5D, 0x7C,
+	0x34, 0x34, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x81,
+	0x01, 0x12, 0x7A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x37, 0x38, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x37, 0x38, 0x5D,
+	0x7C, 0x34, 0x35, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31,
+	0x31, 0x31, 0x29, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D,
+	0x35, 0x37, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x31, 0x34, 0x35, 0x5D, 0x29, 0x7C,
+	0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x2D, 0x34, 0x36, 0x5D,
+	0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x31, 0x5B, 0x31, 0x38,
+	0x39, 0x5D, 0x7C, 0x5B, 0x32, 0x35, 0x5D, 0x30, 0x7C, 0x37, 0x7C, 0x38, 0x5B,
+	0x30, 0x38, 0x5D, 0x7C, 0x39, 0x39, 0x29, 0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x2B, 0x12, 0x20, 0x31, 0x28, 0x3F, 0x3A, 0x34, 0x5B, 0x30, 0x33,
+	0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x31, 0x2D, 0x34, 0x36, 0x5D, 0x29, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x34, 0x31, 0x7C, 0x36, 0x30, 0x29, 0x5C, 0x64, 0x32, 0x03,
+	0x31, 0x34, 0x30, 0x48, 0x03, 0x48, 0x04, 0xFA, 0x01, 0x16, 0x12, 0x0B, 0x35,
+	0x28, 0x3F, 0x3A, 0x32, 0x30, 0x30, 0x7C, 0x33, 0x35, 0x29, 0x32, 0x03, 0x35,
+	0x33, 0x35, 0x48, 0x03, 0x48, 0x04, 0x0A, 0x9A, 0x01, 0x0A, 0x11, 0x12, 0x0B,
+	0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03,
+	0x48, 0x04, 0x22, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30,
+	0x31, 0x5D, 0x7C, 0x5B, 0x37, 0x38, 0x5D, 0x30, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x49, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x5B, 0x37, 0x38, 0x5D,
+	0x30, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x1C, 0x12,
+	0x15, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x5B, 0x37,
+	0x38, 0x5D, 0x30, 0x29, 0x7C, 0x34, 0x34, 0x34, 0x33, 0x32, 0x03, 0x31, 0x31,
+	0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x05, 0x34, 0x34, 0x34, 0x5C, 0x64, 0x32,
+	0x04, 0x34, 0x34, 0x34, 0x30, 0x48, 0x04, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05,
+	0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x39, 0x39,
+	0x5B, 0x36, 0x38, 0x39, 0x5D, 0x32, 0x03, 0x39, 0x39, 0x36, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43,
+	0x4B, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x36, 0x38, 0x39, 0x5D,
+	0x32, 0x03, 0x39, 0x39, 0x36, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x39, 0x39, 0x5B,
+	0x36, 0x38, 0x39, 0x5D, 0x32, 0x03, 0x39, 0x39, 0x36, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xBD,
+	0x05, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x24, 0x12,
+	0x19, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x31, 0x33, 0x7C, 0x33, 0x5B, 0x31, 0x2D,
+	0x33, 0x5D, 0x29, 0x7C, 0x34, 0x33, 0x34, 0x5C, 0x64, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x31, 0x33, 0x31, 0x48, 0x03, 0x48, 0x04, 0x2A, 0xE7, 0x01, 0x12,
+	0xDA, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x31, 0x31, 0x7C, 0x33, 0x28, 0x3F,
+	0x3A, 0x31, 0x33, 0x7C, 0x5B, 0x33, 0x34, 0x38, 0x5D, 0x30, 0x7C, 0x35, 0x5B,
+	0x30, 0x31, 0x5D, 0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A,
+	0x5B, 0x30, 0x35, 0x5D, 0x36, 0x7C, 0x5B, 0x34, 0x38, 0x5D, 0x31, 0x7C, 0x39,
+	0x5B, 0x31, 0x38, 0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x5C,
+	0x64, 0x7C, 0x5B, 0x32, 0x33, 0x5D, 0x32, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38,
+	0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x31,
+	0x33, 0x7C, 0x33, 0x5B, 0x32, 0x37, 0x39, 0x5D, 0x7C, 0x36, 0x36, 0x29, 0x7C,
+	0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x32, 0x5D, 0x34, 0x7C, 0x33, 0x36, 0x5C,
+	0x64, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x37, 0x5D, 0x7C, 0x35, 0x35, 0x29, 0x7C,
+	0x35, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x34, 0x31, 0x5C, 0x64, 0x7C, 0x35,
+	0x5B, 0x36, 0x37, 0x5D, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x36, 0x28, 0x3F, 0x3A,
+	0x30, 0x37, 0x5C, 0x64, 0x7C, 0x31, 0x33, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x5B,
+	0x30, 0x36, 0x5D, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x39, 0x29, 0x7C, 0x37, 0x38,
+	0x37, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x5D, 0x31, 0x7C, 0x5B,
+	0x34, 0x38, 0x5D, 0x38, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C,
+	0x5B, 0x31, 0x32, 0x5D, 0x30, 0x7C, 0x33, 0x33, 0x29, 0x29, 0x5C, 0x64, 0x32,
+	0x04, 0x31, 0x30, 0x36, 0x30, 0x48, 0x04, 0x48, 0x05, 0x4A, 0x02, 0x43, 0x4C,
+	0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x33, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C,
+	0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x33, 0x31, 0x48, 0x03, 0xEA, 0x01, 0xD0,
+	0x02, 0x12, 0xC8, 0x02, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x32, 0x31,
+	0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x31, 0x33, 0x7C, 0x5B,
+	0x33, 0x34, 0x38, 0x5D, 0x30, 0x7C, 0x35, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x7C,
+	0x34, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x32, 0x2D, 0x36, 0x5D, 0x7C, 0x31,
+	0x37, 0x7C, 0x5B, 0x33, 0x37, 0x39, 0x5D, 0x29, 0x7C, 0x38, 0x31, 0x38, 0x7C,
+	0x39, 0x31, 0x39, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A,
+	0x30, 0x31, 0x7C, 0x31, 0x32, 0x32, 0x29, 0x7C, 0x32, 0x32, 0x5B, 0x34, 0x37,
+	0x5D, 0x7C, 0x33, 0x32, 0x33, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x32,
+	0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x35, 0x31, 0x7C,
+	0x39, 0x39, 0x29, 0x7C, 0x31, 0x33, 0x32, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x32,
+	0x39, 0x7C, 0x5B, 0x33, 0x37, 0x5D, 0x37, 0x29, 0x7C, 0x36, 0x36, 0x35, 0x29,
+	0x7C, 0x34, 0x33, 0x36, 0x35, 0x36, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x28, 0x3F,
+	0x3A, 0x30, 0x30, 0x7C, 0x34, 0x31, 0x35, 0x29, 0x34, 0x7C, 0x35, 0x28, 0x3F,
+	0x3A, 0x36, 0x36, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x39, 0x39, 0x35, 0x29, 0x7C,
+	0x36, 0x28, 0x3F, 0x3A, 0x31, 0x33, 0x31, 0x7C, 0x32, 0x32, 0x32, 0x7C, 0x33,
+	0x36, 0x36, 0x7C, 0x36, 0x39, 0x39, 0x29, 0x7C, 0x37, 0x38, 0x37, 0x38, 0x7C,
+	0x38, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x31, 0x7C, 0x31, 0x31, 0x5B, 0x32, 0x38,
+	0x5D, 0x7C, 0x34, 0x38, 0x32, 0x7C, 0x38, 0x38, 0x39, 0x29, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x31, 0x29, 0x31, 0x7C, 0x31, 0x33, 0x5C, 0x64,
+	0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x33, 0x5D, 0x34, 0x32, 0x7C, 0x32,
+	0x34, 0x33, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x31, 0x35, 0x7C,
+	0x37, 0x37, 0x29, 0x7C, 0x35, 0x35, 0x34, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x35, 0x5D, 0x36, 0x7C, 0x39, 0x38, 0x29, 0x7C,
+	0x33, 0x33, 0x39, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x37, 0x7C, 0x5B, 0x33,
+	0x35, 0x5D, 0x29, 0x30, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x32, 0x5D,
+	0x30, 0x7C, 0x33, 0x33, 0x29, 0x29, 0x30, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x17, 0x12, 0x0D, 0x28, 0x3F, 0x3A, 0x32, 0x30, 0x30, 0x7C, 0x33, 0x33,
+	0x33, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x32, 0x30, 0x30, 0x30, 0x48, 0x04, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x9A, 0x01, 0x0A, 0x13, 0x12, 0x0B, 0x5B, 0x31, 0x38, 0x5D, 0x5C, 0x64,
+	0x7B, 0x31, 0x2C, 0x33, 0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x22, 0x19,
+	0x12, 0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x33, 0x37, 0x5D, 0x7C, 0x5B,
+	0x33, 0x37, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x33, 0x48, 0x02, 0x48, 0x03, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x43, 0x4D, 0xDA, 0x01, 0x19, 0x12, 0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x33, 0x37, 0x5D, 0x7C, 0x5B, 0x33, 0x37, 0x5D, 0x29, 0x32, 0x02, 0x31,
+	0x33, 0x48, 0x02, 0x48, 0x03, 0xEA, 0x01, 0x1A, 0x12, 0x14, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x33, 0x37, 0x5D, 0x7C, 0x5B, 0x33, 0x37, 0x5D, 0x29, 0x7C,
+	0x38, 0x37, 0x31, 0x31, 0x32, 0x02, 0x31, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12,
+	0x05, 0x38, 0x37, 0x31, 0x5C, 0x64, 0x32, 0x04, 0x38, 0x37, 0x31, 0x30, 0x48,
+	0x04, 0x0A, 0x8F, 0x02, 0x0A, 0x15, 0x12, 0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48,
+	0x06, 0x22, 0x29, 0x12, 0x1E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x39,
+	0x5D, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x31, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x33, 0x39, 0x35, 0x29, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x30, 0x48, 0x03, 0x48, 0x05, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x4E, 0xDA, 0x01, 0x16, 0x12,
+	0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x39, 0x5D, 0x7C, 0x32, 0x30,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x52, 0x12, 0x4B,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x34, 0x39,
+	0x5D, 0x7C, 0x32, 0x33, 0x39, 0x35, 0x7C, 0x36, 0x5B, 0x30, 0x38, 0x5D, 0x29,
+	0x7C, 0x39, 0x5B, 0x35, 0x36, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x2C, 0x34, 0x7D,
+	0x7C, 0x31, 0x32, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x32, 0x36, 0x5D, 0x5C, 0x64, 0x7C,
+	0x38, 0x29, 0x7C, 0x32, 0x31, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xF2, 0x01, 0x3D, 0x12, 0x36, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x32, 0x36, 0x5D, 0x5C, 0x64, 0x7C, 0x38, 0x29,
+	0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x32, 0x34, 0x5D, 0x7C, 0x32, 0x33, 0x7C, 0x36,
+	0x5B, 0x30, 0x38, 0x5D, 0x29, 0x7C, 0x39, 0x5B, 0x35, 0x36, 0x5D, 0x5C, 0x64,
+	0x7B, 0x33, 0x2C, 0x34, 0x7D, 0x7C, 0x31, 0x30, 0x30, 0x32, 0x03, 0x31, 0x30,
+	0x30, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x9B, 0x02, 0x0A, 0x1F, 0x12, 0x17, 0x5B, 0x31, 0x2D, 0x35,
+	0x38, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x1C, 0x12, 0x13, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x7C,
+	0x32, 0x33, 0x7C, 0x33, 0x32, 0x7C, 0x35, 0x36, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x4F, 0xDA, 0x01, 0x1C, 0x12, 0x13, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x32, 0x33, 0x7C, 0x33,
+	0x32, 0x7C, 0x35, 0x36, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x7F, 0x12, 0x78, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x31, 0x5B,
+	0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x33, 0x35, 0x2D, 0x37, 0x5D, 0x7C,
+	0x33, 0x5B, 0x32, 0x37, 0x5D, 0x7C, 0x34, 0x5B, 0x34, 0x36, 0x37, 0x5D, 0x7C,
+	0x35, 0x5B, 0x33, 0x36, 0x5D, 0x7C, 0x36, 0x5B, 0x34, 0x2D, 0x37, 0x5D, 0x7C,
+	0x39, 0x35, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x39, 0x30, 0x30, 0x32, 0x7C,
+	0x33, 0x39, 0x30, 0x30, 0x33, 0x29, 0x39, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34,
+	0x7C, 0x35, 0x39, 0x33, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x38, 0x35, 0x34,
+	0x33, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33, 0x35, 0x39, 0x5D, 0x5B,
+	0x35, 0x37, 0x5D, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x37, 0x7C, 0x39, 0x5C, 0x64,
+	0x29, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x30, 0x36, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x19, 0x12, 0x0E, 0x28, 0x3F, 0x3A, 0x34, 0x30, 0x7C, 0x38, 0x35,
+	0x29, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30,
+	0x48, 0x05, 0x0A, 0xBA, 0x02, 0x0A, 0x13, 0x12, 0x0D, 0x5B, 0x31, 0x33, 0x35,
+	0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x22, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x52, 0xDA, 0x01, 0x10, 0x12,
+	0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0xCF, 0x01, 0x12, 0xC7, 0x01, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x35, 0x7C, 0x32, 0x5B, 0x32,
+	0x2D, 0x34, 0x36, 0x37, 0x39, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x30, 0x2D, 0x33, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x7C, 0x33, 0x37,
+	0x7C, 0x5B, 0x34, 0x36, 0x5D, 0x36, 0x7C, 0x37, 0x5B, 0x35, 0x37, 0x5D, 0x7C,
+	0x38, 0x5B, 0x37, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x33, 0x37, 0x39,
+	0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x32,
+	0x5D, 0x32, 0x7C, 0x33, 0x34, 0x7C, 0x35, 0x35, 0x29, 0x7C, 0x33, 0x28, 0x3F,
+	0x3A, 0x32, 0x31, 0x7C, 0x33, 0x33, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x5B, 0x34, 0x2D, 0x36, 0x5D, 0x29, 0x7C,
+	0x35, 0x28, 0x3F, 0x3A, 0x31, 0x35, 0x7C, 0x35, 0x5B, 0x31, 0x35, 0x5D, 0x29,
+	0x7C, 0x36, 0x39, 0x33, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31,
+	0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x5B,
+	0x36, 0x37, 0x5D, 0x37, 0x29, 0x7C, 0x39, 0x37, 0x35, 0x29, 0x7C, 0x33, 0x38,
+	0x35, 0x35, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x33, 0x30,
+	0x7C, 0x34, 0x39, 0x29, 0x7C, 0x35, 0x31, 0x30, 0x29, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xAD, 0x01, 0x0A, 0x1C, 0x12,
+	0x14, 0x5B, 0x31, 0x32, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x33, 0x2C, 0x34, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x06, 0x48,
+	0x07, 0x22, 0x21, 0x12, 0x1A, 0x31, 0x30, 0x5B, 0x34, 0x2D, 0x37, 0x5D, 0x7C,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x36, 0x7C, 0x32, 0x30, 0x34, 0x5C, 0x64, 0x29,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x30, 0x34, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43,
+	0x55, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x30, 0x5B, 0x34, 0x2D, 0x36, 0x5D,
+	0x32, 0x03, 0x31, 0x30, 0x34, 0x48, 0x03, 0xEA, 0x01, 0x29, 0x12, 0x22, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x34, 0x2D, 0x37, 0x5D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x36, 0x31, 0x31, 0x31, 0x7C, 0x38, 0x29, 0x7C, 0x34, 0x30, 0x29, 0x7C,
+	0x32, 0x30, 0x34, 0x35, 0x32, 0x35, 0x32, 0x32, 0x03, 0x31, 0x30, 0x34, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x33, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x32,
+	0x03, 0x31, 0x33, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x56, 0xDA, 0x01, 0x0E, 0x12, 0x07,
+	0x31, 0x33, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x32, 0x03, 0x31, 0x33, 0x30, 0xEA,
+	0x01, 0x0E, 0x12, 0x07, 0x31, 0x33, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x32, 0x03,
+	0x31, 0x33, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x73, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31,
+	0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31,
+	0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x43, 0x57, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37, 0x36, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x37, 0x36,
+	0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0x6D, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x30,
+	0x31, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x30,
+	0x30, 0x30, 0x7C, 0x31, 0x31, 0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x43, 0x58, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31,
+	0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x30, 0x30,
+	0x30, 0x7C, 0x31, 0x31, 0x32, 0x32, 0x03, 0x30, 0x30, 0x30, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0x9D, 0x01, 0x0A, 0x15, 0x12, 0x0F, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x06, 0x22,
+	0x1C, 0x12, 0x15, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x43, 0x59, 0xDA, 0x01, 0x13, 0x12, 0x0A, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x22, 0x12, 0x1B, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C,
+	0x31, 0x31, 0x31, 0x29, 0x29, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0xCB, 0x01, 0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06,
+	0x22, 0x31, 0x12, 0x26, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x29, 0x7C, 0x35,
+	0x5C, 0x64, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x43, 0x5A, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x32, 0x7C, 0x35, 0x5C, 0x64, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0xEA, 0x01, 0x3D, 0x12, 0x36, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x38, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x33,
+	0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x35, 0x5C,
+	0x64, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x7C,
+	0x34, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0xC8, 0x02, 0x0A, 0x19, 0x12, 0x0F, 0x5B, 0x31, 0x2D, 0x34, 0x36,
+	0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x1C, 0x12, 0x11, 0x31, 0x31, 0x28, 0x3F,
+	0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x44, 0x45,
+	0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x8D, 0x01, 0x12, 0x85, 0x01, 0x31,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x35, 0x5D, 0x7C, 0x36, 0x28, 0x3F,
+	0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x31, 0x36, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38, 0x30,
+	0x30, 0x5C, 0x64, 0x29, 0x7C, 0x32, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x34, 0x34,
+	0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x34, 0x33, 0x7C, 0x38, 0x30, 0x29, 0x7C, 0x37,
+	0x37, 0x30, 0x30, 0x7C, 0x39, 0x32, 0x32, 0x29, 0x7C, 0x33, 0x33, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x33, 0x5B, 0x33, 0x34, 0x5D, 0x29, 0x7C, 0x34, 0x34,
+	0x38, 0x34, 0x34, 0x7C, 0x36, 0x30, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x37,
+	0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x34, 0x36, 0x34,
+	0x29, 0x7C, 0x38, 0x30, 0x38, 0x30, 0x38, 0x7C, 0x31, 0x31, 0x38, 0x5C, 0x64,
+	0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x4A, 0x12, 0x3C,
+	0x28, 0x3F, 0x3A, 0x33, 0x33, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x37, 0x34, 0x36,
+	0x29, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x32, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x35, 0x39, 0x5D, 0x7C, 0x37, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F, 0x3A,
+	0x34, 0x34, 0x7C, 0x38, 0x30, 0x29, 0x38, 0x7C, 0x36, 0x30, 0x30, 0x7C, 0x37,
+	0x30, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x33, 0x33, 0x31,
+	0x30, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x0A, 0x5F, 0x0A, 0x07, 0x12, 0x03,
+	0x31, 0x5C, 0x64, 0x48, 0x02, 0x22, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38,
+	0x5D, 0x32, 0x02, 0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x44, 0x4A, 0xDA, 0x01, 0x0B, 0x12,
+	0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xEA, 0x01, 0x0B,
+	0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0xC5, 0x01, 0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x1C, 0x12,
+	0x11, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x34, 0x5D, 0x7C, 0x36, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48,
+	0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x44, 0x4B, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B,
+	0x32, 0x34, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x51,
+	0x12, 0x4A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x34,
+	0x38, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D,
+	0x7C, 0x31, 0x31, 0x31, 0x29, 0x29, 0x7C, 0x36, 0x31, 0x39, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x31, 0x5B, 0x30,
+	0x32, 0x33, 0x38, 0x5D, 0x7C, 0x32, 0x38, 0x7C, 0x33, 0x30, 0x7C, 0x35, 0x5B,
+	0x31, 0x33, 0x5D, 0x7C, 0x38, 0x5B, 0x31, 0x38, 0x5D, 0x29, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x88, 0x01, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x33, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x18, 0x12, 0x11,
+	0x33, 0x33, 0x33, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38,
+	0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x33, 0x33, 0x33, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x44, 0x4D,
+	0xDA, 0x01, 0x15, 0x12, 0x0E, 0x33, 0x33, 0x33, 0x7C, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x33, 0x33, 0x33, 0xEA, 0x01,
+	0x18, 0x12, 0x11, 0x33, 0x33, 0x33, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31,
+	0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x33, 0x33, 0x33, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x7B, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x15, 0x12, 0x0E, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x44, 0x4F, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x32,
+	0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x15, 0x12,
+	0x0E, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38,
+	0x38, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x96, 0x01, 0x0A,
+	0x13, 0x12, 0x0B, 0x5B, 0x31, 0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x33,
+	0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x22, 0x17, 0x12, 0x11, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x34, 0x37, 0x5D, 0x7C, 0x35, 0x34, 0x5C,
+	0x64, 0x29, 0x32, 0x02, 0x31, 0x34, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x44, 0x5A, 0xDA, 0x01, 0x16,
+	0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x34, 0x37, 0x5D,
+	0x29, 0x32, 0x02, 0x31, 0x34, 0x48, 0x02, 0x48, 0x03, 0xEA, 0x01, 0x1E, 0x12,
+	0x18, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x35, 0x35, 0x7C, 0x31, 0x32, 0x7C, 0x5B,
+	0x34, 0x37, 0x5D, 0x7C, 0x35, 0x34, 0x38, 0x29, 0x7C, 0x37, 0x33, 0x30, 0x32,
+	0x02, 0x31, 0x34, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0C, 0x12, 0x03, 0x37, 0x33, 0x30, 0x32,
+	0x03, 0x37, 0x33, 0x30, 0x48, 0x03, 0x0A, 0x8B, 0x01, 0x0A, 0x0C, 0x12, 0x08,
+	0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x18, 0x12,
+	0x11, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x31, 0x32,
+	0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x31, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x45,
+	0x43, 0xDA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x32, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31,
+	0x30, 0x31, 0xEA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x31, 0x32, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03,
+	0x31, 0x30, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xA3, 0x09, 0x0A, 0x12, 0x12, 0x08, 0x31,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05,
+	0x48, 0x06, 0x22, 0x97, 0x01, 0x12, 0x8F, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x35, 0x7C, 0x32, 0x38, 0x29,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x34, 0x7C, 0x33, 0x28, 0x3F, 0x3A,
+	0x32, 0x31, 0x7C, 0x35, 0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x36, 0x36, 0x30, 0x29,
+	0x7C, 0x34, 0x39, 0x32, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x33,
+	0x5D, 0x7C, 0x34, 0x31, 0x30, 0x7C, 0x35, 0x30, 0x31, 0x29, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x32, 0x7C, 0x33, 0x33, 0x33, 0x7C, 0x36, 0x34, 0x34,
+	0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x32, 0x7C, 0x31, 0x32, 0x37,
+	0x7C, 0x38, 0x39, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x7C, 0x38,
+	0x5B, 0x35, 0x37, 0x5D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x33, 0x34, 0x5D, 0x7C, 0x31, 0x34, 0x29, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30,
+	0x2A, 0xFC, 0x05, 0x12, 0xEE, 0x05, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x32, 0x34, 0x35, 0x38, 0x5D, 0x5C,
+	0x64, 0x3F, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x32, 0x2D, 0x34, 0x36, 0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x31,
+	0x5B, 0x30, 0x2D, 0x33, 0x36, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x2D, 0x34, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x36, 0x5B, 0x30, 0x36, 0x5D,
+	0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x5C, 0x64,
+	0x3F, 0x7C, 0x35, 0x5B, 0x32, 0x35, 0x5D, 0x29, 0x7C, 0x5B, 0x33, 0x36, 0x37,
+	0x5D, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x5B,
+	0x31, 0x32, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x34, 0x5B, 0x32, 0x34, 0x5D, 0x7C,
+	0x35, 0x34, 0x29, 0x7C, 0x35, 0x35, 0x5B, 0x31, 0x32, 0x34, 0x35, 0x37, 0x5D,
+	0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32,
+	0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x31, 0x5B, 0x31, 0x33, 0x35, 0x37, 0x38, 0x5D,
+	0x7C, 0x33, 0x5B, 0x33, 0x35, 0x36, 0x5D, 0x29, 0x7C, 0x31, 0x5B, 0x31, 0x33,
+	0x34, 0x37, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x32, 0x2D, 0x35, 0x5D, 0x7C, 0x33,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x34, 0x37, 0x5D, 0x5C, 0x64, 0x3F,
+	0x7C, 0x32, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x7C, 0x38, 0x38, 0x29, 0x7C, 0x34,
+	0x28, 0x3F, 0x3A, 0x5B, 0x33, 0x35, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x34, 0x5B,
+	0x33, 0x34, 0x5D, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x31, 0x33,
+	0x34, 0x5D, 0x7C, 0x35, 0x5B, 0x30, 0x33, 0x35, 0x5D, 0x29, 0x7C, 0x36, 0x36,
+	0x36, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x30,
+	0x7C, 0x34, 0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x30, 0x31, 0x33, 0x35, 0x38, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x34, 0x5D,
+	0x7C, 0x35, 0x30, 0x7C, 0x37, 0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x39, 0x30, 0x30,
+	0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x33, 0x35, 0x5D,
+	0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x32, 0x36, 0x37, 0x5D, 0x5C, 0x64,
+	0x3F, 0x7C, 0x35, 0x5B, 0x30, 0x2D, 0x37, 0x5D, 0x7C, 0x38, 0x32, 0x29, 0x7C,
+	0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x34, 0x2D, 0x36, 0x5D, 0x5C, 0x64,
+	0x3F, 0x7C, 0x32, 0x32, 0x29, 0x7C, 0x33, 0x33, 0x30, 0x7C, 0x34, 0x28, 0x3F,
+	0x3A, 0x5B, 0x33, 0x35, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x34, 0x34, 0x29, 0x7C,
+	0x35, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x2D, 0x36, 0x39, 0x5D,
+	0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x35, 0x39,
+	0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x5B, 0x33, 0x38, 0x5D, 0x30, 0x7C, 0x37, 0x37,
+	0x29, 0x29, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30,
+	0x7C, 0x31, 0x5B, 0x31, 0x39, 0x5D, 0x7C, 0x5B, 0x33, 0x35, 0x2D, 0x39, 0x5D,
+	0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x32, 0x36,
+	0x5D, 0x7C, 0x5B, 0x36, 0x38, 0x5D, 0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x33, 0x28,
+	0x3F, 0x3A, 0x32, 0x32, 0x7C, 0x33, 0x36, 0x7C, 0x36, 0x5B, 0x33, 0x36, 0x5D,
+	0x29, 0x7C, 0x35, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x33, 0x35,
+	0x39, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x36, 0x5B, 0x30, 0x2D, 0x32, 0x36, 0x5D,
+	0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x35, 0x35, 0x7C, 0x37,
+	0x5C, 0x64, 0x3F, 0x7C, 0x38, 0x5B, 0x38, 0x39, 0x5D, 0x29, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5C, 0x64, 0x3F, 0x7C, 0x36, 0x39, 0x29,
+	0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32,
+	0x33, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x31, 0x5B, 0x30, 0x35, 0x37, 0x38, 0x5D,
+	0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x32, 0x5B, 0x30, 0x33,
+	0x34, 0x5D, 0x7C, 0x5B, 0x34, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x3F, 0x29, 0x7C,
+	0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x37, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x32,
+	0x30, 0x7C, 0x34, 0x34, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D,
+	0x35, 0x37, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x39, 0x5B, 0x37, 0x39, 0x5D, 0x29,
+	0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x38, 0x5D, 0x7C, 0x32, 0x5C,
+	0x64, 0x3F, 0x7C, 0x38, 0x5B, 0x30, 0x31, 0x37, 0x38, 0x5D, 0x29, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x39, 0x37, 0x29, 0x29, 0x7C, 0x38, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x32, 0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x31, 0x32,
+	0x36, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x32, 0x36, 0x39, 0x5D, 0x29, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x5C, 0x64,
+	0x3F, 0x7C, 0x36, 0x39, 0x7C, 0x39, 0x5B, 0x30, 0x32, 0x36, 0x39, 0x5D, 0x29,
+	0x7C, 0x31, 0x5B, 0x31, 0x2D, 0x33, 0x36, 0x38, 0x39, 0x5D, 0x7C, 0x32, 0x31,
+	0x29, 0x29, 0x32, 0x03, 0x31, 0x32, 0x33, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05,
+	0x4A, 0x02, 0x45, 0x45, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x30,
+	0x32, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0xC0, 0x01,
+	0x12, 0xB8, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30,
+	0x32, 0x2D, 0x35, 0x37, 0x39, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30,
+	0x30, 0x7C, 0x31, 0x31, 0x31, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x5B, 0x30,
+	0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x5B, 0x31, 0x2D, 0x38, 0x5D, 0x29, 0x29, 0x7C,
+	0x32, 0x5B, 0x33, 0x36, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x37, 0x2D, 0x39,
+	0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x35, 0x2D, 0x37, 0x5D, 0x7C, 0x35, 0x5B, 0x36,
+	0x2D, 0x38, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x35, 0x5D, 0x7C, 0x37, 0x5B, 0x33,
+	0x2D, 0x36, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x32, 0x2D, 0x37, 0x5D, 0x7C, 0x39,
+	0x5B, 0x33, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x5B,
+	0x30, 0x2D, 0x32, 0x34, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x2D, 0x36, 0x5D,
+	0x7C, 0x34, 0x5B, 0x31, 0x2D, 0x34, 0x38, 0x39, 0x5D, 0x7C, 0x35, 0x5B, 0x30,
+	0x2D, 0x35, 0x39, 0x5D, 0x7C, 0x36, 0x5B, 0x31, 0x2D, 0x34, 0x36, 0x2D, 0x39,
+	0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x2D, 0x32, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x38,
+	0x5B, 0x31, 0x38, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x29,
+	0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xA7, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64, 0x5C,
+	0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03,
+	0x48, 0x05, 0x22, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x32,
+	0x33, 0x5D, 0x7C, 0x38, 0x30, 0x29, 0x32, 0x03, 0x31, 0x32, 0x32, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x45, 0x47, 0xDA, 0x01, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x38, 0x30, 0x29, 0x32, 0x03, 0x31, 0x32,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x25, 0x12, 0x1E, 0x31, 0x28, 0x3F, 0x3A, 0x32,
+	0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x5B, 0x36, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x33, 0x7D, 0x7C, 0x38, 0x30, 0x29, 0x7C, 0x33, 0x34, 0x34, 0x30, 0x30, 0x32,
+	0x03, 0x31, 0x32, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x33, 0x34, 0x34,
+	0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x33, 0x34, 0x34, 0x30, 0x30, 0x48, 0x05,
+	0x0A, 0x79, 0x0A, 0x0C, 0x12, 0x06, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x48,
+	0x02, 0x48, 0x03, 0x22, 0x12, 0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x35,
+	0x39, 0x5D, 0x7C, 0x37, 0x37, 0x29, 0x32, 0x02, 0x31, 0x35, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x45,
+	0x48, 0xDA, 0x01, 0x12, 0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x35, 0x39,
+	0x5D, 0x7C, 0x37, 0x37, 0x29, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x12, 0x12,
+	0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x37, 0x37, 0x29,
+	0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF6, 0x01, 0x0A, 0x18, 0x12, 0x12,
+	0x5B, 0x31, 0x32, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x06, 0x22, 0x26, 0x12, 0x1F,
+	0x31, 0x31, 0x5B, 0x32, 0x2D, 0x34, 0x36, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x31,
+	0x32, 0x5B, 0x34, 0x37, 0x5D, 0x7C, 0x32, 0x30, 0x5B, 0x31, 0x32, 0x5D, 0x29,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x45,
+	0x52, 0xDA, 0x01, 0x41, 0x12, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32,
+	0x2D, 0x34, 0x36, 0x5D, 0x7C, 0x32, 0x34, 0x34, 0x32, 0x32, 0x29, 0x7C, 0x32,
+	0x30, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x36, 0x30, 0x36, 0x7C, 0x39,
+	0x31, 0x37, 0x29, 0x7C, 0x32, 0x39, 0x31, 0x34, 0x29, 0x7C, 0x28, 0x3F, 0x3A,
+	0x31, 0x32, 0x37, 0x37, 0x7C, 0x32, 0x30, 0x32, 0x30, 0x29, 0x39, 0x39, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x40, 0x12, 0x39, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x5B, 0x32, 0x2D, 0x36, 0x5D, 0x7C, 0x32, 0x34, 0x34, 0x32, 0x32, 0x29,
+	0x7C, 0x32, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x36, 0x30, 0x36,
+	0x7C, 0x39, 0x31, 0x37, 0x29, 0x7C, 0x32, 0x39, 0x31, 0x34, 0x29, 0x7C, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x37, 0x37, 0x7C, 0x32, 0x30, 0x32, 0x30, 0x29, 0x39,
+	0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8B, 0x04, 0x0A, 0x18,
+	0x12, 0x0E, 0x5B, 0x30, 0x2D, 0x33, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32,
+	0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x4B,
+	0x12, 0x3E, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x7C, 0x32, 0x30, 0x7C, 0x36,
+	0x5B, 0x35, 0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x35, 0x38, 0x5D, 0x29, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x36, 0x7C, 0x31, 0x32, 0x7C, 0x5B, 0x33, 0x2D,
+	0x37, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31,
+	0x36, 0x7C, 0x32, 0x30, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32,
+	0x03, 0x30, 0x31, 0x36, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x2A, 0x53, 0x12,
+	0x4C, 0x5B, 0x31, 0x32, 0x5D, 0x32, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x34, 0x7D,
+	0x7C, 0x39, 0x30, 0x28, 0x3F, 0x3A, 0x35, 0x5C, 0x64, 0x7C, 0x37, 0x29, 0x7C,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x33,
+	0x35, 0x37, 0x5D, 0x5C, 0x64, 0x7C, 0x38, 0x30, 0x29, 0x7C, 0x33, 0x5B, 0x33,
+	0x35, 0x37, 0x5D, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x5B, 0x37,
+	0x39, 0x5D, 0x39, 0x5B, 0x35, 0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32,
+	0x03, 0x31, 0x32, 0x30, 0x4A, 0x02, 0x45, 0x53, 0xDA, 0x01, 0x13, 0x12, 0x0A,
+	0x30, 0x38, 0x5B, 0x35, 0x38, 0x5D, 0x7C, 0x31, 0x31, 0x32, 0x32, 0x03, 0x30,
+	0x38, 0x35, 0x48, 0x03, 0xEA, 0x01, 0xD4, 0x01, 0x12, 0xCC, 0x01, 0x30, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x36, 0x37, 0x5D, 0x7C, 0x32, 0x5B,
+	0x30, 0x31, 0x34, 0x38, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x37,
+	0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x35, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x32,
+	0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x33, 0x2D, 0x35,
+	0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x33, 0x7D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31,
+	0x31, 0x29, 0x7C, 0x38, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x32, 0x5C, 0x64,
+	0x7B, 0x31, 0x2C, 0x34, 0x7D, 0x7C, 0x5B, 0x33, 0x2D, 0x39, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x5C, 0x64, 0x7B, 0x31,
+	0x2C, 0x34, 0x7D, 0x7C, 0x38, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x39,
+	0x30, 0x28, 0x3F, 0x3A, 0x35, 0x5B, 0x31, 0x32, 0x34, 0x35, 0x37, 0x38, 0x5D,
+	0x7C, 0x37, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x33, 0x34, 0x5D,
+	0x7C, 0x37, 0x37, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x30, 0x31, 0x5D,
+	0x5C, 0x64, 0x7C, 0x5B, 0x37, 0x39, 0x5D, 0x39, 0x5B, 0x35, 0x37, 0x5D, 0x29,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x5B, 0x32, 0x33, 0x5D, 0x5B, 0x33, 0x35,
+	0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x30, 0x31, 0x30, 0xF2,
+	0x01, 0x36, 0x12, 0x2B, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x2D, 0x32,
+	0x37, 0x5D, 0x7C, 0x32, 0x5B, 0x31, 0x34, 0x38, 0x5D, 0x7C, 0x36, 0x5B, 0x30,
+	0x2D, 0x32, 0x5D, 0x7C, 0x38, 0x30, 0x7C, 0x39, 0x5B, 0x31, 0x32, 0x5D, 0x29,
+	0x7C, 0x32, 0x31, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x32, 0x03, 0x30, 0x31, 0x30,
+	0x48, 0x03, 0x48, 0x06, 0xFA, 0x01, 0x21, 0x12, 0x1A, 0x31, 0x28, 0x3F, 0x3A,
+	0x33, 0x5B, 0x33, 0x34, 0x5D, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x5B, 0x31, 0x32,
+	0x5D, 0x32, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x34, 0x7D, 0x32, 0x03, 0x31, 0x32,
+	0x30, 0x0A, 0x9A, 0x01, 0x0A, 0x0C, 0x12, 0x06, 0x39, 0x5C, 0x64, 0x5C, 0x64,
+	0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x1D, 0x12, 0x17, 0x39, 0x28, 0x3F, 0x3A,
+	0x30, 0x37, 0x7C, 0x31, 0x31, 0x3F, 0x7C, 0x32, 0x7C, 0x33, 0x39, 0x3F, 0x7C,
+	0x39, 0x5B, 0x31, 0x37, 0x5D, 0x29, 0x32, 0x02, 0x39, 0x31, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x45,
+	0x54, 0xDA, 0x01, 0x1A, 0x12, 0x14, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x3F,
+	0x7C, 0x32, 0x7C, 0x33, 0x39, 0x3F, 0x7C, 0x39, 0x5B, 0x31, 0x37, 0x5D, 0x29,
+	0x32, 0x02, 0x39, 0x31, 0xEA, 0x01, 0x20, 0x12, 0x1A, 0x39, 0x28, 0x3F, 0x3A,
+	0x30, 0x37, 0x7C, 0x31, 0x31, 0x3F, 0x7C, 0x32, 0x7C, 0x33, 0x39, 0x3F, 0x7C,
+	0x34, 0x35, 0x7C, 0x39, 0x5B, 0x31, 0x37, 0x5D, 0x29, 0x32, 0x02, 0x39, 0x31,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xC3, 0x01, 0x0A, 0x1C, 0x12, 0x14, 0x5B, 0x31, 0x37, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33,
+	0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x48, 0x06, 0x22, 0x19, 0x12, 0x0E,
+	0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x46,
+	0x49, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x40, 0x12, 0x39, 0x31, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29,
+	0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x2D, 0x38, 0x5D, 0x5C,
+	0x64, 0x7C, 0x37, 0x35, 0x5B, 0x31, 0x32, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x16, 0x12, 0x0B, 0x31, 0x5B, 0x32,
+	0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x31, 0x32, 0x30,
+	0x30, 0x30, 0x48, 0x05, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xC1, 0x01, 0x0A, 0x22, 0x12, 0x1A, 0x5B,
+	0x30, 0x2D, 0x35, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48,
+	0x02, 0x48, 0x03, 0x48, 0x05, 0x22, 0x0F, 0x12, 0x06, 0x39, 0x31, 0x5B, 0x31,
+	0x37, 0x5D, 0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x46, 0x4A,
+	0xDA, 0x01, 0x0F, 0x12, 0x06, 0x39, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x32, 0x03,
+	0x39, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x4A, 0x12, 0x44, 0x30, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x33, 0x34, 0x5D, 0x7C, 0x38, 0x5B, 0x31, 0x2D, 0x34, 0x5D,
+	0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C,
+	0x5B, 0x32, 0x35, 0x5D, 0x39, 0x29, 0x7C, 0x32, 0x5B, 0x32, 0x38, 0x39, 0x5D,
+	0x7C, 0x33, 0x30, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x7C, 0x39, 0x31, 0x5B,
+	0x31, 0x33, 0x37, 0x5D, 0x7C, 0x5B, 0x34, 0x35, 0x5D, 0x34, 0x7C, 0x37, 0x35,
+	0x32, 0x02, 0x32, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x67, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03,
+	0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x46, 0x4B, 0xDA,
+	0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xEA,
+	0x01, 0x10, 0x12, 0x09, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x39, 0x39,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8A, 0x01, 0x0A, 0x18, 0x12,
+	0x12, 0x5B, 0x33, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x06, 0x22, 0x13, 0x12,
+	0x0C, 0x33, 0x32, 0x30, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x46, 0x4D, 0xDA, 0x01, 0x14, 0x12,
+	0x0D, 0x28, 0x3F, 0x3A, 0x33, 0x32, 0x30, 0x32, 0x32, 0x7C, 0x39, 0x31, 0x29,
+	0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x28, 0x3F,
+	0x3A, 0x33, 0x32, 0x30, 0x32, 0x32, 0x7C, 0x39, 0x31, 0x29, 0x31, 0x32, 0x03,
+	0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x98, 0x01, 0x0A, 0x0E, 0x12, 0x08, 0x31,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x16,
+	0x12, 0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x34, 0x5D, 0x7C, 0x38,
+	0x31, 0x5C, 0x64, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x46, 0x4F,
+	0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x32, 0x34, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x2E, 0x12, 0x27, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x32, 0x34, 0x38, 0x5D, 0x7C, 0x38, 0x31, 0x39, 0x29, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x34, 0x5B, 0x31, 0x32, 0x34, 0x5D, 0x7C, 0x37, 0x31,
+	0x7C, 0x38, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xB2, 0x03, 0x0A, 0x18, 0x12, 0x0C, 0x5B, 0x31,
+	0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x35, 0x7D, 0x48, 0x02, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x45, 0x12, 0x3F, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x34, 0x35, 0x39, 0x5D, 0x7C, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x36, 0x37, 0x5D, 0x29, 0x7C, 0x32,
+	0x32, 0x34, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x33, 0x37, 0x30, 0x7C, 0x37, 0x34,
+	0x29, 0x30, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x36, 0x5C, 0x64, 0x7C, 0x33,
+	0x5B, 0x30, 0x31, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x35,
+	0x2A, 0x3A, 0x12, 0x2C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x7C,
+	0x31, 0x38, 0x5C, 0x64, 0x29, 0x7C, 0x33, 0x36, 0x36, 0x7C, 0x5B, 0x34, 0x2D,
+	0x38, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x33,
+	0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x30,
+	0x30, 0x30, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x4A, 0x02, 0x46, 0x52, 0xDA,
+	0x01, 0x17, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x03, 0xEA,
+	0x01, 0x82, 0x01, 0x12, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x5C,
+	0x64, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x34, 0x35, 0x39, 0x5D,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29,
+	0x7C, 0x38, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x5B, 0x35, 0x37, 0x38,
+	0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x36, 0x37, 0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F,
+	0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x32, 0x29, 0x30, 0x7C, 0x32,
+	0x34, 0x29, 0x7C, 0x5B, 0x33, 0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x34, 0x7D,
+	0x7C, 0x33, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x33, 0x34, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x32, 0x32, 0x7C, 0x34, 0x30, 0x29, 0x32,
+	0x02, 0x31, 0x35, 0xF2, 0x01, 0x25, 0x12, 0x1A, 0x32, 0x30, 0x32, 0x5C, 0x64,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x33, 0x34,
+	0x29, 0x7C, 0x37, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x32, 0x03, 0x36, 0x31, 0x31,
+	0x48, 0x03, 0x48, 0x04, 0xFA, 0x01, 0x46, 0x12, 0x37, 0x31, 0x31, 0x38, 0x37,
+	0x37, 0x37, 0x7C, 0x32, 0x32, 0x34, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x31, 0x34, 0x5D, 0x7C, 0x33, 0x34, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x32, 0x32, 0x7C, 0x34, 0x30, 0x29, 0x7C, 0x32,
+	0x30, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C, 0x32, 0x29, 0x5C, 0x64, 0x32,
+	0x03, 0x32, 0x32, 0x34, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x0A,
+	0x8C, 0x01, 0x0A, 0x13, 0x12, 0x0D, 0x31, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x04, 0x22, 0x16, 0x12,
+	0x10, 0x31, 0x38, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x33, 0x5C, 0x64, 0x7C, 0x37,
+	0x33, 0x29, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x38, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x41, 0xDA,
+	0x01, 0x16, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x33, 0x5C, 0x64, 0x5C, 0x64,
+	0x7C, 0x37, 0x33, 0x30, 0x7C, 0x38, 0x29, 0x32, 0x02, 0x31, 0x38, 0xEA, 0x01,
+	0x16, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x33, 0x5C, 0x64, 0x5C, 0x64, 0x7C,
+	0x37, 0x33, 0x30, 0x7C, 0x38, 0x29, 0x32, 0x02, 0x31, 0x38, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xE5, 0x03, 0x0A, 0x19, 0x12, 0x0F, 0x5B, 0x31, 0x2D, 0x34, 0x36, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x05, 0x48, 0x06, 0x22, 0x42, 0x12, 0x3B, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x35,
+	0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x36, 0x5C, 0x64,
+	0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x37, 0x5B, 0x35, 0x36, 0x5D, 0x5C, 0x64, 0x7C,
+	0x38, 0x30, 0x30, 0x30, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x30, 0x5C,
+	0x64, 0x7C, 0x34, 0x38, 0x29, 0x7C, 0x34, 0x34, 0x34, 0x34, 0x7C, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x31, 0x30, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x42, 0xDA, 0x01, 0x10,
+	0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0x81, 0x02, 0x12, 0xF9, 0x01, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x31, 0x32, 0x39, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x7C, 0x35, 0x30, 0x29, 0x7C, 0x33, 0x33, 0x7C, 0x34, 0x28,
+	0x3F, 0x3A, 0x31, 0x7C, 0x37, 0x5C, 0x64, 0x29, 0x7C, 0x35, 0x37, 0x31, 0x7C,
+	0x37, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C, 0x5B, 0x35, 0x36, 0x5D, 0x30,
+	0x29, 0x7C, 0x38, 0x30, 0x30, 0x5C, 0x64, 0x7C, 0x39, 0x5B, 0x31, 0x35, 0x5D,
+	0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x30, 0x32, 0x7C, 0x31, 0x33,
+	0x30, 0x30, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x31, 0x31, 0x29,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x33, 0x33, 0x36, 0x7C, 0x34,
+	0x35, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x32, 0x35, 0x7C, 0x38, 0x29, 0x29,
+	0x7C, 0x33, 0x5B, 0x31, 0x33, 0x5D, 0x33, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x33, 0x35, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x34,
+	0x34, 0x5B, 0x34, 0x35, 0x5D, 0x7C, 0x35, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F,
+	0x3A, 0x5B, 0x36, 0x38, 0x5D, 0x5C, 0x64, 0x7C, 0x37, 0x5B, 0x30, 0x38, 0x39,
+	0x5D, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x31, 0x35, 0x5C, 0x64, 0x7C,
+	0x32, 0x5B, 0x30, 0x32, 0x5D, 0x32, 0x7C, 0x36, 0x35, 0x30, 0x7C, 0x37, 0x38,
+	0x39, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32,
+	0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x4C, 0x12, 0x3F, 0x31, 0x28, 0x3F,
+	0x3A, 0x28, 0x3F, 0x3A, 0x32, 0x35, 0x7C, 0x37, 0x5B, 0x35, 0x36, 0x5D, 0x29,
+	0x5C, 0x64, 0x7C, 0x35, 0x37, 0x31, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30,
+	0x32, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x7C, 0x5B,
+	0x31, 0x33, 0x5D, 0x33, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x34, 0x38, 0x29, 0x7C,
+	0x34, 0x34, 0x34, 0x34, 0x7C, 0x39, 0x30, 0x31, 0x32, 0x03, 0x32, 0x30, 0x32,
+	0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x0A, 0x72, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12, 0x0A,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39,
+	0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x47, 0x44, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x37,
+	0x36, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32,
+	0x03, 0x31, 0x37, 0x36, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x37, 0x36,
+	0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0xC1, 0x01, 0x0A, 0x19, 0x12, 0x13, 0x5B,
+	0x30, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x22, 0x12, 0x19,
+	0x30, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33, 0x33, 0x29, 0x7C, 0x31, 0x31,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x5B, 0x30, 0x31, 0x5D, 0x32, 0x32, 0x32,
+	0x03, 0x30, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x45, 0xDA, 0x01, 0x22,
+	0x12, 0x19, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33, 0x33, 0x29, 0x7C,
+	0x31, 0x31, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x5B, 0x30, 0x31, 0x5D, 0x32,
+	0x32, 0x32, 0x03, 0x30, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x26, 0x12, 0x1F,
+	0x30, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33, 0x33, 0x29, 0x7C, 0x31, 0x31,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x7C, 0x5B,
+	0x30, 0x31, 0x5D, 0x32, 0x32, 0x32, 0x03, 0x30, 0x31, 0x31, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34,
+	0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xDA, 0x01, 0x0A, 0x21, 0x12, 0x19,
+	0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48,
+	0x02, 0x48, 0x04, 0x48, 0x06, 0x22, 0x24, 0x12, 0x1A, 0x31, 0x5B, 0x35, 0x37,
+	0x38, 0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C, 0x31, 0x5B,
+	0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x35,
+	0x48, 0x02, 0x48, 0x04, 0x2A, 0x15, 0x12, 0x0B, 0x33, 0x5B, 0x32, 0x34, 0x36,
+	0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x33, 0x32, 0x30, 0x30, 0x48,
+	0x04, 0x4A, 0x02, 0x47, 0x46, 0xDA, 0x01, 0x0E, 0x12, 0x06, 0x31, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0xEA, 0x01, 0x46, 0x12,
+	0x40, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x33, 0x30, 0x30, 0x5B, 0x30,
+	0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x5B, 0x30,
+	0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x31, 0x2D, 0x39, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D,
+	0x7C, 0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x29, 0x29, 0x5C, 0x64,
+	0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xAE, 0x01, 0x0A, 0x15, 0x12, 0x0B,
+	0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32,
+	0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x47, 0x47, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x42, 0x12, 0x3B,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x31, 0x5B, 0x31,
+	0x32, 0x5D, 0x7C, 0x32, 0x33, 0x7C, 0x34, 0x31, 0x7C, 0x35, 0x35, 0x7C, 0x39,
+	0x5B, 0x30, 0x35, 0x5D, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x36, 0x38, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x34, 0x37,
+	0x7C, 0x38, 0x30, 0x30, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0xAE, 0x01, 0x0A, 0x16, 0x12, 0x0E, 0x5B, 0x31, 0x34, 0x35, 0x38,
+	0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x48, 0x05, 0x22, 0x14, 0x12, 0x0B, 0x31, 0x39, 0x5B, 0x31, 0x2D, 0x33, 0x5D,
+	0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x31, 0x48, 0x03, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x47, 0x48, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x39, 0x5B, 0x31, 0x2D, 0x33,
+	0x5D, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x31, 0x48, 0x03, 0xEA,
+	0x01, 0x24, 0x12, 0x1D, 0x31, 0x39, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x34,
+	0x30, 0x34, 0x30, 0x34, 0x7C, 0x28, 0x3F, 0x3A, 0x35, 0x34, 0x7C, 0x38, 0x33,
+	0x29, 0x30, 0x30, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x31, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x20, 0x12, 0x14, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x7C,
+	0x28, 0x3F, 0x3A, 0x35, 0x34, 0x7C, 0x38, 0x33, 0x29, 0x30, 0x5C, 0x64, 0x32,
+	0x04, 0x35, 0x34, 0x30, 0x30, 0x48, 0x04, 0x48, 0x05, 0x0A, 0x82, 0x03, 0x0A,
+	0x16, 0x12, 0x0C, 0x5B, 0x31, 0x35, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x4C, 0x12,
+	0x3F, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5B, 0x32, 0x35, 0x5D,
+	0x7C, 0x32, 0x33, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x37, 0x5C, 0x64,
+	0x29, 0x7C, 0x35, 0x5B, 0x31, 0x35, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x32, 0x2D,
+	0x34, 0x39, 0x5D, 0x29, 0x7C, 0x35, 0x35, 0x35, 0x7C, 0x28, 0x3F, 0x3A, 0x31,
+	0x31, 0x36, 0x5C, 0x64, 0x7C, 0x38, 0x30, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32,
+	0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x2A, 0x15, 0x12,
+	0x0B, 0x38, 0x5B, 0x31, 0x2D, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32,
+	0x04, 0x38, 0x31, 0x30, 0x30, 0x48, 0x04, 0x4A, 0x02, 0x47, 0x49, 0xDA, 0x01,
+	0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x30,
+	0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x81,
+	0x01, 0x12, 0x7A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x5B, 0x32, 0x35, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B,
+	0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D,
+	0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C,
+	0x32, 0x33, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x37, 0x5B, 0x30, 0x31,
+	0x34, 0x5D, 0x29, 0x7C, 0x35, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C, 0x39, 0x5B,
+	0x30, 0x32, 0x2D, 0x34, 0x39, 0x5D, 0x29, 0x7C, 0x35, 0x35, 0x35, 0x7C, 0x38,
+	0x5B, 0x30, 0x2D, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x38, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x34, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x38,
+	0x5B, 0x30, 0x2D, 0x35, 0x38, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30,
+	0xF2, 0x01, 0x15, 0x12, 0x0A, 0x31, 0x35, 0x30, 0x7C, 0x38, 0x37, 0x5C, 0x64,
+	0x5C, 0x64, 0x32, 0x03, 0x31, 0x35, 0x30, 0x48, 0x03, 0x48, 0x04, 0xFA, 0x01,
+	0x48, 0x12, 0x3D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x35, 0x7C, 0x38, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x32, 0x33, 0x7C,
+	0x35, 0x31, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29, 0x7C, 0x35, 0x35,
+	0x35, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x34, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x35, 0x38, 0x39, 0x5D, 0x29, 0x32,
+	0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x05, 0x0A, 0x63, 0x0A, 0x09, 0x12,
+	0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x31,
+	0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x4C, 0xDA, 0x01,
+	0x0A, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01,
+	0x0F, 0x12, 0x08, 0x31, 0x5B, 0x31, 0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x32, 0x03,
+	0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x88, 0x01, 0x0A, 0x0C, 0x12, 0x06, 0x31,
+	0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x17, 0x12, 0x11,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x36, 0x2D, 0x38, 0x5D, 0x7C, 0x5B, 0x36,
+	0x2D, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x36, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x4D, 0xDA,
+	0x01, 0x17, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x36, 0x2D, 0x38,
+	0x5D, 0x7C, 0x5B, 0x36, 0x2D, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x36, 0xEA,
+	0x01, 0x17, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x36, 0x2D, 0x38,
+	0x5D, 0x7C, 0x5B, 0x36, 0x2D, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x36, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x7D, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48,
+	0x03, 0x48, 0x05, 0x22, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x4E, 0xDA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xEA, 0x01, 0x11, 0x12, 0x0A,
+	0x31, 0x32, 0x5C, 0x64, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31,
+	0x32, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64,
+	0x5C, 0x64, 0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xD2,
+	0x01, 0x0A, 0x21, 0x12, 0x19, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D,
+	0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x04, 0x48, 0x06, 0x22, 0x23, 0x12,
+	0x19, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x5C, 0x64,
+	0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x04, 0x2A, 0x15, 0x12, 0x0B, 0x33,
+	0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x33,
+	0x32, 0x30, 0x30, 0x48, 0x04, 0x4A, 0x02, 0x47, 0x50, 0xDA, 0x01, 0x0E, 0x12,
+	0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02,
+	0xEA, 0x01, 0x3F, 0x12, 0x39, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x33,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x31,
+	0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x38, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B,
+	0x32, 0x34, 0x36, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02, 0x31,
+	0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0xDC, 0x01, 0x0A, 0x19, 0x12, 0x11, 0x31, 0x5C, 0x64,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x05, 0x48, 0x06, 0x22, 0x2A, 0x12, 0x1F, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x36, 0x36, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x06, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x47, 0x52, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x7C, 0x31, 0x32, 0x7C, 0x36, 0x36, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03,
+	0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x42, 0x12, 0x3B, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32,
+	0x7C, 0x33, 0x32, 0x30, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29,
+	0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x38, 0x39, 0x7C, 0x39, 0x29, 0x39,
+	0x7C, 0x36, 0x36, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x12, 0x12,
+	0x07, 0x31, 0x31, 0x33, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x31, 0x31, 0x33,
+	0x30, 0x30, 0x48, 0x05, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x9D, 0x01, 0x0A, 0x13, 0x12, 0x0B, 0x5B,
+	0x31, 0x34, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x22, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x30,
+	0x7C, 0x32, 0x5B, 0x30, 0x33, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48,
+	0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x47, 0x54, 0xDA, 0x01, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x30, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x5D, 0x29, 0x32, 0x03, 0x31,
+	0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x20, 0x12, 0x19, 0x31, 0x31, 0x30, 0x7C,
+	0x34, 0x30, 0x34, 0x30, 0x34, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x5B,
+	0x35, 0x37, 0x5D, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x30,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x6C, 0x0A, 0x09,
+	0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12, 0x0A,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39,
+	0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x47, 0x55, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x33, 0x37, 0x38, 0x5D,
+	0x32, 0x03, 0x31, 0x31, 0x33, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x57, 0xDA, 0x01, 0x0E, 0x12,
+	0x07, 0x31, 0x31, 0x5B, 0x33, 0x37, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x33,
+	0xEA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x33, 0x37, 0x38, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xC1, 0x01, 0x0A, 0x12, 0x12, 0x0C,
+	0x5B, 0x30, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x22, 0x10, 0x12, 0x07, 0x39, 0x31, 0x5B, 0x31, 0x2D, 0x34,
+	0x5D, 0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x47, 0x59, 0xDA,
+	0x01, 0x10, 0x12, 0x07, 0x39, 0x31, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x32, 0x03,
+	0x39, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x54, 0x12, 0x4D, 0x30, 0x28, 0x3F,
+	0x3A, 0x30, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x37, 0x7C, 0x38, 0x30, 0x29,
+	0x31, 0x7C, 0x34, 0x34, 0x34, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x5B, 0x36, 0x37,
+	0x5D, 0x37, 0x7C, 0x39, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x37,
+	0x38, 0x5D, 0x7C, 0x5B, 0x32, 0x2D, 0x34, 0x37, 0x5D, 0x29, 0x29, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x34, 0x34, 0x33, 0x7C, 0x35, 0x5B, 0x35, 0x36, 0x38, 0x5D,
+	0x29, 0x7C, 0x39, 0x31, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x32, 0x03, 0x30, 0x30,
+	0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x05, 0x31, 0x34, 0x34, 0x5C, 0x64, 0x32,
+	0x04, 0x31, 0x34, 0x34, 0x30, 0x48, 0x04, 0x0A, 0xAB, 0x03, 0x0A, 0x17, 0x12,
+	0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x36, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x22, 0x13, 0x12, 0x0A,
+	0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x5B, 0x32, 0x39, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x48, 0x4B, 0xDA, 0x01, 0x13, 0x12, 0x0A,
+	0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x5B, 0x32, 0x39, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0xA2, 0x02, 0x12, 0x9A, 0x02, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33,
+	0x36, 0x5D, 0x5C, 0x64, 0x7C, 0x32, 0x5B, 0x31, 0x34, 0x5D, 0x29, 0x5C, 0x64,
+	0x7B, 0x30, 0x2C, 0x33, 0x7D, 0x7C, 0x38, 0x5B, 0x31, 0x33, 0x38, 0x5D, 0x29,
+	0x7C, 0x31, 0x32, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x33, 0x5D,
+	0x5C, 0x64, 0x7B, 0x30, 0x2C, 0x34, 0x7D, 0x7C, 0x28, 0x3F, 0x3A, 0x35, 0x38,
+	0x7C, 0x38, 0x5B, 0x31, 0x33, 0x5D, 0x29, 0x5C, 0x64, 0x7B, 0x30, 0x2C, 0x33,
+	0x7D, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x33, 0x35, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x30, 0x2C, 0x34, 0x7D, 0x7C, 0x32, 0x31, 0x39, 0x5C,
+	0x64, 0x7B, 0x30, 0x2C, 0x32, 0x7D, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x36, 0x30,
+	0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7C, 0x38, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x5C, 0x64, 0x7C, 0x5B, 0x32, 0x2D, 0x38, 0x5D, 0x29, 0x7C, 0x32, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x31,
+	0x38, 0x7C, 0x32, 0x29, 0x32, 0x7C, 0x33, 0x7C, 0x38, 0x5B, 0x31, 0x32, 0x38,
+	0x5D, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x30, 0x2D,
+	0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x32, 0x5B,
+	0x31, 0x2D, 0x33, 0x38, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x32, 0x33, 0x35,
+	0x2D, 0x39, 0x5D, 0x7C, 0x39, 0x33, 0x29, 0x29, 0x5C, 0x64, 0x7C, 0x38, 0x29,
+	0x5C, 0x64, 0x7C, 0x35, 0x30, 0x5B, 0x31, 0x33, 0x38, 0x5D, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x36, 0x29, 0x7C,
+	0x38, 0x29, 0x29, 0x29, 0x7C, 0x39, 0x39, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x31,
+	0x30, 0x5B, 0x30, 0x31, 0x33, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x1F, 0x12, 0x12, 0x31, 0x30, 0x39, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x38, 0x7C, 0x38, 0x35, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31,
+	0x30, 0x39, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x0A, 0x7E, 0x0A, 0x18, 0x12,
+	0x12, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x0C, 0x12,
+	0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39, 0x48, 0x03, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x48, 0x4E, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31,
+	0x39, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x10, 0x12, 0x09, 0x31, 0x39, 0x39, 0x7C,
+	0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x39, 0x39, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34,
+	0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xA4, 0x02, 0x0A, 0x1A, 0x12, 0x0E,
+	0x5B, 0x30, 0x31, 0x36, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x35,
+	0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x33,
+	0x12, 0x25, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D,
+	0x34, 0x5D, 0x29, 0x7C, 0x39, 0x5B, 0x33, 0x34, 0x5D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x36, 0x5C, 0x64, 0x7C, 0x33, 0x39, 0x29, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x02, 0x39, 0x33, 0x48, 0x02, 0x48, 0x03, 0x48, 0x05, 0x48, 0x06, 0x2A,
+	0x28, 0x12, 0x1B, 0x30, 0x36, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31,
+	0x38, 0x7C, 0x5B, 0x36, 0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29,
+	0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x30, 0x36, 0x30, 0x48, 0x03, 0x48, 0x05,
+	0x48, 0x06, 0x4A, 0x02, 0x48, 0x52, 0xDA, 0x01, 0x1E, 0x12, 0x14, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29, 0x7C,
+	0x39, 0x5B, 0x33, 0x34, 0x5D, 0x32, 0x02, 0x39, 0x33, 0x48, 0x02, 0x48, 0x03,
+	0xEA, 0x01, 0x5E, 0x12, 0x58, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x5B, 0x36,
+	0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x29, 0x5C, 0x64, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38, 0x5C, 0x64, 0x5C,
+	0x64, 0x29, 0x7C, 0x33, 0x39, 0x37, 0x37, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x5B,
+	0x32, 0x2D, 0x35, 0x5D, 0x7C, 0x38, 0x37, 0x29, 0x29, 0x7C, 0x39, 0x5B, 0x33,
+	0x34, 0x5D, 0x32, 0x02, 0x39, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x31,
+	0x33, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x31, 0x33, 0x39, 0x30, 0x30,
+	0x48, 0x05, 0x0A, 0x87, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x34, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x34,
+	0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x34, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x48, 0x54,
+	0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x34, 0x38, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x34, 0x48, 0x03, 0xEA, 0x01, 0x13, 0x12, 0x0C, 0x31, 0x31, 0x5B,
+	0x34, 0x38, 0x5D, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x32, 0x03, 0x31, 0x31,
+	0x34, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xA8, 0x03,
+	0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x32, 0x12, 0x2B, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x34, 0x35, 0x37, 0x5D, 0x7C, 0x31, 0x32, 0x7C, 0x34,
+	0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x5C, 0x64, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x36, 0x5C, 0x64, 0x7C, 0x33, 0x37, 0x7C, 0x34, 0x35, 0x29, 0x5C, 0x64,
+	0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x34, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x48, 0x55, 0xDA, 0x01,
+	0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x34, 0x35, 0x37, 0x5D,
+	0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x34, 0x48, 0x03, 0xEA, 0x01,
+	0xF3, 0x01, 0x12, 0xEB, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x34, 0x35,
+	0x37, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33,
+	0x29, 0x29, 0x7C, 0x38, 0x30, 0x30, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x31, 0x33, 0x34, 0x38,
+	0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x30,
+	0x2D, 0x34, 0x36, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x2D, 0x36,
+	0x38, 0x5D, 0x7C, 0x35, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x36, 0x38, 0x5D, 0x7C,
+	0x36, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x2D,
+	0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x32, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x30,
+	0x38, 0x5D, 0x29, 0x7C, 0x33, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x33,
+	0x37, 0x7C, 0x37, 0x5B, 0x30, 0x37, 0x5D, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x31, 0x33, 0x2D,
+	0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x34, 0x5D, 0x7C, 0x33, 0x5B, 0x32,
+	0x33, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x35, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x34, 0x31, 0x7C, 0x36, 0x37, 0x29, 0x29, 0x7C,
+	0x37, 0x37, 0x37, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x37, 0x2D,
+	0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x34, 0x30, 0x7C, 0x5B,
+	0x35, 0x38, 0x39, 0x5D, 0x29, 0x29, 0x32, 0x03, 0x31, 0x30, 0x34, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x2D, 0x12, 0x21, 0x31, 0x28, 0x3F, 0x3A, 0x34, 0x5B, 0x30, 0x2D, 0x34,
+	0x5D, 0x7C, 0x37, 0x37, 0x29, 0x5C, 0x64, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x38, 0x7C, 0x32, 0x7C, 0x34, 0x35, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04,
+	0x31, 0x32, 0x30, 0x30, 0x48, 0x04, 0x48, 0x05, 0x0A, 0xB4, 0x01, 0x0A, 0x1D,
+	0x12, 0x15, 0x5B, 0x31, 0x37, 0x38, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48,
+	0x05, 0x48, 0x06, 0x22, 0x12, 0x12, 0x09, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33,
+	0x38, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x49,
+	0x44, 0xDA, 0x01, 0x12, 0x12, 0x09, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x38,
+	0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x2D, 0x12,
+	0x26, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x38, 0x39, 0x5D,
+	0x7C, 0x34, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x35, 0x30, 0x32, 0x36, 0x34,
+	0x29, 0x7C, 0x37, 0x31, 0x34, 0x30, 0x30, 0x7C, 0x38, 0x39, 0x38, 0x38, 0x37,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x1A, 0x12, 0x0F, 0x28, 0x3F,
+	0x3A, 0x37, 0x31, 0x34, 0x7C, 0x38, 0x39, 0x38, 0x29, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x05, 0x37, 0x31, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xD8, 0x01, 0x0A,
+	0x16, 0x12, 0x0C, 0x5B, 0x31, 0x35, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x1D, 0x12,
+	0x12, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0x48, 0x06, 0x2A, 0x15, 0x12, 0x0A, 0x35, 0x5B, 0x33, 0x37, 0x5D, 0x5C, 0x64,
+	0x7B, 0x33, 0x7D, 0x32, 0x05, 0x35, 0x33, 0x30, 0x30, 0x30, 0x48, 0x05, 0x4A,
+	0x02, 0x49, 0x45, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x48, 0x12,
+	0x41, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31,
+	0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x7C,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x7C, 0x39, 0x29, 0x7C,
+	0x35, 0x5B, 0x30, 0x31, 0x33, 0x37, 0x5D, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x12, 0x12, 0x07, 0x35, 0x31,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x35, 0x31, 0x30, 0x30, 0x30, 0x48,
+	0x05, 0xFA, 0x01, 0x10, 0x12, 0x05, 0x35, 0x31, 0x32, 0x31, 0x30, 0x32, 0x05,
+	0x35, 0x31, 0x32, 0x31, 0x30, 0x48, 0x05, 0x0A, 0xBE, 0x01, 0x0A, 0x13, 0x12,
+	0x0B, 0x5B, 0x31, 0x32, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x49, 0x4C, 0xDA, 0x01, 0x17, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x31, 0x32,
+	0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x3F, 0x12, 0x38,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x31, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x32,
+	0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C,
+	0x32, 0x34, 0x30, 0x37, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x34, 0x7C, 0x32,
+	0x37, 0x29, 0x30, 0x30, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12,
+	0x12, 0x07, 0x31, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x31, 0x30,
+	0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x95, 0x01, 0x0A, 0x1D, 0x12, 0x15, 0x5B,
+	0x31, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x48, 0x06,
+	0x22, 0x0C, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x48,
+	0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x49, 0x4D, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x39, 0x39, 0x39,
+	0x32, 0x03, 0x39, 0x39, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x29, 0x12, 0x22, 0x31,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29,
+	0x3F, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x36, 0x34, 0x34, 0x34, 0x7C, 0x39, 0x38,
+	0x38, 0x37, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0xC5, 0x0A, 0x0A, 0x1E, 0x12, 0x0E, 0x5B, 0x31, 0x32, 0x35, 0x37,
+	0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x38, 0x7D, 0x48, 0x03, 0x48, 0x04,
+	0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x48, 0x08, 0x48, 0x09, 0x22, 0xAC, 0x01,
+	0x12, 0x9C, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x34,
+	0x38, 0x5D, 0x7C, 0x31, 0x5B, 0x32, 0x38, 0x39, 0x5D, 0x7C, 0x32, 0x31, 0x7C,
+	0x5B, 0x33, 0x39, 0x5D, 0x5B, 0x38, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x31,
+	0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x36, 0x5C, 0x64, 0x3F, 0x29,
+	0x7C, 0x38, 0x5B, 0x31, 0x32, 0x5D, 0x29, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x38,
+	0x30, 0x30, 0x7C, 0x31, 0x5B, 0x30, 0x35, 0x5D, 0x35, 0x5C, 0x64, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x37, 0x7C, 0x35, 0x31, 0x7C, 0x39, 0x34, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x35, 0x5D, 0x35, 0x5C, 0x64, 0x7C, 0x37, 0x30, 0x29, 0x5C, 0x64, 0x7C,
+	0x32, 0x36, 0x31, 0x29, 0x5C, 0x64, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x33, 0x36, 0x39, 0x5D, 0x7C, 0x31, 0x30, 0x7C, 0x32, 0x39, 0x7C, 0x33, 0x5B,
+	0x31, 0x32, 0x36, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x36, 0x5D,
+	0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x05, 0x48, 0x06, 0x2A, 0x23, 0x12, 0x14, 0x31, 0x31, 0x5B, 0x36, 0x37, 0x5D,
+	0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x7C, 0x35, 0x36, 0x31, 0x36, 0x31, 0x35, 0x36,
+	0x31, 0x32, 0x07, 0x31, 0x31, 0x36, 0x30, 0x30, 0x30, 0x30, 0x48, 0x07, 0x48,
+	0x08, 0x4A, 0x02, 0x49, 0x4E, 0xDA, 0x01, 0x23, 0x12, 0x18, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x38, 0x5D, 0x7C, 0x31, 0x32, 0x7C, 0x32,
+	0x39, 0x38, 0x29, 0x7C, 0x32, 0x36, 0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x30,
+	0x48, 0x03, 0x48, 0x04, 0xEA, 0x01, 0x86, 0x07, 0x12, 0xFE, 0x06, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x38, 0x5D,
+	0x7C, 0x33, 0x5B, 0x33, 0x39, 0x5D, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x31,
+	0x30, 0x7C, 0x36, 0x29, 0x7C, 0x36, 0x5B, 0x33, 0x34, 0x36, 0x38, 0x5D, 0x7C,
+	0x37, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x35, 0x37, 0x5D, 0x7C, 0x5B,
+	0x32, 0x38, 0x5D, 0x30, 0x3F, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x7C,
+	0x39, 0x5B, 0x30, 0x31, 0x33, 0x35, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x32, 0x38, 0x39, 0x5D, 0x29, 0x7C, 0x32,
+	0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x39, 0x38, 0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x36, 0x33, 0x7C,
+	0x5B, 0x38, 0x39, 0x5D, 0x29, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x35,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x33, 0x36,
+	0x5D, 0x7C, 0x5B, 0x31, 0x32, 0x37, 0x5D, 0x29, 0x7C, 0x35, 0x34, 0x29, 0x7C,
+	0x36, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x36, 0x5B, 0x30, 0x31, 0x5D, 0x3F, 0x29,
+	0x7C, 0x37, 0x30, 0x30, 0x30, 0x7C, 0x38, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x35, 0x39, 0x5D, 0x7C,
+	0x31, 0x32, 0x7C, 0x32, 0x35, 0x7C, 0x34, 0x5B, 0x34, 0x2D, 0x39, 0x5D, 0x5C,
+	0x64, 0x3F, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x5B, 0x31, 0x33, 0x34, 0x37, 0x5D,
+	0x7C, 0x5B, 0x38, 0x39, 0x5D, 0x29, 0x29, 0x7C, 0x32, 0x36, 0x31, 0x31, 0x7C,
+	0x35, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30,
+	0x5C, 0x64, 0x7C, 0x31, 0x7C, 0x32, 0x30, 0x3F, 0x29, 0x7C, 0x33, 0x32, 0x35,
+	0x7C, 0x35, 0x5B, 0x32, 0x2D, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x2C,
+	0x35, 0x7D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x33, 0x34, 0x7C, 0x35,
+	0x35, 0x35, 0x7C, 0x37, 0x31, 0x37, 0x7C, 0x38, 0x31, 0x38, 0x7C, 0x39, 0x36,
+	0x5B, 0x34, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x5B, 0x31, 0x34, 0x5D, 0x30, 0x29,
+	0x7C, 0x31, 0x35, 0x31, 0x7C, 0x35, 0x35, 0x35, 0x7C, 0x36, 0x36, 0x36, 0x7C,
+	0x38, 0x38, 0x38, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x39, 0x39,
+	0x5C, 0x64, 0x3F, 0x29, 0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30,
+	0x31, 0x5D, 0x30, 0x7C, 0x31, 0x33, 0x31, 0x7C, 0x35, 0x35, 0x33, 0x7C, 0x28,
+	0x3F, 0x3A, 0x36, 0x36, 0x7C, 0x37, 0x37, 0x29, 0x36, 0x29, 0x7C, 0x28, 0x3F,
+	0x3A, 0x34, 0x36, 0x34, 0x7C, 0x35, 0x35, 0x5B, 0x30, 0x35, 0x5D, 0x29, 0x5C,
+	0x64, 0x7B, 0x31, 0x2C, 0x33, 0x7D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x37,
+	0x30, 0x7C, 0x33, 0x5B, 0x36, 0x38, 0x5D, 0x7C, 0x34, 0x33, 0x29, 0x7C, 0x37,
+	0x31, 0x37, 0x5C, 0x64, 0x29, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x38, 0x30, 0x30,
+	0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31,
+	0x5C, 0x64, 0x29, 0x7C, 0x32, 0x32, 0x31, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30,
+	0x33, 0x7C, 0x33, 0x5B, 0x32, 0x33, 0x5D, 0x29, 0x29, 0x5C, 0x64, 0x7B, 0x31,
+	0x2C, 0x34, 0x7D, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x30, 0x34,
+	0x7C, 0x38, 0x38, 0x29, 0x30, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x30,
+	0x32, 0x36, 0x37, 0x5D, 0x7C, 0x33, 0x5B, 0x31, 0x36, 0x5D, 0x29, 0x7C, 0x34,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x32, 0x30, 0x7C, 0x33,
+	0x5B, 0x30, 0x32, 0x5D, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x31,
+	0x36, 0x5D, 0x7C, 0x36, 0x37, 0x29, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x36,
+	0x7C, 0x5B, 0x36, 0x37, 0x5D, 0x5C, 0x64, 0x29, 0x7C, 0x37, 0x38, 0x37, 0x7C,
+	0x39, 0x28, 0x3F, 0x3A, 0x36, 0x34, 0x7C, 0x39, 0x30, 0x29, 0x29, 0x5C, 0x64,
+	0x5C, 0x64, 0x3F, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x35,
+	0x5B, 0x37, 0x39, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x36, 0x37, 0x5D,
+	0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x38, 0x30, 0x32, 0x29, 0x5C, 0x64, 0x7C,
+	0x35, 0x35, 0x5B, 0x32, 0x33, 0x5D, 0x29, 0x5C, 0x64, 0x7C, 0x35, 0x28, 0x3F,
+	0x3A, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C,
+	0x31, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x30, 0x34, 0x7C, 0x36, 0x31, 0x36,
+	0x29, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x34, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29,
+	0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x33, 0x35, 0x38, 0x39, 0x5D, 0x7C,
+	0x33, 0x28, 0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x32, 0x29,
+	0x7C, 0x34, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x37, 0x5B, 0x37, 0x38, 0x5D, 0x29,
+	0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x34, 0x7C, 0x33, 0x32,
+	0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x7C, 0x34, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x39,
+	0x39, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x35, 0x5D, 0x7C,
+	0x5B, 0x33, 0x36, 0x5D, 0x35, 0x7C, 0x34, 0x5B, 0x34, 0x35, 0x5D, 0x7C, 0x39,
+	0x33, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x31, 0x37, 0x5C,
+	0x64, 0x7C, 0x35, 0x37, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x5B, 0x32, 0x37,
+	0x5D, 0x37, 0x7C, 0x38, 0x38, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x33, 0x5B,
+	0x34, 0x2D, 0x36, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x35,
+	0x5B, 0x35, 0x38, 0x5D, 0x7C, 0x38, 0x38, 0x28, 0x3F, 0x3A, 0x38, 0x5C, 0x64,
+	0x5C, 0x64, 0x7C, 0x39, 0x29, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x39, 0x28, 0x3F,
+	0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x32, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x7C, 0x35, 0x35, 0x7C, 0x36, 0x5B, 0x36, 0x37, 0x5D, 0x7C, 0x37, 0x37,
+	0x7C, 0x38, 0x38, 0x29, 0x29, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30,
+	0xF2, 0x01, 0x2C, 0x12, 0x1F, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x34, 0x28, 0x3F,
+	0x3A, 0x32, 0x5B, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x5B, 0x33, 0x34, 0x5D, 0x5C,
+	0x64, 0x29, 0x7C, 0x37, 0x35, 0x37, 0x35, 0x35, 0x35, 0x29, 0x32, 0x05, 0x35,
+	0x31, 0x34, 0x32, 0x35, 0x48, 0x05, 0x48, 0x07, 0xFA, 0x01, 0x6B, 0x12, 0x58,
+	0x31, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x36, 0x37, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x37, 0x30, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x35,
+	0x35, 0x33, 0x33, 0x30, 0x7C, 0x39, 0x30, 0x39, 0x29, 0x7C, 0x35, 0x28, 0x3F,
+	0x3A, 0x33, 0x30, 0x30, 0x5C, 0x64, 0x7C, 0x36, 0x31, 0x36, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x37, 0x5B, 0x38, 0x39, 0x5D, 0x7C, 0x35, 0x36, 0x31, 0x29, 0x29,
+	0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x39, 0x5D, 0x5B, 0x38, 0x39, 0x5D,
+	0x7C, 0x32, 0x31, 0x7C, 0x34, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x32, 0x03, 0x31,
+	0x31, 0x38, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x48,
+	0x08, 0x0A, 0xB9, 0x01, 0x0A, 0x15, 0x12, 0x0D, 0x5B, 0x31, 0x34, 0x37, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x05, 0x22, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x34,
+	0x5D, 0x7C, 0x31, 0x35, 0x7C, 0x32, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30,
+	0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x49, 0x51, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x31, 0x35, 0x7C, 0x32, 0x32,
+	0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x27, 0x12, 0x20,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x31, 0x35, 0x7C,
+	0x32, 0x32, 0x29, 0x7C, 0x34, 0x34, 0x33, 0x32, 0x7C, 0x37, 0x31, 0x31, 0x31,
+	0x37, 0x7C, 0x39, 0x39, 0x38, 0x38, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x1F, 0x12, 0x13, 0x28, 0x3F, 0x3A, 0x34, 0x34, 0x33, 0x7C, 0x37, 0x31,
+	0x31, 0x5C, 0x64, 0x7C, 0x39, 0x39, 0x38, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x34,
+	0x34, 0x33, 0x30, 0x48, 0x04, 0x48, 0x05, 0x0A, 0xB5, 0x03, 0x0A, 0x16, 0x12,
+	0x0C, 0x5B, 0x31, 0x32, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D,
+	0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x2A, 0x12, 0x21, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x5D, 0x7C, 0x32, 0x5B,
+	0x30, 0x2D, 0x35, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x35, 0x37, 0x39,
+	0x5D, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x49, 0x52, 0xDA, 0x01, 0x1B, 0x12, 0x12, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x5B, 0x30, 0x32, 0x35, 0x5D, 0x7C, 0x32, 0x35, 0x29, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0xE0, 0x01, 0x12,
+	0xD8, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x5D,
+	0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x35, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x33, 0x34,
+	0x36, 0x2D, 0x38, 0x5D, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x34,
+	0x37, 0x5D, 0x7C, 0x5B, 0x32, 0x38, 0x39, 0x5D, 0x30, 0x29, 0x7C, 0x35, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x34,
+	0x37, 0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x33, 0x5D, 0x7C, 0x33, 0x39,
+	0x7C, 0x5B, 0x34, 0x39, 0x5D, 0x30, 0x7C, 0x36, 0x35, 0x29, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x5B, 0x31, 0x36, 0x5D, 0x36, 0x7C, 0x5B, 0x32, 0x37, 0x5D, 0x7C,
+	0x39, 0x30, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x33, 0x7C, 0x31, 0x5B,
+	0x31, 0x38, 0x5D, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x5B, 0x33, 0x37, 0x5D, 0x7C,
+	0x34, 0x5B, 0x32, 0x38, 0x5D, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x7C,
+	0x39, 0x5B, 0x30, 0x2D, 0x35, 0x37, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x30, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x39, 0x5D, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x33, 0x38, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x37, 0x39, 0x5D, 0x7C, 0x32,
+	0x36, 0x7C, 0x39, 0x5B, 0x36, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x5B, 0x30, 0x31,
+	0x5D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x28, 0x3F,
+	0x3A, 0x30, 0x30, 0x30, 0x39, 0x7C, 0x39, 0x30, 0x29, 0x29, 0x32, 0x03, 0x31,
+	0x31, 0x30, 0xF2, 0x01, 0x22, 0x12, 0x18, 0x31, 0x28, 0x3F, 0x3A, 0x35, 0x5B,
+	0x30, 0x2D, 0x34, 0x36, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x34, 0x38,
+	0x39, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x35, 0x30, 0x30, 0x48, 0x04,
+	0xFA, 0x01, 0x36, 0x12, 0x2A, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x35,
+	0x5B, 0x30, 0x2D, 0x34, 0x36, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x34,
+	0x38, 0x39, 0x5D, 0x29, 0x7C, 0x39, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64,
+	0x5C, 0x64, 0x7C, 0x39, 0x29, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x35, 0x30,
+	0x30, 0x48, 0x04, 0x48, 0x06, 0x0A, 0xEB, 0x01, 0x0A, 0x1E, 0x12, 0x16, 0x31,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x06, 0x22, 0x17, 0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37,
+	0x31, 0x5C, 0x64, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x04,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x49, 0x53, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x68, 0x12, 0x61, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x38, 0x5D, 0x7C, 0x36, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x36, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x34, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5B, 0x31, 0x34, 0x35, 0x5D, 0x7C, 0x34,
+	0x5B, 0x30, 0x31, 0x34, 0x36, 0x5D, 0x29, 0x7C, 0x35, 0x35, 0x7C, 0x37, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x37, 0x7C, 0x37, 0x5B, 0x30, 0x37, 0x2D,
+	0x39, 0x5D, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x30,
+	0x7C, 0x31, 0x5B, 0x31, 0x36, 0x2D, 0x39, 0x5D, 0x7C, 0x38, 0x38, 0x29, 0x7C,
+	0x39, 0x30, 0x30, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x16,
+	0x12, 0x0C, 0x31, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C, 0x34, 0x31,
+	0x29, 0x32, 0x04, 0x31, 0x34, 0x30, 0x30, 0x48, 0x04, 0x0A, 0x9C, 0x03, 0x0A,
+	0x17, 0x12, 0x0B, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x36,
+	0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07, 0x22, 0x25,
+	0x12, 0x1A, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33,
+	0x35, 0x38, 0x5D, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x38,
+	0x37, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x39,
+	0x12, 0x2B, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x5B,
+	0x34, 0x37, 0x38, 0x5D, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x7C,
+	0x5B, 0x35, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x35, 0x35,
+	0x29, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x32, 0x30, 0x30, 0x48,
+	0x04, 0x48, 0x05, 0x48, 0x07, 0x4A, 0x02, 0x49, 0x54, 0xDA, 0x01, 0x11, 0x12,
+	0x08, 0x31, 0x31, 0x5B, 0x32, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0xEA, 0x01, 0xE9, 0x01, 0x12, 0xE1, 0x01, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x5B, 0x32, 0x2D, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x36, 0x28, 0x3F,
+	0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29, 0x29, 0x7C, 0x33, 0x5B,
+	0x33, 0x39, 0x5D, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x38, 0x32, 0x7C, 0x39, 0x5C,
+	0x64, 0x7B, 0x31, 0x2C, 0x33, 0x7D, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x7C, 0x31, 0x5B, 0x35, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x32, 0x35, 0x5D,
+	0x7C, 0x33, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x34, 0x34, 0x7C, 0x5B, 0x35, 0x39,
+	0x5D, 0x29, 0x7C, 0x36, 0x30, 0x7C, 0x38, 0x5B, 0x36, 0x37, 0x5D, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x32, 0x5B, 0x32, 0x2D, 0x39,
+	0x5D, 0x7C, 0x34, 0x5C, 0x64, 0x7C, 0x36, 0x39, 0x36, 0x29, 0x29, 0x7C, 0x34,
+	0x28, 0x3F, 0x3A, 0x32, 0x33, 0x32, 0x33, 0x7C, 0x35, 0x30, 0x34, 0x35, 0x29,
+	0x7C, 0x31, 0x34, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x39, 0x32, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x7C, 0x34, 0x28, 0x3F,
+	0x3A, 0x33, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x5B, 0x34, 0x35,
+	0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x5B, 0x34, 0x37, 0x38, 0x5D, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x7C, 0x5B, 0x35, 0x2D, 0x39, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x29, 0x7C, 0x35, 0x35, 0x29, 0x29, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xDA, 0x01, 0x0A, 0x22,
+	0x12, 0x1A, 0x5B, 0x31, 0x32, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F,
+	0x29, 0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x22, 0x10, 0x12, 0x07, 0x31,
+	0x31, 0x32, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4A, 0x45, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C,
+	0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x61,
+	0x12, 0x5A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x38, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x32, 0x33, 0x7C,
+	0x34, 0x28, 0x3F, 0x3A, 0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x32, 0x38, 0x7C, 0x37,
+	0x5C, 0x64, 0x29, 0x7C, 0x35, 0x5C, 0x64, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x5B, 0x31, 0x32, 0x38, 0x5D, 0x7C, 0x33, 0x35,
+	0x3F, 0x29, 0x7C, 0x38, 0x30, 0x38, 0x7C, 0x39, 0x5B, 0x30, 0x31, 0x33, 0x35,
+	0x5D, 0x29, 0x7C, 0x32, 0x33, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x7C, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8D, 0x01, 0x0A, 0x0C,
+	0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x19, 0x12, 0x12, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x39, 0x5D, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4A, 0x4D, 0xDA, 0x01, 0x12, 0x12, 0x0B, 0x31, 0x31, 0x5B, 0x30,
+	0x32, 0x39, 0x5D, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x30, 0xEA,
+	0x01, 0x20, 0x12, 0x19, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x39,
+	0x5D, 0x7C, 0x37, 0x36, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x38, 0x38, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12,
+	0x03, 0x31, 0x37, 0x36, 0x32, 0x03, 0x31, 0x37, 0x36, 0x0A, 0xE5, 0x01, 0x0A,
+	0x18, 0x12, 0x12, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22,
+	0x26, 0x12, 0x1D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x32, 0x33, 0x35, 0x5D,
+	0x7C, 0x31, 0x5B, 0x32, 0x2D, 0x36, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x32, 0x37,
+	0x5D, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x32, 0x48, 0x03,
+	0x2A, 0x19, 0x12, 0x0E, 0x39, 0x5B, 0x30, 0x2D, 0x34, 0x36, 0x38, 0x39, 0x5D,
+	0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x39, 0x30, 0x30, 0x30, 0x30, 0x48,
+	0x05, 0x4A, 0x02, 0x4A, 0x4F, 0xDA, 0x01, 0x1B, 0x12, 0x12, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x31, 0x32, 0x37, 0x5D, 0x29, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x39, 0x12,
+	0x32, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x32, 0x33, 0x35, 0x39, 0x5D, 0x7C,
+	0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x32,
+	0x34, 0x2D, 0x37, 0x39, 0x5D, 0x29, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x34, 0x36,
+	0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x31, 0x30, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x19, 0x12, 0x0E, 0x39, 0x5B, 0x30,
+	0x2D, 0x34, 0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05,
+	0x39, 0x30, 0x30, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xA3, 0x01, 0x0A, 0x1E, 0x12,
+	0x14, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C,
+	0x64, 0x7B, 0x35, 0x2C, 0x37, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x08, 0x48,
+	0x09, 0x48, 0x0A, 0x22, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x38, 0x39,
+	0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4A, 0x50, 0xDA,
+	0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x30, 0x39, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x2F, 0x12, 0x28, 0x30, 0x30, 0x30, 0x5B,
+	0x32, 0x35, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x34, 0x2C, 0x36, 0x7D, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x32, 0x34, 0x5D, 0x7C, 0x31, 0x5B, 0x30,
+	0x38, 0x39, 0x5D, 0x7C, 0x34, 0x34, 0x7C, 0x38, 0x39, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x9B, 0x04, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31,
+	0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x22, 0x35, 0x12, 0x2E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28,
+	0x3F, 0x3A, 0x5B, 0x32, 0x34, 0x36, 0x5D, 0x7C, 0x39, 0x5C, 0x64, 0x29, 0x7C,
+	0x35, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x32, 0x5B, 0x31, 0x32, 0x37, 0x5D,
+	0x7C, 0x36, 0x5B, 0x32, 0x36, 0x5D, 0x5C, 0x64, 0x29, 0x29, 0x7C, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x12, 0x12, 0x07, 0x39, 0x30, 0x39,
+	0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x39, 0x30, 0x39, 0x30, 0x30, 0x48, 0x05,
+	0x4A, 0x02, 0x4B, 0x45, 0xDA, 0x01, 0x13, 0x12, 0x0A, 0x31, 0x31, 0x5B, 0x32,
+	0x34, 0x5D, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03,
+	0xEA, 0x01, 0x85, 0x02, 0x12, 0xFD, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x2D,
+	0x32, 0x35, 0x5D, 0x7C, 0x34, 0x30, 0x30, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x5B, 0x30, 0x32, 0x34, 0x2D, 0x36, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x35,
+	0x37, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x33,
+	0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x34, 0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x35, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x5D, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x32, 0x5B,
+	0x30, 0x2D, 0x32, 0x34, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x33, 0x33, 0x7C, 0x34,
+	0x5B, 0x30, 0x35, 0x5D, 0x7C, 0x35, 0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x32, 0x39, 0x7C, 0x36, 0x5B, 0x36, 0x37, 0x5D,
+	0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x36, 0x5B, 0x30, 0x33, 0x35, 0x5D, 0x5C,
+	0x64, 0x7C, 0x5B, 0x37, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x7C, 0x39, 0x28, 0x3F,
+	0x3A, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x31,
+	0x39, 0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x32, 0x5B, 0x30,
+	0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x5B, 0x33, 0x37, 0x5D, 0x5B, 0x30, 0x2D, 0x32,
+	0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x7C, 0x36, 0x5B, 0x32,
+	0x33, 0x35, 0x37, 0x5D, 0x7C, 0x38, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7C, 0x35,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x37, 0x5D, 0x5C, 0x64, 0x7C, 0x39, 0x39,
+	0x29, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x39,
+	0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x38, 0x39, 0x38, 0x38,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x85, 0x01, 0x12, 0x7E, 0x31,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x30, 0x34, 0x7C, 0x36, 0x5B, 0x33, 0x35,
+	0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x5D, 0x7C,
+	0x34, 0x5B, 0x31, 0x34, 0x5D, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x5C, 0x64,
+	0x7C, 0x32, 0x5B, 0x32, 0x35, 0x5D, 0x29, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x28,
+	0x3F, 0x3A, 0x32, 0x5B, 0x30, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x5B, 0x33, 0x37,
+	0x5D, 0x5B, 0x30, 0x2D, 0x32, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x2D, 0x34,
+	0x5D, 0x7C, 0x36, 0x5B, 0x32, 0x33, 0x35, 0x37, 0x5D, 0x7C, 0x38, 0x5C, 0x64,
+	0x29, 0x5C, 0x64, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x37, 0x5D,
+	0x5C, 0x64, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x39, 0x30, 0x39, 0x29, 0x5C, 0x64,
+	0x5C, 0x64, 0x7C, 0x38, 0x39, 0x38, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x33, 0x30,
+	0x0A, 0x7F, 0x0A, 0x11, 0x12, 0x0B, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x10, 0x12, 0x07, 0x31,
+	0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x31, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4B, 0x47, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x30, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x13,
+	0x12, 0x0C, 0x31, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x34, 0x30, 0x34,
+	0x30, 0x32, 0x03, 0x31, 0x30, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x05, 0x34,
+	0x30, 0x34, 0x5C, 0x64, 0x32, 0x04, 0x34, 0x30, 0x34, 0x30, 0x48, 0x04, 0x0A,
+	0x97, 0x01, 0x0A, 0x19, 0x12, 0x13, 0x5B, 0x31, 0x34, 0x36, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48,
+	0x03, 0x48, 0x05, 0x22, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x5B, 0x37, 0x2D, 0x39,
+	0x5D, 0x7C, 0x36, 0x36, 0x36, 0x32, 0x03, 0x31, 0x31, 0x37, 0x48, 0x03, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4B, 0x48, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x5B, 0x37, 0x2D,
+	0x39, 0x5D, 0x7C, 0x36, 0x36, 0x36, 0x32, 0x03, 0x31, 0x31, 0x37, 0x48, 0x03,
+	0xEA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x31, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x7C,
+	0x34, 0x30, 0x34, 0x30, 0x34, 0x7C, 0x36, 0x36, 0x36, 0x32, 0x03, 0x31, 0x31,
+	0x37, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xAD, 0x01,
+	0x0A, 0x12, 0x12, 0x0C, 0x5B, 0x31, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32,
+	0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x18, 0x12, 0x0F, 0x31, 0x39,
+	0x5B, 0x32, 0x2D, 0x35, 0x5D, 0x7C, 0x39, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D,
+	0x32, 0x03, 0x31, 0x39, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4B, 0x49, 0xDA, 0x01,
+	0x18, 0x12, 0x0F, 0x31, 0x39, 0x5B, 0x32, 0x2D, 0x35, 0x5D, 0x7C, 0x39, 0x39,
+	0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x33, 0x12, 0x2C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x35, 0x5B, 0x30, 0x2D,
+	0x32, 0x35, 0x39, 0x5D, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x35,
+	0x5D, 0x29, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x39, 0x39, 0x5B, 0x32, 0x2D, 0x34,
+	0x5D, 0x7C, 0x31, 0x30, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x30,
+	0x30, 0xF2, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x30, 0x33, 0x32, 0x03, 0x31, 0x30,
+	0x33, 0x48, 0x03, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5F, 0x0A, 0x07, 0x12, 0x03, 0x31, 0x5C, 0x64,
+	0x48, 0x02, 0x22, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02,
+	0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4B, 0x4D, 0xDA, 0x01, 0x0B, 0x12, 0x05, 0x31, 0x5B,
+	0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xEA, 0x01, 0x0B, 0x12, 0x05, 0x31,
+	0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x88, 0x01,
+	0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x33, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x18, 0x12, 0x11, 0x33, 0x33, 0x33, 0x7C, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x33, 0x33,
+	0x33, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x4B, 0x4E, 0xDA, 0x01, 0x15, 0x12, 0x0E, 0x33, 0x33, 0x33,
+	0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03,
+	0x33, 0x33, 0x33, 0xEA, 0x01, 0x18, 0x12, 0x11, 0x33, 0x33, 0x33, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32,
+	0x03, 0x33, 0x33, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x76, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x31, 0x38, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12, 0x0A,
+	0x31, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x38, 0x31, 0x39, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4B, 0x50, 0xDA, 0x01, 0x11, 0x12, 0x0A, 0x31, 0x31,
+	0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x38, 0x31, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xEA, 0x01, 0x11, 0x12, 0x0A, 0x31, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x38,
+	0x31, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xDE, 0x02, 0x0A,
+	0x27, 0x12, 0x1D, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x3F, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48,
+	0x08, 0x22, 0x21, 0x12, 0x16, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x37,
+	0x2D, 0x39, 0x5D, 0x7C, 0x32, 0x38, 0x7C, 0x33, 0x33, 0x30, 0x7C, 0x38, 0x32,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x04, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4B,
+	0x52, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0xA1, 0x01, 0x12, 0x99, 0x01,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x36, 0x37, 0x39, 0x5D, 0x31, 0x31,
+	0x34, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x32,
+	0x7C, 0x33, 0x5B, 0x30, 0x2D, 0x33, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x34, 0x35,
+	0x3F, 0x7C, 0x35, 0x5B, 0x30, 0x35, 0x37, 0x5D, 0x7C, 0x36, 0x5B, 0x35, 0x36,
+	0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x37, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x32, 0x35,
+	0x38, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x31, 0x38, 0x39, 0x5D, 0x29, 0x7C,
+	0x35, 0x35, 0x5B, 0x31, 0x35, 0x5D, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x7C, 0x38,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x34, 0x34, 0x7C, 0x36,
+	0x36, 0x29, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x7C, 0x5B, 0x32, 0x38, 0x5D, 0x29,
+	0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C,
+	0x31, 0x5C, 0x64, 0x7C, 0x32, 0x5B, 0x30, 0x31, 0x33, 0x35, 0x37, 0x2D, 0x39,
+	0x5D, 0x7C, 0x34, 0x31, 0x7C, 0x38, 0x31, 0x31, 0x34, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x39, 0x12, 0x2E, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x31, 0x5B, 0x34, 0x2D, 0x36, 0x5D, 0x7C, 0x34,
+	0x31, 0x7C, 0x38, 0x31, 0x31, 0x34, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x36, 0x37, 0x39, 0x5D, 0x31, 0x5C, 0x64, 0x7C, 0x31, 0x31, 0x31, 0x29,
+	0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x48, 0x05, 0x0A, 0x83,
+	0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x31, 0x38, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48,
+	0x05, 0x22, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4B, 0x57, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31,
+	0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x15, 0x12, 0x0E,
+	0x31, 0x5B, 0x30, 0x2D, 0x37, 0x5D, 0x5C, 0x64, 0x7C, 0x38, 0x39, 0x38, 0x38,
+	0x37, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x38,
+	0x39, 0x38, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x38, 0x39, 0x38, 0x30, 0x30,
+	0x48, 0x05, 0x0A, 0x6C, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4B, 0x59, 0xDA,
+	0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA,
+	0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xB9, 0x01, 0x0A, 0x14,
+	0x12, 0x0C, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34,
+	0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x1F, 0x12, 0x14, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C,
+	0x32, 0x31, 0x32, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x31, 0x48, 0x03, 0x48,
+	0x04, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x4B, 0x5A, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03,
+	0x31, 0x30, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x28, 0x12, 0x21, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C, 0x32,
+	0x31, 0x32, 0x31, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x30, 0x34, 0x30, 0x7C, 0x34,
+	0x30, 0x34, 0x29, 0x30, 0x32, 0x03, 0x31, 0x30, 0x31, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x1B,
+	0x12, 0x0F, 0x28, 0x3F, 0x3A, 0x33, 0x30, 0x34, 0x5C, 0x64, 0x7C, 0x34, 0x30,
+	0x34, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x34, 0x30, 0x34, 0x30, 0x48, 0x04, 0x48,
+	0x05, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x32,
+	0x03, 0x31, 0x39, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x41, 0xDA, 0x01, 0x0E, 0x12, 0x07,
+	0x31, 0x39, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x30, 0xEA,
+	0x01, 0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x32, 0x03,
+	0x31, 0x39, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8B, 0x01, 0x0A, 0x0C, 0x12, 0x08, 0x5B,
+	0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x18, 0x12, 0x11,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x34, 0x30, 0x7C, 0x37, 0x35, 0x29,
+	0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x42,
+	0xDA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x34,
+	0x30, 0x7C, 0x37, 0x35, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xEA, 0x01, 0x18, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C,
+	0x34, 0x30, 0x7C, 0x37, 0x35, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x79, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31,
+	0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4C, 0x43, 0xDA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01,
+	0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xA1, 0x01,
+	0x0A, 0x0E, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x22, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x32, 0x37, 0x38, 0x5D, 0x7C, 0x34, 0x34, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x49, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x37, 0x38, 0x5D, 0x7C, 0x34, 0x34, 0x29, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x2E, 0x12, 0x27, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x37, 0x38, 0x5D, 0x7C, 0x34,
+	0x35, 0x29, 0x7C, 0x34, 0x5B, 0x33, 0x2D, 0x35, 0x37, 0x5D, 0x7C, 0x35, 0x30,
+	0x7C, 0x37, 0x35, 0x7C, 0x38, 0x31, 0x5B, 0x31, 0x38, 0x5D, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x9C, 0x02, 0x0A, 0x0E, 0x12, 0x08, 0x31,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x12,
+	0x12, 0x09, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x38, 0x39, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x4B, 0xDA, 0x01, 0x12, 0x12,
+	0x09, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x36, 0x38, 0x39, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0xB2, 0x01, 0x12, 0xAA, 0x01, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x34, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5B, 0x32, 0x2D, 0x34, 0x39, 0x5D, 0x7C,
+	0x32, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x33, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C,
+	0x34, 0x34, 0x7C, 0x35, 0x5B, 0x30, 0x37, 0x5D, 0x7C, 0x5B, 0x36, 0x37, 0x5D,
+	0x39, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x5B, 0x30, 0x33, 0x39, 0x5D, 0x29, 0x7C,
+	0x39, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x38, 0x39, 0x5D,
+	0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x33, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x32,
+	0x5B, 0x30, 0x2D, 0x32, 0x35, 0x36, 0x38, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x30,
+	0x33, 0x38, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x34, 0x38, 0x39, 0x5D, 0x7C,
+	0x35, 0x5B, 0x30, 0x31, 0x34, 0x2D, 0x36, 0x39, 0x5D, 0x7C, 0x36, 0x5B, 0x30,
+	0x2D, 0x32, 0x36, 0x38, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x33, 0x35, 0x37,
+	0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x32, 0x34, 0x35, 0x37, 0x2D, 0x39, 0x5D,
+	0x7C, 0x39, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x36, 0x39, 0x5D, 0x29, 0x29, 0x32,
+	0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x9D, 0x01, 0x0A, 0x13, 0x12, 0x0D,
+	0x5B, 0x33, 0x34, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D,
+	0x48, 0x03, 0x48, 0x04, 0x22, 0x10, 0x12, 0x07, 0x33, 0x35, 0x35, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x33, 0x35, 0x35, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x52,
+	0xDA, 0x01, 0x10, 0x12, 0x07, 0x33, 0x35, 0x35, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x33, 0x35, 0x35, 0x48, 0x03, 0xEA, 0x01, 0x20, 0x12, 0x19, 0x33, 0x35,
+	0x35, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x34, 0x30,
+	0x30, 0x7C, 0x39, 0x33, 0x33, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x33,
+	0x35, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x1E, 0x12, 0x14, 0x28, 0x3F, 0x3A, 0x34, 0x30,
+	0x34, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x34, 0x30, 0x7C, 0x39, 0x33, 0x29, 0x29,
+	0x5C, 0x64, 0x32, 0x04, 0x34, 0x30, 0x34, 0x30, 0x48, 0x04, 0x0A, 0x6A, 0x0A,
+	0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12,
+	0x07, 0x31, 0x31, 0x5B, 0x32, 0x35, 0x37, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4C, 0x53, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x32,
+	0x35, 0x37, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x0E, 0x12, 0x07,
+	0x31, 0x31, 0x5B, 0x32, 0x35, 0x37, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x82, 0x02, 0x0A, 0x1F, 0x12, 0x17, 0x5B, 0x30, 0x31, 0x5D, 0x5C,
+	0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x48, 0x06, 0x22, 0x3E,
+	0x12, 0x38, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x3F, 0x7C, 0x32, 0x32, 0x3F,
+	0x7C, 0x33, 0x33, 0x3F, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x31, 0x31,
+	0x31, 0x29, 0x29, 0x7C, 0x31, 0x31, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64,
+	0x7C, 0x31, 0x32, 0x29, 0x5C, 0x64, 0x32, 0x02, 0x30, 0x31, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C,
+	0x54, 0xDA, 0x01, 0x29, 0x12, 0x1F, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x3F,
+	0x7C, 0x32, 0x32, 0x3F, 0x7C, 0x33, 0x33, 0x3F, 0x29, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x02,
+	0x30, 0x31, 0x48, 0x02, 0x48, 0x03, 0xEA, 0x01, 0x45, 0x12, 0x3F, 0x30, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x3F, 0x7C, 0x32, 0x32, 0x3F, 0x7C, 0x33, 0x33, 0x3F,
+	0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31,
+	0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x29, 0x29, 0x32, 0x02, 0x30, 0x31,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xA7, 0x01, 0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x1C, 0x12, 0x11, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33, 0x5D, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48,
+	0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x55, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31,
+	0x31, 0x5B, 0x32, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x33, 0x12, 0x2C, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33, 0x5D,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29,
+	0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x7C, 0x5B, 0x32, 0x35, 0x5D,
+	0x5C, 0x64, 0x7C, 0x33, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0xE8, 0x01, 0x0A, 0x18, 0x12, 0x0C, 0x5B, 0x30, 0x31,
+	0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x35, 0x7D, 0x48, 0x02, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x25, 0x12, 0x19, 0x30, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x33,
+	0x5D, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x02, 0x30, 0x31,
+	0x48, 0x02, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x18, 0x12, 0x0C, 0x31, 0x31, 0x38,
+	0x30, 0x7C, 0x38, 0x32, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x31,
+	0x38, 0x30, 0x48, 0x04, 0x48, 0x05, 0x4A, 0x02, 0x4C, 0x56, 0xDA, 0x01, 0x18,
+	0x12, 0x0E, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x31, 0x5B, 0x30,
+	0x32, 0x33, 0x5D, 0x32, 0x02, 0x30, 0x31, 0x48, 0x02, 0x48, 0x03, 0xEA, 0x01,
+	0x48, 0x12, 0x42, 0x30, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D, 0x34, 0x5D, 0x7C, 0x36,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29, 0x7C, 0x38,
+	0x5B, 0x30, 0x31, 0x38, 0x39, 0x5D, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x35, 0x7C,
+	0x36, 0x35, 0x29, 0x35, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x38, 0x32, 0x31, 0x5B,
+	0x35, 0x37, 0x5D, 0x34, 0x32, 0x02, 0x30, 0x31, 0xF2, 0x01, 0x0E, 0x12, 0x04,
+	0x31, 0x31, 0x38, 0x31, 0x32, 0x04, 0x31, 0x31, 0x38, 0x31, 0x48, 0x04, 0xFA,
+	0x01, 0x0F, 0x12, 0x05, 0x31, 0x36, 0x35, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x36,
+	0x35, 0x30, 0x48, 0x04, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x31,
+	0x33, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4C, 0x59, 0xDA, 0x01,
+	0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x31, 0x33, 0x5D, 0x32, 0x03, 0x31,
+	0x39, 0x30, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x31, 0x33,
+	0x5D, 0x32, 0x03, 0x31, 0x39, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x79, 0x0A, 0x0C, 0x12,
+	0x06, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x12,
+	0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x37, 0x37,
+	0x29, 0x32, 0x02, 0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x41, 0xDA, 0x01, 0x12, 0x12,
+	0x0C, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x37, 0x37, 0x29,
+	0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x12, 0x12, 0x0C, 0x31, 0x28, 0x3F, 0x3A,
+	0x5B, 0x35, 0x39, 0x5D, 0x7C, 0x37, 0x37, 0x29, 0x32, 0x02, 0x31, 0x35, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x7F, 0x0A, 0x0C, 0x12, 0x06, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x3F,
+	0x48, 0x02, 0x48, 0x03, 0x22, 0x13, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x32, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4D, 0x43, 0xDA, 0x01, 0x13, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x32, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0xEA,
+	0x01, 0x16, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x34, 0x31,
+	0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0xF3, 0x01, 0x0A, 0x15, 0x12, 0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06,
+	0x22, 0x2F, 0x12, 0x24, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x32, 0x5C, 0x64, 0x29, 0x29, 0x29, 0x7C, 0x39, 0x30, 0x5B, 0x31, 0x2D, 0x33,
+	0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D,
+	0x44, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x30, 0x5B,
+	0x31, 0x2D, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01,
+	0x64, 0x12, 0x5D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C,
+	0x38, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x39, 0x30,
+	0x5B, 0x30, 0x34, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x39, 0x30, 0x5B, 0x31, 0x2D,
+	0x33, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x7C,
+	0x36, 0x5B, 0x30, 0x2D, 0x33, 0x38, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x2D,
+	0x34, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xE7, 0x01, 0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35,
+	0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x17, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x32, 0x5B, 0x32, 0x2D, 0x34, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x45, 0xDA,
+	0x01, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x32, 0x5B,
+	0x32, 0x2D, 0x34, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x70, 0x12, 0x69, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x7C,
+	0x36, 0x5C, 0x64, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x7C, 0x32, 0x29, 0x7C, 0x5B,
+	0x32, 0x34, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x35, 0x39, 0x39,
+	0x39, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x7C,
+	0x31, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x7C, 0x38, 0x38, 0x38, 0x29, 0x29, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D, 0x35, 0x5D, 0x5C, 0x64, 0x5C,
+	0x64, 0x7C, 0x36, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x37, 0x30, 0x30, 0x29,
+	0x7C, 0x31, 0x32, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xD2, 0x01, 0x0A, 0x21, 0x12, 0x19, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64, 0x28,
+	0x3F, 0x3A, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32,
+	0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x04, 0x48, 0x06, 0x22, 0x23,
+	0x12, 0x19, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x5C,
+	0x64, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x04, 0x2A, 0x15, 0x12, 0x0B,
+	0x33, 0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04,
+	0x33, 0x32, 0x30, 0x30, 0x48, 0x04, 0x4A, 0x02, 0x4D, 0x46, 0xDA, 0x01, 0x0E,
+	0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0x48,
+	0x02, 0xEA, 0x01, 0x3F, 0x12, 0x39, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C,
+	0x33, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x2D, 0x37, 0x39, 0x5D, 0x7C,
+	0x31, 0x5B, 0x30, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x29, 0x7C, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x38, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x33,
+	0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02,
+	0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x82, 0x01, 0x0A, 0x0C, 0x12, 0x06, 0x31, 0x5C,
+	0x64, 0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x15, 0x12, 0x0F, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x5B, 0x37, 0x38, 0x5D,
+	0x29, 0x32, 0x02, 0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x47, 0xDA, 0x01, 0x15, 0x12,
+	0x0F, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x5B, 0x37,
+	0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x37, 0xEA, 0x01, 0x15, 0x12, 0x0F, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x5B, 0x37, 0x38, 0x5D,
+	0x29, 0x32, 0x02, 0x31, 0x37, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05,
+	0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x48, 0xDA, 0x01, 0x0A,
+	0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x0A,
+	0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xC2, 0x01, 0x0A, 0x1E, 0x12, 0x16, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F,
+	0x29, 0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x22, 0x24, 0x12, 0x19, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B,
+	0x33, 0x7D, 0x29, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x4B, 0xDA, 0x01,
+	0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32,
+	0x2D, 0x34, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01,
+	0x32, 0x12, 0x2B, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x38, 0x5C, 0x64, 0x29, 0x7C, 0x33, 0x5C, 0x64, 0x7C, 0x39, 0x5B, 0x32, 0x2D,
+	0x34, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x7C, 0x32, 0x5C,
+	0x64, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x8C, 0x03, 0x0A, 0x18, 0x12, 0x0E, 0x5B, 0x31, 0x33, 0x36, 0x2D,
+	0x38, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x34, 0x7D, 0x48, 0x02, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x22, 0x37, 0x12, 0x2B, 0x31, 0x5B, 0x35, 0x37, 0x38,
+	0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x35, 0x32, 0x7C, 0x36, 0x37, 0x29, 0x30,
+	0x30, 0x7C, 0x37, 0x34, 0x30, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x36, 0x37, 0x37,
+	0x7C, 0x37, 0x34, 0x34, 0x7C, 0x38, 0x30, 0x30, 0x30, 0x29, 0x5C, 0x64, 0x32,
+	0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x04, 0x48, 0x05, 0x2A, 0x3D, 0x12, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x38, 0x30, 0x30, 0x29, 0x32, 0x5C, 0x64,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x35, 0x32, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x32, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x34, 0x2D, 0x36, 0x5D,
+	0x7C, 0x39, 0x39, 0x29, 0x7C, 0x37, 0x35, 0x37, 0x34, 0x29, 0x32, 0x04, 0x31,
+	0x32, 0x32, 0x30, 0x48, 0x04, 0x48, 0x05, 0x4A, 0x02, 0x4D, 0x4C, 0xDA, 0x01,
+	0x0E, 0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35,
+	0x48, 0x02, 0xEA, 0x01, 0x91, 0x01, 0x12, 0x8A, 0x01, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x39, 0x5D, 0x5C, 0x64,
+	0x7C, 0x32, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x2D,
+	0x34, 0x36, 0x39, 0x5D, 0x7C, 0x32, 0x5B, 0x31, 0x33, 0x5D, 0x29, 0x7C, 0x5B,
+	0x35, 0x37, 0x38, 0x5D, 0x29, 0x7C, 0x33, 0x35, 0x30, 0x28, 0x3F, 0x3A, 0x33,
+	0x35, 0x7C, 0x35, 0x37, 0x29, 0x7C, 0x36, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x30, 0x39, 0x5D, 0x7C, 0x5B, 0x35, 0x39, 0x5D, 0x39, 0x7C, 0x37, 0x37, 0x7C,
+	0x38, 0x5B, 0x38, 0x39, 0x5D, 0x29, 0x7C, 0x37, 0x34, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x34, 0x34, 0x7C, 0x35, 0x35, 0x29, 0x7C, 0x38,
+	0x30, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x33,
+	0x28, 0x3F, 0x3A, 0x35, 0x32, 0x7C, 0x5B, 0x36, 0x37, 0x5D, 0x5C, 0x64, 0x29,
+	0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x25, 0x12, 0x19,
+	0x33, 0x37, 0x28, 0x3F, 0x3A, 0x34, 0x33, 0x33, 0x7C, 0x35, 0x37, 0x35, 0x29,
+	0x7C, 0x37, 0x34, 0x30, 0x30, 0x7C, 0x38, 0x30, 0x30, 0x31, 0x5C, 0x64, 0x32,
+	0x04, 0x37, 0x34, 0x30, 0x30, 0x48, 0x04, 0x48, 0x05, 0xFA, 0x01, 0x25, 0x12,
+	0x1A, 0x33, 0x35, 0x30, 0x33, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x5B,
+	0x36, 0x37, 0x5D, 0x5C, 0x64, 0x7C, 0x38, 0x30, 0x30, 0x29, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x05, 0x33, 0x35, 0x30, 0x33, 0x30, 0x48, 0x05, 0x0A, 0x5E, 0x0A,
+	0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12,
+	0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x4D,
+	0xDA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39,
+	0xEA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x6D, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x0F, 0x12, 0x08, 0x31, 0x30, 0x5B, 0x30, 0x2D, 0x33, 0x35,
+	0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x4E, 0xDA, 0x01, 0x0F,
+	0x12, 0x08, 0x31, 0x30, 0x5B, 0x30, 0x2D, 0x33, 0x35, 0x5D, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xEA, 0x01, 0x0F, 0x12, 0x08, 0x31, 0x30, 0x5B, 0x30, 0x2D, 0x33,
+	0x35, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5E, 0x0A, 0x09,
+	0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03,
+	0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x4F, 0xDA,
+	0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xEA,
+	0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x6C, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38,
+	0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x50, 0xDA, 0x01,
+	0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01,
+	0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xDB, 0x01, 0x0A, 0x28, 0x12,
+	0x1E, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28,
+	0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29,
+	0x3F, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06,
+	0x22, 0x23, 0x12, 0x17, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x29, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x2A, 0x15,
+	0x12, 0x0B, 0x33, 0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x04, 0x33, 0x32, 0x30, 0x30, 0x48, 0x04, 0x4A, 0x02, 0x4D, 0x51, 0xDA,
+	0x01, 0x17, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x03, 0xEA,
+	0x01, 0x2F, 0x12, 0x29, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x5B, 0x30,
+	0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x36, 0x39,
+	0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x14,
+	0x12, 0x08, 0x31, 0x31, 0x38, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x06, 0x31,
+	0x31, 0x38, 0x30, 0x30, 0x30, 0x48, 0x06, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5F, 0x0A, 0x07, 0x12,
+	0x03, 0x31, 0x5C, 0x64, 0x48, 0x02, 0x22, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37,
+	0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x52, 0xDA, 0x01, 0x0B,
+	0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xEA, 0x01,
+	0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x80, 0x01, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x32, 0x39, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31,
+	0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x4D, 0x53, 0xDA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA,
+	0x01, 0x18, 0x12, 0x11, 0x32, 0x31, 0x31, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31,
+	0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x32, 0x31, 0x31,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x8F, 0x01, 0x0A, 0x15, 0x12, 0x0F, 0x31, 0x5C, 0x64, 0x5C,
+	0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03,
+	0x48, 0x06, 0x22, 0x15, 0x12, 0x0E, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4D, 0x54, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x22, 0x12, 0x1B, 0x31, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x95, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B,
+	0x31, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x22, 0x16, 0x12, 0x0D, 0x31, 0x31, 0x5B, 0x34, 0x35,
+	0x5D, 0x7C, 0x39, 0x39, 0x5B, 0x35, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x34,
+	0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x55, 0xDA, 0x01, 0x16, 0x12, 0x0D, 0x31, 0x31,
+	0x5B, 0x34, 0x35, 0x5D, 0x7C, 0x39, 0x39, 0x5B, 0x35, 0x39, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x34, 0x48, 0x03, 0xEA, 0x01, 0x1E, 0x12, 0x17, 0x31, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x7C, 0x28, 0x3F, 0x3A, 0x38, 0x5C, 0x64, 0x5C,
+	0x64, 0x7C, 0x39, 0x39, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0xA2, 0x01, 0x0A, 0x11, 0x12, 0x0B, 0x5B, 0x31, 0x34, 0x5D, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x16, 0x12,
+	0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x31, 0x5B, 0x38, 0x39, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x30, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x56, 0xDA,
+	0x01, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x32, 0x7C, 0x31, 0x5B,
+	0x38, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x30, 0x32, 0x48, 0x03, 0xEA, 0x01,
+	0x2A, 0x12, 0x23, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x33, 0x37, 0x2D,
+	0x39, 0x5D, 0x7C, 0x5B, 0x34, 0x2D, 0x36, 0x5D, 0x5C, 0x64, 0x29, 0x5C, 0x64,
+	0x7C, 0x34, 0x30, 0x34, 0x30, 0x7C, 0x31, 0x5B, 0x34, 0x35, 0x5D, 0x31, 0x32,
+	0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x5B, 0x34,
+	0x35, 0x5D, 0x31, 0x32, 0x03, 0x31, 0x34, 0x31, 0x48, 0x03, 0x0A, 0x97, 0x01,
+	0x0A, 0x19, 0x12, 0x13, 0x5B, 0x31, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48,
+	0x05, 0x22, 0x14, 0x12, 0x0B, 0x31, 0x39, 0x39, 0x7C, 0x39, 0x39, 0x5B, 0x37,
+	0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x39, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D,
+	0x57, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x39, 0x39, 0x7C, 0x39, 0x39, 0x5B,
+	0x37, 0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x39, 0x48, 0x03, 0xEA, 0x01,
+	0x18, 0x12, 0x11, 0x31, 0x39, 0x39, 0x7C, 0x38, 0x30, 0x34, 0x30, 0x30, 0x7C,
+	0x39, 0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x39, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x12, 0x12, 0x07, 0x38, 0x30, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32,
+	0x05, 0x38, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xC4, 0x01, 0x0A, 0x15,
+	0x12, 0x0D, 0x5B, 0x30, 0x35, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x1C, 0x12, 0x13, 0x30,
+	0x28, 0x3F, 0x3A, 0x36, 0x5B, 0x30, 0x35, 0x36, 0x38, 0x5D, 0x7C, 0x38, 0x30,
+	0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x30, 0x36, 0x30, 0x48, 0x03, 0x2A,
+	0x1B, 0x12, 0x0F, 0x28, 0x3F, 0x3A, 0x35, 0x33, 0x30, 0x5C, 0x64, 0x7C, 0x37,
+	0x37, 0x36, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x37, 0x37, 0x36, 0x30, 0x48, 0x04,
+	0x48, 0x05, 0x4A, 0x02, 0x4D, 0x58, 0xDA, 0x01, 0x1C, 0x12, 0x13, 0x30, 0x28,
+	0x3F, 0x3A, 0x36, 0x5B, 0x30, 0x35, 0x36, 0x38, 0x5D, 0x7C, 0x38, 0x30, 0x29,
+	0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x30, 0x36, 0x30, 0x48, 0x03, 0xEA, 0x01,
+	0x1E, 0x12, 0x17, 0x30, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x35,
+	0x33, 0x30, 0x35, 0x33, 0x7C, 0x37, 0x37, 0x36, 0x36, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x30, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x1D, 0x12, 0x14, 0x30, 0x28,
+	0x3F, 0x3A, 0x5B, 0x32, 0x34, 0x39, 0x5D, 0x30, 0x7C, 0x5B, 0x33, 0x35, 0x5D,
+	0x5B, 0x30, 0x31, 0x5D, 0x29, 0x32, 0x03, 0x30, 0x32, 0x30, 0x48, 0x03, 0x0A,
+	0xED, 0x02, 0x0A, 0x15, 0x12, 0x0D, 0x5B, 0x31, 0x33, 0x36, 0x39, 0x5D, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22,
+	0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D, 0x59, 0xDA, 0x01, 0x10, 0x12, 0x07,
+	0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48,
+	0x03, 0xEA, 0x01, 0xF9, 0x01, 0x12, 0xF1, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x31, 0x33, 0x34, 0x38, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x32, 0x5D, 0x7C, 0x31, 0x5B, 0x31, 0x32, 0x38, 0x5D, 0x7C, 0x33, 0x31,
+	0x31, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x32, 0x35, 0x5D,
+	0x7C, 0x5B, 0x31, 0x33, 0x2D, 0x36, 0x5D, 0x7C, 0x32, 0x5C, 0x64, 0x7B, 0x30,
+	0x2C, 0x32, 0x7D, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x31, 0x2D, 0x33,
+	0x35, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x34, 0x35, 0x5D, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x34, 0x35, 0x34, 0x7C,
+	0x35, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x38,
+	0x7C, 0x39, 0x39, 0x39, 0x3F, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x31, 0x38,
+	0x3F, 0x7C, 0x32, 0x7C, 0x38, 0x5B, 0x31, 0x38, 0x5D, 0x29, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x5B, 0x31, 0x32, 0x34, 0x5D, 0x5C, 0x64, 0x3F, 0x7C, 0x36, 0x38,
+	0x7C, 0x37, 0x31, 0x7C, 0x39, 0x5B, 0x30, 0x36, 0x37, 0x39, 0x5D, 0x29, 0x29,
+	0x7C, 0x36, 0x36, 0x36, 0x32, 0x38, 0x7C, 0x39, 0x39, 0x5B, 0x31, 0x2D, 0x34,
+	0x36, 0x39, 0x5D, 0x7C, 0x31, 0x33, 0x5B, 0x35, 0x2D, 0x37, 0x5D, 0x7C, 0x28,
+	0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x35, 0x36, 0x39, 0x5D, 0x7C,
+	0x33, 0x30, 0x39, 0x7C, 0x35, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x37, 0x5B, 0x31,
+	0x33, 0x36, 0x2D, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x33, 0x5D, 0x29, 0x7C,
+	0x33, 0x5B, 0x32, 0x33, 0x36, 0x37, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x29,
+	0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x12, 0x12, 0x07, 0x36,
+	0x36, 0x36, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x36, 0x36, 0x36, 0x30, 0x30,
+	0x48, 0x05, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0x99, 0x01, 0x0A, 0x0E, 0x12, 0x08, 0x31, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x19, 0x12, 0x10,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x37,
+	0x38, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x37, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4D,
+	0x5A, 0xDA, 0x01, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37,
+	0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x37, 0x48, 0x03, 0xEA, 0x01, 0x22, 0x12, 0x1B, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x30, 0x32, 0x2D, 0x35, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x37,
+	0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x37, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0x8B, 0x01, 0x0A, 0x13, 0x12, 0x0B, 0x5B, 0x31, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48,
+	0x05, 0x22, 0x10, 0x12, 0x05, 0x31, 0x30, 0x31, 0x31, 0x31, 0x32, 0x05, 0x31,
+	0x30, 0x31, 0x31, 0x31, 0x48, 0x05, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4E, 0x41, 0xDA, 0x01, 0x10,
+	0x12, 0x05, 0x31, 0x30, 0x31, 0x31, 0x31, 0x32, 0x05, 0x31, 0x30, 0x31, 0x31,
+	0x31, 0x48, 0x05, 0xEA, 0x01, 0x21, 0x12, 0x1A, 0x28, 0x3F, 0x3A, 0x31, 0x30,
+	0x7C, 0x39, 0x33, 0x29, 0x31, 0x31, 0x31, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x5C,
+	0x64, 0x7C, 0x39, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x39, 0x30, 0x30,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xE1, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x33, 0x35,
+	0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x33, 0x7D, 0x48, 0x02, 0x48, 0x03, 0x48,
+	0x04, 0x22, 0x33, 0x12, 0x2D, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x7C, 0x31, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x7C, 0x38, 0x5C, 0x64, 0x29, 0x7C, 0x5B, 0x35, 0x2D, 0x38, 0x5D,
+	0x29, 0x7C, 0x33, 0x36, 0x33, 0x5C, 0x64, 0x7C, 0x35, 0x37, 0x37, 0x32, 0x02,
+	0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x4E, 0x43, 0xDA, 0x01, 0x0E, 0x12, 0x06, 0x31, 0x5B,
+	0x35, 0x2D, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0xEA, 0x01, 0x4D,
+	0x12, 0x47, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30,
+	0x36, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x32, 0x2D, 0x34, 0x36, 0x5D, 0x7C, 0x32,
+	0x30, 0x7C, 0x33, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x5D, 0x7C, 0x34, 0x32, 0x7C,
+	0x35, 0x5B, 0x30, 0x35, 0x38, 0x5D, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x29,
+	0x7C, 0x5B, 0x35, 0x2D, 0x38, 0x5D, 0x29, 0x7C, 0x33, 0x36, 0x33, 0x31, 0x7C,
+	0x35, 0x5B, 0x36, 0x2D, 0x38, 0x5D, 0x5C, 0x64, 0x32, 0x02, 0x31, 0x35, 0xF2,
+	0x01, 0x13, 0x12, 0x0A, 0x35, 0x28, 0x3F, 0x3A, 0x36, 0x37, 0x7C, 0x38, 0x38,
+	0x29, 0x32, 0x03, 0x35, 0x36, 0x37, 0x48, 0x03, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x90, 0x02, 0x0A,
+	0x23, 0x12, 0x1B, 0x5B, 0x31, 0x2D, 0x33, 0x35, 0x37, 0x38, 0x5D, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x48, 0x06, 0x22, 0x1C, 0x12,
+	0x16, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D,
+	0x29, 0x7C, 0x37, 0x32, 0x33, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x02, 0x31,
+	0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x4E, 0x45, 0xDA, 0x01, 0x1A, 0x12, 0x14, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x38, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x7C, 0x37, 0x32,
+	0x33, 0x31, 0x34, 0x31, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x4B, 0x12, 0x45,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x31, 0x5B, 0x31,
+	0x32, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x34, 0x5D, 0x7C, 0x33, 0x5B,
+	0x30, 0x31, 0x33, 0x5D, 0x7C, 0x5B, 0x34, 0x36, 0x5D, 0x30, 0x7C, 0x35, 0x35,
+	0x3F, 0x7C, 0x5B, 0x37, 0x38, 0x5D, 0x29, 0x7C, 0x32, 0x32, 0x32, 0x7C, 0x33,
+	0x33, 0x33, 0x7C, 0x35, 0x35, 0x35, 0x7C, 0x37, 0x32, 0x33, 0x31, 0x34, 0x31,
+	0x7C, 0x38, 0x38, 0x38, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x40, 0x12,
+	0x37, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x31, 0x5B,
+	0x31, 0x32, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x34, 0x5D, 0x7C, 0x33, 0x5B,
+	0x30, 0x31, 0x33, 0x5D, 0x7C, 0x5B, 0x34, 0x36, 0x5D, 0x30, 0x7C, 0x35, 0x35,
+	0x29, 0x7C, 0x32, 0x32, 0x32, 0x7C, 0x33, 0x33, 0x33, 0x7C, 0x35, 0x35, 0x35,
+	0x7C, 0x38, 0x38, 0x38, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0x0A, 0x7C,
+	0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x14,
+	0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x35, 0x35, 0x7C, 0x37,
+	0x37, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4E, 0x46, 0xDA, 0x01,
+	0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x35, 0x35, 0x7C,
+	0x37, 0x37, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x35, 0x35, 0x7C, 0x37, 0x37, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x7E, 0x0A, 0x18, 0x12, 0x12,
+	0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x0C, 0x12, 0x03,
+	0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39, 0x39, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4E,
+	0x47, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x39, 0x39, 0x32, 0x03, 0x31, 0x39,
+	0x39, 0x48, 0x03, 0xEA, 0x01, 0x10, 0x12, 0x09, 0x31, 0x39, 0x39, 0x7C, 0x34,
+	0x30, 0x37, 0x30, 0x30, 0x32, 0x03, 0x31, 0x39, 0x39, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12,
+	0x12, 0x07, 0x34, 0x30, 0x37, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34, 0x30,
+	0x37, 0x30, 0x30, 0x48, 0x05, 0x0A, 0xC1, 0x01, 0x0A, 0x14, 0x12, 0x0E, 0x5B,
+	0x31, 0x32, 0x34, 0x36, 0x37, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D,
+	0x48, 0x03, 0x48, 0x04, 0x22, 0x1D, 0x12, 0x16, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x35, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x38, 0x5D, 0x29, 0x7C, 0x37,
+	0x33, 0x37, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4E, 0x49,
+	0xDA, 0x01, 0x19, 0x12, 0x10, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x35, 0x38,
+	0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x38, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x35,
+	0x48, 0x03, 0xEA, 0x01, 0x40, 0x12, 0x39, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x35, 0x38, 0x5D, 0x7C, 0x32, 0x30, 0x30, 0x29, 0x7C, 0x34, 0x38, 0x37, 0x38,
+	0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x30, 0x7C, 0x33, 0x37, 0x33, 0x29,
+	0x7C, 0x31, 0x32, 0x5B, 0x30, 0x31, 0x35, 0x38, 0x5D, 0x7C, 0x28, 0x3F, 0x3A,
+	0x31, 0x39, 0x7C, 0x5B, 0x32, 0x36, 0x37, 0x5D, 0x31, 0x29, 0x30, 0x30, 0x32,
+	0x03, 0x31, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF9, 0x01, 0x0A, 0x23, 0x12, 0x1B,
+	0x5B, 0x31, 0x33, 0x34, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x22, 0x1D, 0x12, 0x12, 0x31, 0x31,
+	0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C,
+	0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4E, 0x4C, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x6E, 0x12,
+	0x67, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x30, 0x2D, 0x34, 0x5D, 0x7C, 0x33, 0x5B, 0x33, 0x34, 0x5D, 0x7C,
+	0x34, 0x34, 0x29, 0x7C, 0x33, 0x5B, 0x30, 0x33, 0x2D, 0x39, 0x5D, 0x5C, 0x64,
+	0x7C, 0x34, 0x30, 0x30, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D,
+	0x39, 0x5D, 0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x37, 0x39, 0x5D, 0x29,
+	0x29, 0x7C, 0x5B, 0x33, 0x34, 0x5D, 0x30, 0x30, 0x30, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x05, 0x31, 0x32,
+	0x30, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x32, 0x30, 0x30, 0x48, 0x04, 0x0A, 0xCA,
+	0x01, 0x0A, 0x15, 0x12, 0x0B, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64, 0x7B, 0x32,
+	0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x1D,
+	0x12, 0x12, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48,
+	0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4E, 0x4F, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31,
+	0x31, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03,
+	0xEA, 0x01, 0x51, 0x12, 0x4A, 0x30, 0x34, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x33, 0x39,
+	0x5D, 0x7C, 0x36, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C,
+	0x32, 0x33, 0x29, 0x29, 0x7C, 0x32, 0x5B, 0x30, 0x34, 0x38, 0x5D, 0x7C, 0x34,
+	0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35, 0x39, 0x5D, 0x29, 0x7C, 0x37,
+	0x5B, 0x35, 0x37, 0x5D, 0x7C, 0x38, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x30,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xA7, 0x01, 0x0A, 0x0E,
+	0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x22, 0x23, 0x12, 0x1C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D,
+	0x33, 0x36, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x39, 0x7C, 0x31, 0x31, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x30, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4E, 0x50, 0xDA, 0x01, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x30, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30,
+	0x30, 0x48, 0x03, 0xEA, 0x01, 0x28, 0x12, 0x21, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x2D, 0x33, 0x36, 0x5D, 0x7C, 0x39, 0x38, 0x29,
+	0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x7C, 0x32,
+	0x29, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x74, 0x0A, 0x09,
+	0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07,
+	0x31, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x4E, 0x52, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0xEA, 0x01, 0x18, 0x12, 0x11, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x32, 0x33, 0x7C,
+	0x39, 0x32, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6C, 0x0A,
+	0x0D, 0x12, 0x09, 0x5B, 0x30, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x4E, 0x55, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32,
+	0x03, 0x39, 0x39, 0x39, 0xEA, 0x01, 0x15, 0x12, 0x0E, 0x30, 0x31, 0x5B, 0x30,
+	0x35, 0x5D, 0x7C, 0x31, 0x30, 0x31, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x30,
+	0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0A, 0x12, 0x03, 0x30, 0x31, 0x30, 0x32, 0x03,
+	0x30, 0x31, 0x30, 0x0A, 0xAD, 0x01, 0x0A, 0x0D, 0x12, 0x07, 0x5C, 0x64, 0x7B,
+	0x33, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x0C, 0x12, 0x03, 0x31,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0C, 0x12, 0x03,
+	0x30, 0x31, 0x38, 0x32, 0x03, 0x30, 0x31, 0x38, 0x48, 0x03, 0x4A, 0x02, 0x4E,
+	0x5A, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31,
+	0x31, 0x48, 0x03, 0xEA, 0x01, 0x50, 0x12, 0x49, 0x30, 0x31, 0x38, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x33, 0x37, 0x29, 0x31, 0x7C,
+	0x28, 0x3F, 0x3A, 0x32, 0x33, 0x7C, 0x39, 0x34, 0x29, 0x34, 0x7C, 0x37, 0x5B,
+	0x30, 0x33, 0x5D, 0x37, 0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x35, 0x37, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x36, 0x28, 0x3F, 0x3A,
+	0x31, 0x36, 0x31, 0x7C, 0x32, 0x36, 0x5B, 0x30, 0x2D, 0x33, 0x5D, 0x7C, 0x37,
+	0x34, 0x32, 0x29, 0x32, 0x03, 0x30, 0x31, 0x38, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xB9, 0x01,
+	0x0A, 0x0D, 0x12, 0x09, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x48, 0x04, 0x22, 0x12, 0x12, 0x0A, 0x31, 0x34, 0x34, 0x34, 0x7C, 0x39, 0x39,
+	0x39, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x34, 0x34, 0x34, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x4F, 0x4D,
+	0xDA, 0x01, 0x11, 0x12, 0x09, 0x31, 0x34, 0x34, 0x34, 0x7C, 0x39, 0x39, 0x39,
+	0x39, 0x32, 0x04, 0x31, 0x34, 0x34, 0x34, 0xEA, 0x01, 0x52, 0x12, 0x4A, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x31, 0x7C, 0x32, 0x32, 0x32, 0x7C, 0x34, 0x28,
+	0x3F, 0x3A, 0x34, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x7C, 0x35, 0x30, 0x7C, 0x36,
+	0x36, 0x7C, 0x37, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x35, 0x31, 0x5B,
+	0x30, 0x2D, 0x38, 0x5D, 0x29, 0x7C, 0x39, 0x39, 0x39, 0x39, 0x7C, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x5B, 0x33, 0x2D, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x7C, 0x35, 0x30, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x31, 0x31,
+	0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0x69, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x50, 0x41, 0xDA, 0x01, 0x0A, 0x12,
+	0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x12, 0x12,
+	0x0B, 0x31, 0x30, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x31, 0x30, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x7C, 0x0A, 0x09, 0x12, 0x05, 0x31,
+	0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x14, 0x12, 0x0D, 0x31, 0x28, 0x3F,
+	0x3A, 0x30, 0x35, 0x7C, 0x31, 0x5B, 0x36, 0x37, 0x5D, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x50, 0x45, 0xDA, 0x01, 0x14, 0x12, 0x0D, 0x31, 0x28,
+	0x3F, 0x3A, 0x30, 0x35, 0x7C, 0x31, 0x5B, 0x36, 0x37, 0x5D, 0x29, 0x32, 0x03,
+	0x31, 0x30, 0x35, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x35, 0x7C, 0x31, 0x5B, 0x36, 0x37, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x30, 0x35,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x62, 0x0A, 0x07, 0x12, 0x03, 0x31, 0x5C, 0x64, 0x48, 0x02,
+	0x22, 0x0C, 0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31,
+	0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x50, 0x46, 0xDA, 0x01, 0x0C, 0x12, 0x06, 0x31, 0x5B, 0x35,
+	0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x0C, 0x12, 0x06, 0x31,
+	0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x98,
+	0x01, 0x0A, 0x17, 0x12, 0x0B, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64, 0x7B, 0x32,
+	0x2C, 0x36, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x48, 0x07,
+	0x22, 0x13, 0x12, 0x0A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x5B, 0x30, 0x31,
+	0x5D, 0x32, 0x03, 0x30, 0x30, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x50, 0x47, 0xDA,
+	0x01, 0x13, 0x12, 0x0A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x5B, 0x30, 0x31,
+	0x5D, 0x32, 0x03, 0x30, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x24, 0x12, 0x1D,
+	0x30, 0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x31, 0x5D,
+	0x7C, 0x35, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x35, 0x7D, 0x29, 0x32, 0x03, 0x30, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x76, 0x0A,
+	0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x11, 0x12, 0x0A, 0x31, 0x31, 0x5B, 0x32, 0x37, 0x5D, 0x7C, 0x39, 0x31,
+	0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x50, 0x48, 0xDA, 0x01, 0x11,
+	0x12, 0x0A, 0x31, 0x31, 0x5B, 0x32, 0x37, 0x5D, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x11, 0x12, 0x0A, 0x31, 0x31, 0x5B, 0x32,
+	0x37, 0x5D, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0xA1, 0x01, 0x0A, 0x10, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x31, 0x2C,
+	0x33, 0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x22, 0x1B, 0x12, 0x15, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x5C, 0x64, 0x3F, 0x7C, 0x35,
+	0x29, 0x7C, 0x5B, 0x35, 0x36, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x50, 0x4B, 0xDA, 0x01, 0x1A, 0x12, 0x14, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x32, 0x3F, 0x7C, 0x35, 0x29, 0x7C, 0x5B, 0x35, 0x36, 0x5D,
+	0x29, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x25, 0x12, 0x1F, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x32, 0x32, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x34, 0x5D, 0x7C, 0x5B,
+	0x35, 0x36, 0x5D, 0x29, 0x7C, 0x31, 0x31, 0x5B, 0x32, 0x34, 0x35, 0x37, 0x2D,
+	0x39, 0x5D, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xD2, 0x01, 0x0A, 0x1C,
+	0x12, 0x14, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05,
+	0x48, 0x06, 0x22, 0x21, 0x12, 0x16, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x39, 0x39, 0x5B, 0x37, 0x2D,
+	0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x50, 0x4C, 0xDA, 0x01, 0x14, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x39,
+	0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x4A, 0x12, 0x43, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32,
+	0x7C, 0x36, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x7C,
+	0x38, 0x39, 0x31, 0x5B, 0x32, 0x33, 0x5D, 0x29, 0x7C, 0x39, 0x5C, 0x64, 0x7B,
+	0x33, 0x7D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x38, 0x5B, 0x34, 0x2D, 0x37,
+	0x5D, 0x7C, 0x39, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x31, 0x31, 0x5B,
+	0x36, 0x38, 0x5D, 0x30, 0x30, 0x30, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0xE9, 0x01, 0x0A, 0x21, 0x12, 0x19, 0x5B, 0x31, 0x33, 0x5D, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B,
+	0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x04, 0x48, 0x06, 0x22,
+	0x2F, 0x12, 0x25, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x33, 0x31, 0x30,
+	0x5B, 0x30, 0x2D, 0x32, 0x34, 0x35, 0x5D, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30,
+	0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x31, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x5C,
+	0x64, 0x32, 0x02, 0x31, 0x35, 0x48, 0x02, 0x48, 0x04, 0x2A, 0x15, 0x12, 0x0B,
+	0x33, 0x5B, 0x32, 0x34, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x04,
+	0x33, 0x32, 0x30, 0x30, 0x48, 0x04, 0x4A, 0x02, 0x50, 0x4D, 0xDA, 0x01, 0x0E,
+	0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0x48,
+	0x02, 0xEA, 0x01, 0x3E, 0x12, 0x38, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x7C,
+	0x33, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x35, 0x5D, 0x7C, 0x5B,
+	0x31, 0x2D, 0x36, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x29, 0x7C, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x38, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x30,
+	0x32, 0x34, 0x36, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x02, 0x31,
+	0x35, 0xF2, 0x01, 0x14, 0x12, 0x08, 0x31, 0x31, 0x38, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x32, 0x06, 0x31, 0x31, 0x38, 0x30, 0x30, 0x30, 0x48, 0x06, 0xFA, 0x01,
+	0x0E, 0x12, 0x04, 0x33, 0x31, 0x30, 0x33, 0x32, 0x04, 0x33, 0x31, 0x30, 0x33,
+	0x48, 0x04, 0x0A, 0x6C, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x50, 0x52, 0xDA,
+	0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA,
+	0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x8F, 0x01, 0x0A, 0x0E,
+	0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x22, 0x17, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D,
+	0x32, 0x5D, 0x7C, 0x36, 0x36, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x50, 0x53, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x30, 0x5B, 0x30,
+	0x2D, 0x32, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x1F,
+	0x12, 0x18, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C,
+	0x31, 0x32, 0x32, 0x7C, 0x34, 0x34, 0x7C, 0x36, 0x36, 0x7C, 0x39, 0x39, 0x29,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0F, 0x12, 0x05, 0x31, 0x31,
+	0x32, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x31, 0x32, 0x30, 0x48, 0x04, 0x0A, 0xAC,
+	0x02, 0x0A, 0x1E, 0x12, 0x16, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x04, 0x48, 0x06, 0x22, 0x2B, 0x12, 0x24, 0x31, 0x31,
+	0x5B, 0x32, 0x35, 0x37, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x35, 0x5B, 0x31, 0x35, 0x38, 0x39, 0x5D, 0x7C, 0x38,
+	0x5B, 0x32, 0x37, 0x39, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x50, 0x54, 0xDA, 0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x32,
+	0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x9C, 0x01,
+	0x12, 0x94, 0x01, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x34, 0x35,
+	0x7C, 0x35, 0x5B, 0x30, 0x31, 0x5D, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x5B,
+	0x32, 0x35, 0x37, 0x38, 0x5D, 0x7C, 0x36, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D,
+	0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x34, 0x35, 0x5D, 0x7C, 0x34,
+	0x29, 0x7C, 0x35, 0x38, 0x33, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30,
+	0x32, 0x33, 0x36, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x39, 0x5B,
+	0x31, 0x36, 0x39, 0x5D, 0x29, 0x29, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x36,
+	0x31, 0x31, 0x7C, 0x35, 0x39, 0x29, 0x31, 0x7C, 0x31, 0x5B, 0x30, 0x36, 0x38,
+	0x5D, 0x37, 0x38, 0x7C, 0x31, 0x5B, 0x30, 0x38, 0x5D, 0x39, 0x5B, 0x31, 0x36,
+	0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x38, 0x5D,
+	0x7C, 0x34, 0x30, 0x7C, 0x35, 0x5B, 0x31, 0x35, 0x5D, 0x7C, 0x36, 0x5B, 0x32,
+	0x35, 0x38, 0x5D, 0x7C, 0x38, 0x32, 0x29, 0x30, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31,
+	0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x50, 0x57, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xC7, 0x01, 0x0A, 0x1F, 0x12,
+	0x17, 0x5B, 0x31, 0x32, 0x34, 0x35, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28,
+	0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x33, 0x2C, 0x34, 0x7D, 0x29, 0x3F, 0x48, 0x03,
+	0x48, 0x06, 0x48, 0x07, 0x22, 0x10, 0x12, 0x07, 0x31, 0x32, 0x38, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x32, 0x38, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x50, 0x59,
+	0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x32, 0x38, 0x7C, 0x39, 0x31, 0x31, 0x32,
+	0x03, 0x31, 0x32, 0x38, 0x48, 0x03, 0xEA, 0x01, 0x33, 0x12, 0x2C, 0x5B, 0x31,
+	0x32, 0x34, 0x35, 0x5D, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64, 0x7B, 0x35, 0x7D,
+	0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x7C, 0x5B, 0x32,
+	0x34, 0x35, 0x5D, 0x30, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x5C, 0x64, 0x7C,
+	0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x29, 0x12,
+	0x1B, 0x5B, 0x31, 0x32, 0x34, 0x35, 0x5D, 0x5B, 0x30, 0x31, 0x5D, 0x5C, 0x64,
+	0x7B, 0x35, 0x7D, 0x7C, 0x5B, 0x32, 0x34, 0x35, 0x5D, 0x30, 0x5C, 0x64, 0x7B,
+	0x34, 0x7D, 0x32, 0x06, 0x32, 0x30, 0x30, 0x30, 0x30, 0x30, 0x48, 0x06, 0x48,
+	0x07, 0x0A, 0x8F, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x32, 0x39, 0x5D,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05,
+	0x22, 0x0D, 0x12, 0x04, 0x39, 0x39, 0x5C, 0x64, 0x32, 0x03, 0x39, 0x39, 0x30,
+	0x48, 0x03, 0x2A, 0x0C, 0x12, 0x03, 0x39, 0x30, 0x30, 0x32, 0x03, 0x39, 0x30,
+	0x30, 0x48, 0x03, 0x4A, 0x02, 0x51, 0x41, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x39,
+	0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x2A, 0x12,
+	0x23, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x39, 0x5D, 0x5C,
+	0x64, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x7C, 0x32, 0x30, 0x7C, 0x39, 0x5B,
+	0x32, 0x37, 0x5D, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x7C, 0x0A, 0x0C, 0x12, 0x06, 0x31, 0x5C, 0x64,
+	0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x13, 0x12, 0x0D, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02,
+	0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x52, 0x45, 0xDA, 0x01, 0x13, 0x12, 0x0D, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02,
+	0x31, 0x35, 0xEA, 0x01, 0x13, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32,
+	0x7C, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x29, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x98, 0x02, 0x0A, 0x15, 0x12, 0x0B, 0x5B, 0x31, 0x38, 0x5D, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06,
+	0x22, 0x1C, 0x12, 0x11, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33, 0x5D,
+	0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0x48, 0x06, 0x2A, 0x2A, 0x12, 0x1E, 0x28, 0x3F, 0x3A, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x38, 0x5B, 0x33, 0x39, 0x5D, 0x7C, 0x5B, 0x32, 0x34, 0x5D,
+	0x29, 0x7C, 0x38, 0x5B, 0x34, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32,
+	0x04, 0x31, 0x32, 0x30, 0x30, 0x48, 0x04, 0x48, 0x06, 0x4A, 0x02, 0x52, 0x4F,
+	0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0x84, 0x01, 0x12, 0x7D, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x30, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29,
+	0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x38,
+	0x5B, 0x31, 0x38, 0x5D, 0x29, 0x31, 0x7C, 0x31, 0x31, 0x39, 0x7C, 0x5B, 0x32,
+	0x33, 0x5D, 0x30, 0x30, 0x7C, 0x39, 0x33, 0x32, 0x29, 0x29, 0x7C, 0x5B, 0x32,
+	0x34, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x39, 0x29, 0x7C, 0x31, 0x5B, 0x31, 0x39,
+	0x5D, 0x7C, 0x32, 0x31, 0x7C, 0x33, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x35, 0x5B,
+	0x31, 0x37, 0x38, 0x5D, 0x29, 0x29, 0x7C, 0x38, 0x5B, 0x34, 0x38, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x90, 0x01,
+	0x0A, 0x17, 0x12, 0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C,
+	0x35, 0x7D, 0x48, 0x02, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x14, 0x12, 0x0A, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D,
+	0x32, 0x02, 0x39, 0x32, 0x48, 0x02, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x52, 0x53, 0xDA,
+	0x01, 0x14, 0x12, 0x0A, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34,
+	0x5D, 0x32, 0x02, 0x39, 0x32, 0x48, 0x02, 0x48, 0x03, 0xEA, 0x01, 0x1A, 0x12,
+	0x14, 0x31, 0x5B, 0x31, 0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x34,
+	0x7D, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x32, 0x02, 0x39, 0x32, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x8B, 0x01, 0x0A, 0x0F, 0x12, 0x09, 0x5B, 0x30, 0x31, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x17, 0x12, 0x11, 0x31,
+	0x31, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x30, 0x29, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x32, 0x02, 0x30, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x52, 0x55, 0xDA, 0x01,
+	0x17, 0x12, 0x11, 0x31, 0x31, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31,
+	0x30, 0x29, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x32, 0x02, 0x30, 0x31, 0xEA, 0x01,
+	0x17, 0x12, 0x11, 0x31, 0x31, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31,
+	0x30, 0x29, 0x5B, 0x31, 0x2D, 0x34, 0x5D, 0x32, 0x02, 0x30, 0x31, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x8B, 0x01, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x34, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x0F, 0x12, 0x08, 0x31, 0x31, 0x5B, 0x31, 0x32,
+	0x34, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x52, 0x57, 0xDA,
+	0x01, 0x0D, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x31, 0x32, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x31, 0xEA, 0x01, 0x2C, 0x12, 0x25, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x2D, 0x36,
+	0x5D, 0x7C, 0x32, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x37, 0x30, 0x7C, 0x39, 0x39,
+	0x29, 0x7C, 0x34, 0x35, 0x36, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0x97, 0x02, 0x0A, 0x15, 0x12, 0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x2B, 0x12, 0x20, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64,
+	0x7B, 0x33, 0x7D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33,
+	0x37, 0x7C, 0x39, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x41, 0xDA, 0x01, 0x1A, 0x12,
+	0x11, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39,
+	0x5B, 0x37, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA,
+	0x01, 0x6C, 0x12, 0x65, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x30, 0x7C, 0x32, 0x7C, 0x36, 0x31, 0x31, 0x31, 0x29, 0x7C, 0x34, 0x31, 0x30,
+	0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x5B, 0x38, 0x39, 0x5D,
+	0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x39, 0x39, 0x7C, 0x32, 0x32, 0x7C, 0x39,
+	0x5B, 0x30, 0x2D, 0x33, 0x5D, 0x29, 0x29, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x32, 0x34, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x31, 0x31, 0x7C, 0x33,
+	0x5B, 0x33, 0x37, 0x39, 0x5D, 0x7C, 0x34, 0x30, 0x7C, 0x36, 0x36, 0x7C, 0x38,
+	0x5B, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0F, 0x12, 0x05, 0x31, 0x34,
+	0x31, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x34, 0x31, 0x30, 0x48, 0x04, 0xFA, 0x01,
+	0x21, 0x12, 0x16, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x7C, 0x34, 0x31, 0x29,
+	0x5C, 0x64, 0x7C, 0x39, 0x30, 0x5B, 0x32, 0x34, 0x36, 0x37, 0x39, 0x5D, 0x32,
+	0x03, 0x39, 0x30, 0x32, 0x48, 0x03, 0x48, 0x04, 0x0A, 0xC3, 0x01, 0x0A, 0x0F,
+	0x12, 0x0B, 0x5B, 0x31, 0x32, 0x37, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39,
+	0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x53, 0x42, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39,
+	0x32, 0x03, 0x39, 0x39, 0x39, 0xEA, 0x01, 0x69, 0x12, 0x62, 0x31, 0x28, 0x3F,
+	0x3A, 0x5B, 0x30, 0x32, 0x5D, 0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x31, 0x32, 0x5D,
+	0x7C, 0x5B, 0x33, 0x35, 0x5D, 0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x5B, 0x34, 0x39,
+	0x5D, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x7C, 0x36, 0x5B, 0x32, 0x2D, 0x39, 0x5D,
+	0x7C, 0x37, 0x5B, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x38,
+	0x5D, 0x29, 0x7C, 0x32, 0x36, 0x39, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x38, 0x33,
+	0x35, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x5D, 0x31, 0x7C, 0x32,
+	0x32, 0x7C, 0x33, 0x33, 0x7C, 0x35, 0x35, 0x7C, 0x37, 0x37, 0x7C, 0x38, 0x38,
+	0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xCC,
+	0x01, 0x0A, 0x11, 0x12, 0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32,
+	0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22, 0x1C, 0x12, 0x13, 0x31, 0x28,
+	0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x34, 0x36, 0x38, 0x5D, 0x7C, 0x36, 0x30, 0x29,
+	0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x53, 0x43, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39,
+	0x39, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x5C, 0x12, 0x55, 0x31, 0x28, 0x3F, 0x3A,
+	0x5B, 0x30, 0x36, 0x5D, 0x5C, 0x64, 0x7C, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x34,
+	0x36, 0x2D, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x7C, 0x33,
+	0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x35,
+	0x5B, 0x31, 0x35, 0x5D, 0x7C, 0x37, 0x5B, 0x31, 0x32, 0x34, 0x2D, 0x36, 0x5D,
+	0x7C, 0x38, 0x5B, 0x31, 0x35, 0x38, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x31, 0x35,
+	0x5D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x36, 0x5C, 0x64, 0x5C, 0x64, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5E, 0x0A,
+	0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12,
+	0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x44,
+	0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39,
+	0xEA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x9F, 0x03, 0x0A, 0x19, 0x12, 0x0F, 0x5B, 0x31, 0x2D, 0x33,
+	0x37, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x2B, 0x12, 0x24, 0x31, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x7C, 0x36, 0x5C, 0x64, 0x29,
+	0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x34, 0x31, 0x34, 0x7C, 0x37, 0x37, 0x29, 0x7C,
+	0x39, 0x30, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A,
+	0x1E, 0x12, 0x11, 0x31, 0x31, 0x38, 0x31, 0x31, 0x5B, 0x38, 0x39, 0x5D, 0x7C,
+	0x37, 0x32, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x37, 0x32, 0x30, 0x30,
+	0x30, 0x48, 0x05, 0x48, 0x06, 0x4A, 0x02, 0x53, 0x45, 0xDA, 0x01, 0x14, 0x12,
+	0x09, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x30, 0x30, 0x30, 0x30, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x48, 0x03, 0x48, 0x05, 0xEA, 0x01, 0xC8, 0x01, 0x12, 0xC0, 0x01,
+	0x31, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x35, 0x5D, 0x7C, 0x33, 0x31, 0x33,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x30, 0x36, 0x5D, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x37, 0x5D, 0x7C, 0x32, 0x33, 0x29, 0x29,
+	0x7C, 0x37, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A,
+	0x32, 0x5B, 0x30, 0x32, 0x33, 0x35, 0x38, 0x5D, 0x7C, 0x33, 0x33, 0x7C, 0x34,
+	0x5B, 0x30, 0x31, 0x5D, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x5B, 0x31, 0x2D, 0x34,
+	0x5D, 0x29, 0x7C, 0x33, 0x32, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x38, 0x28, 0x3F,
+	0x3A, 0x32, 0x32, 0x7C, 0x38, 0x38, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30,
+	0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x35, 0x31, 0x29, 0x30, 0x7C, 0x31, 0x32,
+	0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x28, 0x3F, 0x3A, 0x34, 0x7C, 0x38,
+	0x5B, 0x30, 0x32, 0x2D, 0x34, 0x36, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x37, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x39, 0x30, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x7C, 0x39, 0x30,
+	0x29, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64,
+	0x7C, 0x31, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x3F, 0x12, 0x36, 0x32, 0x28, 0x3F, 0x3A, 0x32,
+	0x5B, 0x30, 0x32, 0x33, 0x35, 0x38, 0x5D, 0x7C, 0x33, 0x33, 0x7C, 0x34, 0x5B,
+	0x30, 0x31, 0x5D, 0x7C, 0x35, 0x30, 0x7C, 0x36, 0x5B, 0x31, 0x2D, 0x34, 0x5D,
+	0x29, 0x7C, 0x33, 0x32, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x38, 0x28, 0x3F, 0x3A,
+	0x32, 0x32, 0x7C, 0x38, 0x38, 0x29, 0x7C, 0x39, 0x31, 0x32, 0x32, 0x03, 0x32,
+	0x32, 0x30, 0x48, 0x03, 0x0A, 0xBC, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31,
+	0x37, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x22, 0x10, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x33, 0x35, 0x39,
+	0x5D, 0x32, 0x03, 0x39, 0x39, 0x33, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x47, 0xDA,
+	0x01, 0x10, 0x12, 0x07, 0x39, 0x39, 0x5B, 0x33, 0x35, 0x39, 0x5D, 0x32, 0x03,
+	0x39, 0x39, 0x33, 0x48, 0x03, 0xEA, 0x01, 0x51, 0x12, 0x4A, 0x31, 0x28, 0x3F,
+	0x3A, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x31, 0x33, 0x36, 0x38, 0x5D, 0x5C, 0x64,
+	0x7C, 0x34, 0x34, 0x29, 0x5C, 0x64, 0x7C, 0x5B, 0x35, 0x37, 0x5D, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31,
+	0x2D, 0x39, 0x5D, 0x7C, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x29, 0x29,
+	0x7C, 0x37, 0x37, 0x32, 0x32, 0x32, 0x7C, 0x39, 0x39, 0x5B, 0x30, 0x32, 0x2D,
+	0x39, 0x5D, 0x7C, 0x31, 0x30, 0x30, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x96, 0x01, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x32, 0x39, 0x5D, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22,
+	0x13, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x48, 0xDA, 0x01,
+	0x13, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x25, 0x12, 0x1E, 0x31,
+	0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x7C, 0x32, 0x36, 0x5B, 0x30, 0x31,
+	0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF9, 0x01,
+	0x0A, 0x12, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x26, 0x12, 0x1F, 0x31, 0x31,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x36, 0x5C, 0x64, 0x29, 0x5C,
+	0x64, 0x5C, 0x64, 0x7C, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x38, 0x5C, 0x64, 0x5C,
+	0x64, 0x3F, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x49, 0xDA,
+	0x01, 0x0F, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x32, 0x33, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x7B, 0x12, 0x74, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x5B, 0x31, 0x34, 0x36, 0x5D, 0x7C, 0x5B,
+	0x32, 0x33, 0x5D, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38, 0x28,
+	0x3F, 0x3A, 0x5B, 0x30, 0x38, 0x5D, 0x7C, 0x39, 0x39, 0x29, 0x29, 0x7C, 0x39,
+	0x28, 0x3F, 0x3A, 0x30, 0x35, 0x39, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B,
+	0x31, 0x32, 0x5D, 0x7C, 0x31, 0x36, 0x29, 0x7C, 0x35, 0x7C, 0x37, 0x30, 0x7C,
+	0x38, 0x37, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x5B, 0x31, 0x34,
+	0x39, 0x5D, 0x29, 0x29, 0x29, 0x7C, 0x31, 0x39, 0x28, 0x3F, 0x3A, 0x30, 0x38,
+	0x7C, 0x38, 0x31, 0x29, 0x5B, 0x30, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x85, 0x01, 0x0A, 0x18, 0x12, 0x12, 0x5B, 0x30, 0x31, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29,
+	0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30,
+	0x32, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53,
+	0x4A, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x5D,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x16, 0x12, 0x0F, 0x30,
+	0x34, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33,
+	0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xBD, 0x01, 0x0A, 0x12,
+	0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x24, 0x12, 0x19, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29,
+	0x7C, 0x35, 0x5B, 0x30, 0x35, 0x38, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x4B, 0xDA, 0x01, 0x17, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x35, 0x5B, 0x30, 0x35, 0x38, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x39, 0x12, 0x32,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F,
+	0x3A, 0x30, 0x30, 0x30, 0x7C, 0x31, 0x31, 0x31, 0x29, 0x7C, 0x38, 0x5B, 0x30,
+	0x2D, 0x38, 0x5D, 0x29, 0x7C, 0x5B, 0x32, 0x34, 0x38, 0x5D, 0x5C, 0x64, 0x7B,
+	0x33, 0x7D, 0x7C, 0x35, 0x5B, 0x30, 0x35, 0x38, 0x39, 0x5D, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x94, 0x01, 0x0A, 0x19, 0x12, 0x13, 0x5B,
+	0x30, 0x36, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64,
+	0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22, 0x13, 0x12, 0x0A,
+	0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x39, 0x32, 0x03, 0x30,
+	0x31, 0x39, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x4C, 0xDA, 0x01, 0x13, 0x12, 0x0A,
+	0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x39, 0x39, 0x29, 0x39, 0x32, 0x03, 0x30,
+	0x31, 0x39, 0x48, 0x03, 0xEA, 0x01, 0x17, 0x12, 0x10, 0x28, 0x3F, 0x3A, 0x30,
+	0x31, 0x7C, 0x39, 0x39, 0x29, 0x39, 0x7C, 0x36, 0x30, 0x34, 0x30, 0x30, 0x32,
+	0x03, 0x30, 0x31, 0x39, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x36, 0x30, 0x34,
+	0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x36, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05,
+	0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03,
+	0x31, 0x31, 0x33, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x4D, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31,
+	0x31, 0x5B, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x33, 0xEA, 0x01,
+	0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03, 0x31,
+	0x31, 0x33, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF8, 0x01, 0x0A, 0x17, 0x12, 0x0B, 0x5B, 0x31,
+	0x32, 0x5D, 0x5C, 0x64, 0x7B, 0x31, 0x2C, 0x35, 0x7D, 0x48, 0x02, 0x48, 0x03,
+	0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x2A, 0x12, 0x1C, 0x31, 0x28, 0x3F,
+	0x3A, 0x35, 0x31, 0x35, 0x7C, 0x5B, 0x37, 0x38, 0x5D, 0x29, 0x7C, 0x32, 0x28,
+	0x3F, 0x3A, 0x30, 0x30, 0x7C, 0x31, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32,
+	0x02, 0x31, 0x37, 0x48, 0x02, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x2A, 0x23,
+	0x12, 0x16, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x32, 0x34, 0x36, 0x5D, 0x7C,
+	0x5B, 0x34, 0x36, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05,
+	0x32, 0x34, 0x30, 0x30, 0x30, 0x48, 0x05, 0x48, 0x06, 0x4A, 0x02, 0x53, 0x4E,
+	0xDA, 0x01, 0x0D, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31,
+	0x37, 0x48, 0x02, 0xEA, 0x01, 0x43, 0x12, 0x3D, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x36, 0x39, 0x5D, 0x7C, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x34, 0x36, 0x5D,
+	0x5C, 0x64, 0x7C, 0x35, 0x31, 0x29, 0x5C, 0x64, 0x29, 0x7C, 0x32, 0x28, 0x3F,
+	0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x36, 0x5D, 0x7C, 0x5B, 0x31, 0x32,
+	0x34, 0x36, 0x38, 0x5D, 0x29, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x31, 0x5B,
+	0x32, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x32, 0xF2, 0x01, 0x1B, 0x12, 0x0E,
+	0x32, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x7C, 0x32, 0x29, 0x5C, 0x64, 0x7B, 0x33,
+	0x7D, 0x32, 0x05, 0x32, 0x32, 0x30, 0x30, 0x30, 0x48, 0x05, 0x48, 0x06, 0xFA,
+	0x01, 0x13, 0x12, 0x09, 0x31, 0x5B, 0x34, 0x36, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x32, 0x04, 0x31, 0x34, 0x30, 0x30, 0x48, 0x04, 0x0A, 0x7F, 0x0A, 0x0E, 0x12,
+	0x0A, 0x5B, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x12, 0x12, 0x0B, 0x35, 0x35, 0x35, 0x7C, 0x38, 0x38, 0x38, 0x7C, 0x39,
+	0x39, 0x39, 0x32, 0x03, 0x35, 0x35, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x4F, 0xDA, 0x01,
+	0x12, 0x12, 0x0B, 0x35, 0x35, 0x35, 0x7C, 0x38, 0x38, 0x38, 0x7C, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x35, 0x35, 0x35, 0xEA, 0x01, 0x16, 0x12, 0x0F, 0x35, 0x35,
+	0x35, 0x7C, 0x37, 0x37, 0x37, 0x7C, 0x38, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x39,
+	0x32, 0x03, 0x35, 0x35, 0x35, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6C, 0x0A, 0x0E, 0x12, 0x08,
+	0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x22,
+	0x0C, 0x12, 0x03, 0x31, 0x31, 0x35, 0x32, 0x03, 0x31, 0x31, 0x35, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x53, 0x52, 0xDA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x35, 0x32,
+	0x03, 0x31, 0x31, 0x35, 0x48, 0x03, 0xEA, 0x01, 0x0F, 0x12, 0x08, 0x31, 0x5C,
+	0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03,
+	0x22, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x53, 0x53, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03,
+	0x39, 0x39, 0x39, 0xEA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03,
+	0x39, 0x39, 0x39, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C,
+	0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x54, 0xDA, 0x01, 0x0A, 0x12, 0x03,
+	0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x0A, 0x12, 0x03,
+	0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xC0, 0x01,
+	0x0A, 0x1D, 0x12, 0x15, 0x5B, 0x31, 0x34, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64,
+	0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x29, 0x3F, 0x48,
+	0x03, 0x48, 0x05, 0x48, 0x06, 0x22, 0x17, 0x12, 0x0C, 0x31, 0x31, 0x36, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31,
+	0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x56, 0xDA, 0x01, 0x0F, 0x12, 0x06,
+	0x39, 0x31, 0x5B, 0x31, 0x33, 0x5D, 0x32, 0x03, 0x39, 0x31, 0x31, 0x48, 0x03,
+	0xEA, 0x01, 0x3F, 0x12, 0x38, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A,
+	0x32, 0x7C, 0x36, 0x31, 0x31, 0x31, 0x29, 0x7C, 0x32, 0x5B, 0x31, 0x33, 0x36,
+	0x2D, 0x38, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x2D, 0x36, 0x5D, 0x7C, 0x39, 0x5B,
+	0x30, 0x35, 0x5D, 0x29, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x7C, 0x32, 0x39, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x30, 0x34, 0x5C, 0x64, 0x5C,
+	0x64, 0x32, 0x05, 0x34, 0x30, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x6C, 0x0A,
+	0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12,
+	0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x39, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03,
+	0x39, 0x31, 0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x58, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39,
+	0x31, 0x39, 0x32, 0x03, 0x39, 0x31, 0x39, 0xEA, 0x01, 0x11, 0x12, 0x0A, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x39, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31,
+	0x39, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C,
+	0x64, 0x48, 0x03, 0x22, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33,
+	0x5D, 0x32, 0x03, 0x31, 0x31, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x59, 0xDA, 0x01, 0x0E,
+	0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x32, 0x03, 0x31, 0x31,
+	0x30, 0xEA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x33, 0x5D,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05,
+	0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x39,
+	0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x53, 0x5A, 0xDA, 0x01, 0x0A,
+	0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xEA, 0x01, 0x0A,
+	0x12, 0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0x79, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C,
+	0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54, 0x43, 0xDA,
+	0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x39, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03,
+	0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x5F, 0x0A, 0x07, 0x12, 0x03, 0x31, 0x5C,
+	0x64, 0x48, 0x02, 0x22, 0x0B, 0x12, 0x05, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32,
+	0x02, 0x31, 0x37, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54, 0x44, 0xDA, 0x01, 0x0B, 0x12, 0x05, 0x31,
+	0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xEA, 0x01, 0x0B, 0x12, 0x05,
+	0x31, 0x5B, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x37, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x96,
+	0x01, 0x0A, 0x0E, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D,
+	0x48, 0x03, 0x48, 0x04, 0x22, 0x1A, 0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31,
+	0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x37, 0x5B, 0x31, 0x32, 0x37, 0x5D, 0x29, 0x32,
+	0x03, 0x31, 0x31, 0x37, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54, 0x47, 0xDA, 0x01, 0x1A,
+	0x12, 0x11, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x37, 0x38, 0x5D, 0x7C, 0x37,
+	0x5B, 0x31, 0x32, 0x37, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x37, 0x48, 0x03,
+	0xEA, 0x01, 0x1D, 0x12, 0x16, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x31, 0x7C,
+	0x31, 0x5B, 0x30, 0x37, 0x38, 0x5D, 0x7C, 0x37, 0x5B, 0x31, 0x32, 0x37, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xD9, 0x05, 0x0A, 0x0E,
+	0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x22, 0x5D, 0x12, 0x56, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x30, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x33, 0x5B, 0x33, 0x34,
+	0x37, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x36, 0x37, 0x5D, 0x7C, 0x39, 0x5B, 0x30,
+	0x32, 0x34, 0x36, 0x5D, 0x29, 0x7C, 0x35, 0x37, 0x38, 0x7C, 0x36, 0x28, 0x3F,
+	0x3A, 0x34, 0x34, 0x7C, 0x36, 0x5B, 0x37, 0x39, 0x5D, 0x7C, 0x38, 0x38, 0x7C,
+	0x39, 0x5B, 0x31, 0x36, 0x5D, 0x29, 0x7C, 0x38, 0x38, 0x5C, 0x64, 0x7C, 0x39,
+	0x5B, 0x31, 0x39, 0x5D, 0x29, 0x7C, 0x31, 0x5B, 0x31, 0x35, 0x5D, 0x35, 0x35,
+	0x32, 0x03, 0x31, 0x39, 0x31, 0x2A, 0x25, 0x12, 0x1B, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x31, 0x33, 0x7C, 0x32, 0x5B, 0x32, 0x33, 0x5D, 0x5C, 0x64, 0x7C, 0x35,
+	0x28, 0x3F, 0x3A, 0x30, 0x39, 0x7C, 0x35, 0x36, 0x29, 0x29, 0x32, 0x04, 0x31,
+	0x31, 0x31, 0x33, 0x48, 0x04, 0x4A, 0x02, 0x54, 0x48, 0xDA, 0x01, 0x15, 0x12,
+	0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x36, 0x36, 0x39, 0x7C, 0x39, 0x5B, 0x31, 0x39,
+	0x5D, 0x29, 0x32, 0x03, 0x31, 0x39, 0x31, 0xEA, 0x01, 0xBF, 0x02, 0x12, 0xB7,
+	0x02, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x5D, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x31, 0x5B, 0x31, 0x2D,
+	0x33, 0x35, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x33, 0x35, 0x38, 0x5D, 0x7C, 0x33,
+	0x5B, 0x30, 0x33, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x32, 0x2D,
+	0x34, 0x38, 0x39, 0x5D, 0x7C, 0x35, 0x5B, 0x30, 0x34, 0x2D, 0x39, 0x5D, 0x7C,
+	0x36, 0x5B, 0x30, 0x34, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x33,
+	0x2D, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x32, 0x37, 0x2D, 0x39, 0x5D, 0x7C,
+	0x39, 0x5B, 0x30, 0x32, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A,
+	0x32, 0x32, 0x7C, 0x33, 0x5B, 0x38, 0x39, 0x5D, 0x7C, 0x36, 0x36, 0x29, 0x7C,
+	0x33, 0x28, 0x3F, 0x3A, 0x31, 0x38, 0x7C, 0x32, 0x5B, 0x32, 0x33, 0x5D, 0x7C,
+	0x33, 0x5B, 0x30, 0x31, 0x33, 0x5D, 0x7C, 0x35, 0x5B, 0x35, 0x36, 0x5D, 0x7C,
+	0x36, 0x5B, 0x34, 0x35, 0x5D, 0x7C, 0x37, 0x33, 0x29, 0x7C, 0x34, 0x37, 0x37,
+	0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x5C, 0x64, 0x7C, 0x34, 0x5B, 0x30, 0x2D,
+	0x33, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x38, 0x5D, 0x7C,
+	0x36, 0x5B, 0x30, 0x31, 0x36, 0x37, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x31, 0x32,
+	0x35, 0x36, 0x38, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x2D, 0x32, 0x34, 0x35, 0x38,
+	0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x31, 0x33, 0x35, 0x38, 0x39, 0x5D, 0x29,
+	0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x32, 0x39, 0x5D, 0x7C,
+	0x32, 0x5B, 0x30, 0x33, 0x5D, 0x7C, 0x34, 0x5B, 0x33, 0x2D, 0x36, 0x5D, 0x7C,
+	0x36, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x32, 0x35, 0x37,
+	0x2D, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x31, 0x35, 0x38, 0x5D, 0x7C, 0x39,
+	0x5B, 0x30, 0x31, 0x34, 0x2D, 0x39, 0x5D, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A,
+	0x5B, 0x31, 0x34, 0x5D, 0x39, 0x7C, 0x37, 0x5B, 0x32, 0x37, 0x5D, 0x7C, 0x39,
+	0x30, 0x29, 0x7C, 0x38, 0x38, 0x38, 0x7C, 0x39, 0x5B, 0x31, 0x39, 0x5D, 0x29,
+	0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0xCC, 0x01, 0x12, 0xC1, 0x01, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x33, 0x7C, 0x31, 0x5B, 0x31,
+	0x35, 0x5D, 0x7C, 0x32, 0x5B, 0x35, 0x38, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x35,
+	0x36, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x32, 0x2D, 0x34, 0x39, 0x5D, 0x7C, 0x35,
+	0x5B, 0x30, 0x34, 0x36, 0x2D, 0x39, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x33, 0x2D,
+	0x35, 0x38, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x29,
+	0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x2D, 0x38, 0x5D, 0x7C, 0x34,
+	0x5B, 0x30, 0x2D, 0x33, 0x37, 0x38, 0x5D, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x34,
+	0x37, 0x38, 0x5D, 0x7C, 0x37, 0x5B, 0x31, 0x35, 0x36, 0x5D, 0x29, 0x7C, 0x36,
+	0x28, 0x3F, 0x3A, 0x32, 0x30, 0x7C, 0x34, 0x5B, 0x33, 0x35, 0x36, 0x5D, 0x7C,
+	0x36, 0x5B, 0x31, 0x2D, 0x36, 0x38, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x35, 0x37,
+	0x2D, 0x39, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x31, 0x35, 0x5D, 0x7C, 0x39, 0x5B,
+	0x30, 0x34, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x29, 0x29, 0x7C, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x5B, 0x36, 0x38, 0x5D, 0x7C, 0x32, 0x36, 0x7C, 0x33, 0x5B, 0x31,
+	0x2D, 0x33, 0x35, 0x5D, 0x7C, 0x35, 0x5B, 0x36, 0x38, 0x39, 0x5D, 0x7C, 0x36,
+	0x30, 0x7C, 0x37, 0x5B, 0x31, 0x37, 0x5D, 0x29, 0x5C, 0x64, 0x32, 0x04, 0x31,
+	0x31, 0x30, 0x33, 0x48, 0x04, 0xFA, 0x01, 0x11, 0x12, 0x07, 0x31, 0x31, 0x34,
+	0x5B, 0x38, 0x39, 0x5D, 0x32, 0x04, 0x31, 0x31, 0x34, 0x38, 0x48, 0x04, 0x0A,
+	0x7F, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D,
+	0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54, 0x4A,
+	0xDA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D,
+	0x33, 0x5D, 0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0xEA, 0x01,
+	0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D,
+	0x7C, 0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x82,
+	0x01, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x0D, 0x12, 0x06, 0x31, 0x31, 0x5B, 0x32, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31,
+	0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x54, 0x4C, 0xDA, 0x01, 0x0D, 0x12, 0x06, 0x31, 0x31, 0x5B,
+	0x32, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x28, 0x12, 0x21,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x31, 0x5B, 0x32,
+	0x35, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x31, 0x33, 0x38, 0x5D, 0x7C, 0x37, 0x32,
+	0x7C, 0x39, 0x5B, 0x30, 0x37, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x64, 0x0A, 0x07, 0x12, 0x03, 0x30, 0x5C, 0x64, 0x48, 0x02, 0x22,
+	0x0D, 0x12, 0x07, 0x30, 0x5B, 0x31, 0x2D, 0x34, 0x39, 0x5D, 0x32, 0x02, 0x30,
+	0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x4A, 0x02, 0x54, 0x4D, 0xDA, 0x01, 0x0C, 0x12, 0x06, 0x30, 0x5B, 0x31,
+	0x2D, 0x33, 0x5D, 0x32, 0x02, 0x30, 0x31, 0xEA, 0x01, 0x0D, 0x12, 0x07, 0x30,
+	0x5B, 0x31, 0x2D, 0x34, 0x39, 0x5D, 0x32, 0x02, 0x30, 0x31, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0x6A, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x0E, 0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x37, 0x38, 0x5D, 0x32, 0x03, 0x31,
+	0x39, 0x30, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x54, 0x4E, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x39,
+	0x5B, 0x30, 0x37, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x30, 0xEA, 0x01, 0x0E,
+	0x12, 0x07, 0x31, 0x39, 0x5B, 0x30, 0x37, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x39,
+	0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x0A, 0x85, 0x01, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x17, 0x12, 0x10, 0x39, 0x28, 0x3F, 0x3A, 0x31,
+	0x31, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x33, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03,
+	0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54, 0x4F, 0xDA, 0x01, 0x17, 0x12, 0x10, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x33, 0x7C, 0x39,
+	0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x17, 0x12, 0x10, 0x39,
+	0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x32, 0x7C, 0x33, 0x33, 0x7C, 0x39,
+	0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF7, 0x04, 0x0A,
+	0x14, 0x12, 0x0C, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C,
+	0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x37, 0x12, 0x2E, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x32, 0x32, 0x7C, 0x33,
+	0x5B, 0x31, 0x32, 0x36, 0x5D, 0x7C, 0x34, 0x5B, 0x30, 0x34, 0x5D, 0x7C, 0x35,
+	0x5B, 0x31, 0x35, 0x2D, 0x39, 0x5D, 0x7C, 0x36, 0x5B, 0x31, 0x38, 0x5D, 0x7C,
+	0x37, 0x37, 0x7C, 0x38, 0x33, 0x29, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x54, 0x52, 0xDA, 0x01, 0x16, 0x12, 0x0D, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x5B, 0x30, 0x32, 0x5D, 0x7C, 0x35, 0x35, 0x29, 0x32, 0x03, 0x31, 0x31,
+	0x30, 0x48, 0x03, 0xEA, 0x01, 0xD3, 0x03, 0x12, 0xCB, 0x03, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x28, 0x3F, 0x3A, 0x5B, 0x30, 0x32, 0x2D, 0x37, 0x39, 0x5D, 0x7C,
+	0x38, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x30, 0x31, 0x38, 0x5D, 0x7C, 0x32, 0x5B,
+	0x30, 0x32, 0x34, 0x35, 0x5D, 0x7C, 0x33, 0x5B, 0x32, 0x2D, 0x34, 0x5D, 0x7C,
+	0x34, 0x32, 0x7C, 0x35, 0x5B, 0x30, 0x35, 0x38, 0x5D, 0x7C, 0x36, 0x5B, 0x30,
+	0x36, 0x5D, 0x7C, 0x37, 0x5B, 0x30, 0x37, 0x5D, 0x7C, 0x38, 0x5B, 0x30, 0x31,
+	0x33, 0x38, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x38, 0x39, 0x5D, 0x29, 0x29,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x33, 0x37, 0x7C, 0x5B, 0x35, 0x38, 0x5D, 0x36,
+	0x7C, 0x36, 0x35, 0x29, 0x7C, 0x34, 0x37, 0x31, 0x7C, 0x35, 0x28, 0x3F, 0x3A,
+	0x30, 0x37, 0x7C, 0x37, 0x38, 0x29, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x5B, 0x30,
+	0x32, 0x5D, 0x36, 0x7C, 0x39, 0x39, 0x29, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x36,
+	0x33, 0x7C, 0x39, 0x35, 0x29, 0x29, 0x7C, 0x32, 0x28, 0x3F, 0x3A, 0x30, 0x37,
+	0x37, 0x7C, 0x32, 0x36, 0x38, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x31, 0x37, 0x7C,
+	0x32, 0x33, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x37, 0x5B, 0x32, 0x36, 0x5D,
+	0x7C, 0x38, 0x32, 0x29, 0x7C, 0x36, 0x5B, 0x31, 0x34, 0x5D, 0x34, 0x7C, 0x38,
+	0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x33, 0x30, 0x7C, 0x38,
+	0x39, 0x29, 0x29, 0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x30,
+	0x35, 0x7C, 0x37, 0x32, 0x29, 0x7C, 0x33, 0x35, 0x33, 0x7C, 0x34, 0x28, 0x3F,
+	0x3A, 0x30, 0x36, 0x7C, 0x33, 0x30, 0x7C, 0x36, 0x34, 0x29, 0x7C, 0x35, 0x30,
+	0x32, 0x7C, 0x36, 0x37, 0x34, 0x7C, 0x37, 0x34, 0x37, 0x7C, 0x38, 0x35, 0x31,
+	0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x32, 0x39, 0x5D, 0x7C, 0x36, 0x30,
+	0x29, 0x29, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x32, 0x35,
+	0x7C, 0x33, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x5B, 0x34, 0x37, 0x5D, 0x32, 0x29,
+	0x7C, 0x33, 0x28, 0x3F, 0x3A, 0x33, 0x5B, 0x31, 0x33, 0x5D, 0x7C, 0x5B, 0x38,
+	0x39, 0x5D, 0x31, 0x29, 0x7C, 0x34, 0x33, 0x39, 0x7C, 0x35, 0x28, 0x3F, 0x3A,
+	0x34, 0x33, 0x7C, 0x35, 0x35, 0x29, 0x7C, 0x37, 0x31, 0x37, 0x7C, 0x38, 0x33,
+	0x32, 0x29, 0x7C, 0x35, 0x28, 0x3F, 0x3A, 0x31, 0x34, 0x35, 0x7C, 0x32, 0x39,
+	0x30, 0x7C, 0x5B, 0x34, 0x2D, 0x36, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x37,
+	0x37, 0x32, 0x7C, 0x38, 0x33, 0x33, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x5B, 0x30,
+	0x36, 0x5D, 0x31, 0x7C, 0x39, 0x32, 0x29, 0x29, 0x7C, 0x36, 0x28, 0x3F, 0x3A,
+	0x32, 0x33, 0x36, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x33, 0x39,
+	0x7C, 0x38, 0x5B, 0x35, 0x39, 0x5D, 0x29, 0x7C, 0x37, 0x36, 0x39, 0x29, 0x7C,
+	0x37, 0x38, 0x39, 0x30, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x36, 0x38, 0x38, 0x7C,
+	0x37, 0x28, 0x3F, 0x3A, 0x32, 0x38, 0x7C, 0x36, 0x35, 0x29, 0x7C, 0x38, 0x35,
+	0x5B, 0x30, 0x36, 0x5D, 0x29, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x35, 0x39,
+	0x7C, 0x32, 0x39, 0x30, 0x29, 0x7C, 0x31, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x5C,
+	0x64, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x16, 0x12, 0x0C, 0x28, 0x3F,
+	0x3A, 0x32, 0x38, 0x35, 0x7C, 0x35, 0x34, 0x32, 0x29, 0x30, 0x32, 0x04, 0x32,
+	0x38, 0x35, 0x30, 0x48, 0x04, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x75, 0x0A, 0x09, 0x12, 0x05, 0x39,
+	0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F,
+	0x3A, 0x38, 0x38, 0x7C, 0x39, 0x5B, 0x30, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x39,
+	0x38, 0x38, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x4A, 0x02, 0x54, 0x54, 0xDA, 0x01, 0x0D, 0x12, 0x06, 0x39, 0x39,
+	0x5B, 0x30, 0x39, 0x5D, 0x32, 0x03, 0x39, 0x39, 0x30, 0xEA, 0x01, 0x14, 0x12,
+	0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x38, 0x38, 0x7C, 0x39, 0x5B, 0x30, 0x39, 0x5D,
+	0x29, 0x32, 0x03, 0x39, 0x38, 0x38, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x67, 0x0A, 0x0C, 0x12,
+	0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A,
+	0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54,
+	0x56, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31,
+	0x31, 0xEA, 0x01, 0x10, 0x12, 0x09, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x7C, 0x39,
+	0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xEA, 0x01, 0x0A,
+	0x0E, 0x12, 0x08, 0x31, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x33, 0x7D, 0x48, 0x03,
+	0x48, 0x04, 0x22, 0x1C, 0x12, 0x15, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x38, 0x39,
+	0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x38, 0x31, 0x7C, 0x39, 0x32, 0x29, 0x5C,
+	0x64, 0x32, 0x03, 0x31, 0x31, 0x30, 0x2A, 0x0F, 0x12, 0x06, 0x31, 0x30, 0x5B,
+	0x35, 0x36, 0x5D, 0x32, 0x03, 0x31, 0x30, 0x35, 0x48, 0x03, 0x4A, 0x02, 0x54,
+	0x57, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x30, 0x32, 0x39, 0x5D,
+	0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0xEA, 0x01, 0x60, 0x12, 0x59, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x30, 0x34, 0x2D, 0x36, 0x5D, 0x7C, 0x31, 0x5B,
+	0x30, 0x32, 0x33, 0x37, 0x2D, 0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x33, 0x38, 0x39,
+	0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x35, 0x2D, 0x38, 0x5D, 0x7C, 0x37, 0x5B, 0x30,
+	0x37, 0x5D, 0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x30, 0x7C, 0x31, 0x31, 0x29, 0x7C,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x39, 0x7C, 0x32, 0x32, 0x7C, 0x35, 0x5B, 0x30,
+	0x35, 0x37, 0x5D, 0x7C, 0x36, 0x38, 0x7C, 0x38, 0x5B, 0x30, 0x35, 0x5D, 0x7C,
+	0x39, 0x5B, 0x31, 0x35, 0x36, 0x38, 0x39, 0x5D, 0x29, 0x29, 0x32, 0x03, 0x31,
+	0x30, 0x30, 0xF2, 0x01, 0x20, 0x12, 0x19, 0x31, 0x28, 0x3F, 0x3A, 0x36, 0x35,
+	0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x7C, 0x35, 0x30, 0x7C, 0x38,
+	0x35, 0x7C, 0x39, 0x38, 0x29, 0x29, 0x32, 0x03, 0x31, 0x36, 0x35, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0xAB, 0x01, 0x0A, 0x19, 0x12, 0x13, 0x5B, 0x31, 0x34, 0x39, 0x5D, 0x5C, 0x64,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48,
+	0x03, 0x48, 0x05, 0x22, 0x1F, 0x12, 0x16, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5B,
+	0x30, 0x2D, 0x37, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x30, 0x39, 0x5D, 0x29, 0x7C,
+	0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x54,
+	0x5A, 0xDA, 0x01, 0x16, 0x12, 0x0D, 0x31, 0x31, 0x5B, 0x30, 0x2D, 0x32, 0x34,
+	0x35, 0x5D, 0x7C, 0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x30, 0x48, 0x03,
+	0xEA, 0x01, 0x1F, 0x12, 0x18, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x5C, 0x64, 0x7C,
+	0x39, 0x5B, 0x30, 0x39, 0x5D, 0x29, 0x7C, 0x34, 0x36, 0x34, 0x30, 0x30, 0x7C,
+	0x39, 0x39, 0x39, 0x32, 0x03, 0x31, 0x31, 0x30, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x12, 0x12,
+	0x07, 0x34, 0x36, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x05, 0x34, 0x36, 0x34,
+	0x30, 0x30, 0x48, 0x05, 0x0A, 0x83, 0x02, 0x0A, 0x16, 0x12, 0x0C, 0x5B, 0x31,
+	0x38, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48,
+	0x04, 0x48, 0x05, 0x48, 0x06, 0x22, 0x24, 0x12, 0x19, 0x31, 0x28, 0x3F, 0x3A,
+	0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x32, 0x7C,
+	0x36, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x29, 0x29, 0x32, 0x03, 0x31, 0x30, 0x31,
+	0x48, 0x03, 0x48, 0x06, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x55, 0x41, 0xDA, 0x01, 0x17, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x31, 0x32,
+	0x29, 0x32, 0x03, 0x31, 0x30, 0x31, 0x48, 0x03, 0xEA, 0x01, 0x67, 0x12, 0x60,
+	0x31, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x34, 0x39, 0x5D, 0x7C, 0x31,
+	0x28, 0x3F, 0x3A, 0x32, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30, 0x30, 0x7C,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x32, 0x33, 0x29, 0x29, 0x7C, 0x38,
+	0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x5B, 0x32, 0x37,
+	0x38, 0x5D, 0x7C, 0x35, 0x5C, 0x64, 0x29, 0x5C, 0x64, 0x29, 0x7C, 0x5B, 0x38,
+	0x39, 0x5D, 0x30, 0x30, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x7C, 0x31, 0x35, 0x31,
+	0x7C, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x7C, 0x34, 0x5C, 0x64, 0x7C, 0x36,
+	0x29, 0x5C, 0x64, 0x5C, 0x64, 0x32, 0x03, 0x31, 0x30, 0x31, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x1F, 0x12, 0x13, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x38, 0x7C, 0x5B, 0x38, 0x39,
+	0x5D, 0x30, 0x30, 0x29, 0x5C, 0x64, 0x5C, 0x64, 0x3F, 0x32, 0x04, 0x31, 0x31,
+	0x38, 0x30, 0x48, 0x04, 0x48, 0x05, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05, 0x39,
+	0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0A, 0x12, 0x03, 0x39, 0x39, 0x39,
+	0x32, 0x03, 0x39, 0x39, 0x39, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x55, 0x47, 0xDA, 0x01, 0x0A, 0x12,
+	0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xEA, 0x01, 0x0A, 0x12,
+	0x03, 0x39, 0x39, 0x39, 0x32, 0x03, 0x39, 0x39, 0x39, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xF6,
+	0x04, 0x0A, 0x16, 0x12, 0x0C, 0x5B, 0x31, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x32, 0x2C, 0x35, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x48, 0x06, 0x22,
+	0x1E, 0x12, 0x15, 0x31, 0x31, 0x32, 0x7C, 0x36, 0x31, 0x31, 0x7C, 0x39, 0x28,
+	0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33, 0x33, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x84, 0x02, 0x12, 0xF8, 0x01, 0x32, 0x34,
+	0x32, 0x38, 0x30, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x38, 0x31, 0x7C, 0x39, 0x36,
+	0x38, 0x29, 0x33, 0x35, 0x7C, 0x34, 0x28, 0x3F, 0x3A, 0x33, 0x33, 0x35, 0x35,
+	0x7C, 0x37, 0x35, 0x35, 0x33, 0x7C, 0x38, 0x32, 0x32, 0x31, 0x29, 0x7C, 0x35,
+	0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x34, 0x38, 0x39, 0x7C, 0x39, 0x33, 0x34,
+	0x29, 0x32, 0x7C, 0x35, 0x39, 0x32, 0x38, 0x29, 0x7C, 0x37, 0x32, 0x30, 0x37,
+	0x38, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x32, 0x33, 0x7C, 0x39, 0x36, 0x30, 0x29,
+	0x34, 0x30, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x37, 0x36, 0x7C, 0x34, 0x31, 0x34,
+	0x29, 0x36, 0x33, 0x7C, 0x28, 0x3F, 0x3A, 0x32, 0x28, 0x3F, 0x3A, 0x35, 0x32,
+	0x30, 0x7C, 0x37, 0x34, 0x34, 0x29, 0x7C, 0x37, 0x33, 0x39, 0x30, 0x7C, 0x39,
+	0x39, 0x36, 0x38, 0x29, 0x39, 0x7C, 0x28, 0x3F, 0x3A, 0x36, 0x39, 0x33, 0x7C,
+	0x37, 0x33, 0x32, 0x7C, 0x39, 0x37, 0x36, 0x29, 0x38, 0x38, 0x7C, 0x28, 0x3F,
+	0x3A, 0x33, 0x28, 0x3F, 0x3A, 0x35, 0x35, 0x36, 0x7C, 0x38, 0x32, 0x35, 0x29,
+	0x7C, 0x35, 0x32, 0x39, 0x34, 0x7C, 0x38, 0x36, 0x32, 0x33, 0x7C, 0x39, 0x37,
+	0x32, 0x39, 0x29, 0x34, 0x7C, 0x28, 0x3F, 0x3A, 0x33, 0x33, 0x37, 0x38, 0x7C,
+	0x34, 0x31, 0x33, 0x36, 0x7C, 0x37, 0x36, 0x34, 0x32, 0x7C, 0x38, 0x39, 0x36,
+	0x31, 0x7C, 0x39, 0x39, 0x37, 0x39, 0x29, 0x36, 0x7C, 0x28, 0x3F, 0x3A, 0x34,
+	0x28, 0x3F, 0x3A, 0x36, 0x28, 0x3F, 0x3A, 0x31, 0x35, 0x7C, 0x33, 0x32, 0x29,
+	0x7C, 0x38, 0x32, 0x37, 0x29, 0x7C, 0x28, 0x3F, 0x3A, 0x35, 0x39, 0x31, 0x7C,
+	0x37, 0x32, 0x30, 0x29, 0x38, 0x7C, 0x39, 0x35, 0x32, 0x39, 0x29, 0x37, 0x32,
+	0x05, 0x32, 0x34, 0x32, 0x38, 0x30, 0x48, 0x05, 0x4A, 0x02, 0x55, 0x53, 0xDA,
+	0x01, 0x10, 0x12, 0x07, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03,
+	0x31, 0x31, 0x32, 0x48, 0x03, 0xEA, 0x01, 0x4B, 0x12, 0x44, 0x31, 0x31, 0x28,
+	0x3F, 0x3A, 0x32, 0x7C, 0x35, 0x5B, 0x31, 0x2D, 0x34, 0x37, 0x5D, 0x7C, 0x5B,
+	0x36, 0x38, 0x5D, 0x5C, 0x64, 0x7C, 0x37, 0x5B, 0x30, 0x2D, 0x35, 0x37, 0x5D,
+	0x7C, 0x39, 0x38, 0x29, 0x7C, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B,
+	0x33, 0x2C, 0x35, 0x7D, 0x7C, 0x5B, 0x32, 0x2D, 0x38, 0x5D, 0x31, 0x31, 0x7C,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x33, 0x33, 0x7C, 0x38, 0x38, 0x29,
+	0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0xA3, 0x01, 0x12, 0x95, 0x01, 0x32,
+	0x28, 0x3F, 0x3A, 0x33, 0x33, 0x33, 0x33, 0x7C, 0x28, 0x3F, 0x3A, 0x34, 0x32,
+	0x32, 0x34, 0x7C, 0x37, 0x35, 0x36, 0x32, 0x7C, 0x39, 0x30, 0x30, 0x29, 0x32,
+	0x7C, 0x35, 0x36, 0x34, 0x34, 0x37, 0x7C, 0x36, 0x36, 0x38, 0x38, 0x29, 0x7C,
+	0x33, 0x28, 0x3F, 0x3A, 0x31, 0x30, 0x31, 0x30, 0x7C, 0x32, 0x36, 0x36, 0x35,
+	0x7C, 0x37, 0x34, 0x30, 0x34, 0x29, 0x7C, 0x34, 0x30, 0x34, 0x30, 0x34, 0x7C,
+	0x35, 0x36, 0x30, 0x35, 0x36, 0x30, 0x7C, 0x36, 0x28, 0x3F, 0x3A, 0x30, 0x30,
+	0x36, 0x30, 0x7C, 0x32, 0x32, 0x36, 0x33, 0x39, 0x7C, 0x35, 0x32, 0x34, 0x36,
+	0x7C, 0x37, 0x36, 0x32, 0x32, 0x29, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x37,
+	0x30, 0x31, 0x7C, 0x33, 0x38, 0x32, 0x32, 0x7C, 0x34, 0x36, 0x36, 0x36, 0x29,
+	0x7C, 0x38, 0x28, 0x3F, 0x3A, 0x28, 0x3F, 0x3A, 0x33, 0x38, 0x32, 0x35, 0x7C,
+	0x37, 0x32, 0x32, 0x36, 0x29, 0x35, 0x7C, 0x34, 0x38, 0x31, 0x36, 0x29, 0x7C,
+	0x39, 0x39, 0x30, 0x39, 0x39, 0x32, 0x05, 0x32, 0x33, 0x33, 0x33, 0x33, 0x48,
+	0x05, 0x48, 0x06, 0xFA, 0x01, 0x28, 0x12, 0x1B, 0x33, 0x33, 0x36, 0x5C, 0x64,
+	0x5C, 0x64, 0x7C, 0x5B, 0x32, 0x2D, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D,
+	0x7C, 0x5B, 0x32, 0x33, 0x35, 0x36, 0x5D, 0x31, 0x31, 0x32, 0x03, 0x32, 0x31,
+	0x31, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x0A, 0xA1, 0x01, 0x0A, 0x13, 0x12,
+	0x0B, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48,
+	0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x10, 0x12, 0x07, 0x31, 0x32, 0x38, 0x7C,
+	0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x32, 0x38, 0x48, 0x03, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x55,
+	0x59, 0xDA, 0x01, 0x10, 0x12, 0x07, 0x31, 0x32, 0x38, 0x7C, 0x39, 0x31, 0x31,
+	0x32, 0x03, 0x31, 0x32, 0x38, 0x48, 0x03, 0xEA, 0x01, 0x33, 0x12, 0x2C, 0x31,
+	0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x34, 0x2D, 0x39, 0x5D, 0x7C, 0x31, 0x5B, 0x32,
+	0x33, 0x36, 0x38, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D, 0x33, 0x35, 0x36, 0x38,
+	0x5D, 0x7C, 0x37, 0x38, 0x37, 0x7C, 0x39, 0x39, 0x37, 0x5C, 0x64, 0x3F, 0x29,
+	0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x30, 0x34, 0xF2, 0x01, 0x0F, 0x12,
+	0x05, 0x31, 0x37, 0x38, 0x5C, 0x64, 0x32, 0x04, 0x31, 0x37, 0x38, 0x30, 0x48,
+	0x04, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xB9, 0x01, 0x0A, 0x1F, 0x12, 0x17, 0x5B, 0x30, 0x34, 0x5D,
+	0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x28, 0x3F, 0x3A, 0x5C, 0x64, 0x7B,
+	0x32, 0x7D, 0x29, 0x3F, 0x29, 0x3F, 0x48, 0x02, 0x48, 0x03, 0x48, 0x05, 0x22,
+	0x1E, 0x12, 0x14, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D,
+	0x7C, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x35, 0x30, 0x29, 0x32, 0x02, 0x30,
+	0x31, 0x48, 0x02, 0x48, 0x03, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x55, 0x5A, 0xDA, 0x01, 0x1E, 0x12,
+	0x14, 0x30, 0x28, 0x3F, 0x3A, 0x30, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x5B,
+	0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x35, 0x30, 0x29, 0x32, 0x02, 0x30, 0x31, 0x48,
+	0x02, 0x48, 0x03, 0xEA, 0x01, 0x20, 0x12, 0x1A, 0x30, 0x28, 0x3F, 0x3A, 0x30,
+	0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x5B, 0x31, 0x2D, 0x33, 0x5D, 0x7C, 0x35,
+	0x30, 0x29, 0x7C, 0x34, 0x35, 0x34, 0x30, 0x30, 0x32, 0x02, 0x30, 0x31, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x12, 0x12, 0x07, 0x34, 0x35, 0x34, 0x5C, 0x64, 0x5C, 0x64, 0x32,
+	0x05, 0x34, 0x35, 0x34, 0x30, 0x30, 0x48, 0x05, 0x0A, 0x6D, 0x0A, 0x09, 0x12,
+	0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0F, 0x12, 0x08, 0x31,
+	0x31, 0x5B, 0x32, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x56, 0x41, 0xDA, 0x01, 0x0F, 0x12, 0x08, 0x31, 0x31, 0x5B, 0x32, 0x33,
+	0x35, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x0F, 0x12, 0x08,
+	0x31, 0x31, 0x5B, 0x32, 0x33, 0x35, 0x38, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x79, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64,
+	0x48, 0x03, 0x22, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02,
+	0x56, 0x43, 0xDA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31,
+	0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x14, 0x12,
+	0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39,
+	0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x82, 0x01, 0x0A, 0x0C,
+	0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22,
+	0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37, 0x31, 0x29,
+	0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x56, 0x45,
+	0xDA, 0x01, 0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37,
+	0x31, 0x29, 0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01,
+	0x15, 0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x37, 0x31, 0x29,
+	0x7C, 0x39, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x79,
+	0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x14,
+	0x12, 0x0D, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39,
+	0x39, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x56, 0x47, 0xDA, 0x01,
+	0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x39, 0x29,
+	0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x14, 0x12, 0x0D, 0x39, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x38, 0x38, 0x7C, 0x39, 0x39, 0x29, 0x32, 0x03, 0x39,
+	0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6C, 0x0A, 0x09, 0x12, 0x05, 0x39, 0x5C, 0x64,
+	0x5C, 0x64, 0x48, 0x03, 0x22, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31,
+	0x31, 0x7C, 0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x56,
+	0x49, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x39, 0x31, 0x31, 0x32, 0x03, 0x39, 0x31,
+	0x31, 0xEA, 0x01, 0x11, 0x12, 0x0A, 0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C,
+	0x38, 0x38, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6A, 0x0A,
+	0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0E, 0x12,
+	0x07, 0x31, 0x31, 0x5B, 0x33, 0x2D, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x33,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x56, 0x4E, 0xDA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x31, 0x5B, 0x33,
+	0x2D, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x33, 0xEA, 0x01, 0x0E, 0x12, 0x07,
+	0x31, 0x31, 0x5B, 0x33, 0x2D, 0x35, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x33, 0xF2,
+	0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0x0A, 0x5E, 0x0A, 0x09, 0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48,
+	0x03, 0x22, 0x0A, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01,
+	0x4A, 0x02, 0x56, 0x55, 0xDA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x0A, 0x12, 0x03, 0x31, 0x31, 0x32, 0x32,
+	0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x62, 0x0A, 0x07, 0x12, 0x03, 0x31,
+	0x5C, 0x64, 0x48, 0x02, 0x22, 0x0C, 0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38,
+	0x5D, 0x32, 0x02, 0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x57, 0x46, 0xDA, 0x01, 0x0C, 0x12,
+	0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01,
+	0x0C, 0x12, 0x06, 0x31, 0x5B, 0x35, 0x37, 0x38, 0x5D, 0x32, 0x02, 0x31, 0x35,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0xA0, 0x01, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D,
+	0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x16, 0x12, 0x0F, 0x39, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x39, 0x5B, 0x34, 0x2D, 0x36, 0x39, 0x5D, 0x29, 0x32,
+	0x03, 0x39, 0x31, 0x31, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x57, 0x53, 0xDA, 0x01, 0x16, 0x12, 0x0F,
+	0x39, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x39, 0x5B, 0x34, 0x2D, 0x36, 0x39,
+	0x5D, 0x29, 0x32, 0x03, 0x39, 0x31, 0x31, 0xEA, 0x01, 0x2E, 0x12, 0x27, 0x31,
+	0x28, 0x3F, 0x3A, 0x31, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x32, 0x5B, 0x30, 0x2D,
+	0x36, 0x5D, 0x7C, 0x5B, 0x33, 0x39, 0x5D, 0x30, 0x29, 0x7C, 0x39, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x39, 0x5B, 0x34, 0x2D, 0x37, 0x39, 0x5D, 0x29, 0x32,
+	0x03, 0x31, 0x31, 0x31, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0E, 0x12, 0x07, 0x31, 0x32, 0x5B,
+	0x30, 0x2D, 0x36, 0x5D, 0x32, 0x03, 0x31, 0x32, 0x30, 0x0A, 0x7F, 0x0A, 0x09,
+	0x12, 0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x15, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x58, 0x4B, 0xDA, 0x01, 0x15,
+	0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D,
+	0x34, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01, 0x15, 0x12, 0x0E,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x39, 0x5B, 0x32, 0x2D, 0x34, 0x5D,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0x6D, 0x0A, 0x09, 0x12,
+	0x05, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x0F, 0x12, 0x08, 0x31,
+	0x39, 0x5B, 0x31, 0x34, 0x35, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x31, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x59, 0x45, 0xDA, 0x01, 0x0F, 0x12, 0x08, 0x31, 0x39, 0x5B, 0x31, 0x34,
+	0x35, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x31, 0xEA, 0x01, 0x0F, 0x12, 0x08,
+	0x31, 0x39, 0x5B, 0x31, 0x34, 0x35, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x39, 0x31,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x01, 0x0A, 0x70, 0x0A, 0x0C, 0x12, 0x06, 0x31, 0x5C, 0x64, 0x5C, 0x64,
+	0x3F, 0x48, 0x02, 0x48, 0x03, 0x22, 0x0F, 0x12, 0x09, 0x31, 0x28, 0x3F, 0x3A,
+	0x31, 0x32, 0x7C, 0x35, 0x29, 0x32, 0x02, 0x31, 0x35, 0x2A, 0x0B, 0x48, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A, 0x02, 0x59, 0x54,
+	0xDA, 0x01, 0x0F, 0x12, 0x09, 0x31, 0x28, 0x3F, 0x3A, 0x31, 0x32, 0x7C, 0x35,
+	0x29, 0x32, 0x02, 0x31, 0x35, 0xEA, 0x01, 0x0F, 0x12, 0x09, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x32, 0x7C, 0x35, 0x29, 0x32, 0x02, 0x31, 0x35, 0xF2, 0x01, 0x0B,
+	0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A,
+	0x9E, 0x02, 0x0A, 0x14, 0x12, 0x0C, 0x5B, 0x31, 0x33, 0x34, 0x5D, 0x5C, 0x64,
+	0x7B, 0x32, 0x2C, 0x34, 0x7D, 0x48, 0x03, 0x48, 0x04, 0x48, 0x05, 0x22, 0x19,
+	0x12, 0x0E, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x31, 0x5C, 0x64, 0x5C, 0x64, 0x7C,
+	0x31, 0x32, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x05, 0x2A,
+	0x18, 0x12, 0x0D, 0x34, 0x31, 0x28, 0x3F, 0x3A, 0x33, 0x34, 0x38, 0x7C, 0x38,
+	0x35, 0x31, 0x29, 0x32, 0x05, 0x34, 0x31, 0x33, 0x34, 0x38, 0x48, 0x05, 0x4A,
+	0x02, 0x5A, 0x41, 0xDA, 0x01, 0x1E, 0x12, 0x13, 0x31, 0x28, 0x3F, 0x3A, 0x30,
+	0x31, 0x28, 0x3F, 0x3A, 0x31, 0x31, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x31, 0x32,
+	0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x48, 0x05, 0xEA, 0x01, 0x50,
+	0x12, 0x49, 0x31, 0x28, 0x3F, 0x3A, 0x30, 0x28, 0x3F, 0x3A, 0x31, 0x28, 0x3F,
+	0x3A, 0x31, 0x31, 0x7C, 0x37, 0x37, 0x29, 0x7C, 0x32, 0x30, 0x7C, 0x37, 0x29,
+	0x7C, 0x31, 0x5B, 0x31, 0x32, 0x5D, 0x7C, 0x37, 0x37, 0x28, 0x3F, 0x3A, 0x33,
+	0x5B, 0x32, 0x33, 0x37, 0x5D, 0x7C, 0x5B, 0x34, 0x35, 0x5D, 0x37, 0x7C, 0x36,
+	0x5B, 0x32, 0x37, 0x39, 0x5D, 0x7C, 0x39, 0x5B, 0x32, 0x36, 0x5D, 0x29, 0x29,
+	0x7C, 0x5B, 0x33, 0x34, 0x5D, 0x5C, 0x64, 0x7B, 0x34, 0x7D, 0x32, 0x03, 0x31,
+	0x30, 0x37, 0xF2, 0x01, 0x49, 0x12, 0x3E, 0x33, 0x28, 0x3F, 0x3A, 0x30, 0x37,
+	0x38, 0x5B, 0x32, 0x33, 0x5D, 0x7C, 0x37, 0x28, 0x3F, 0x3A, 0x30, 0x36, 0x34,
+	0x7C, 0x35, 0x36, 0x37, 0x29, 0x7C, 0x38, 0x31, 0x32, 0x36, 0x29, 0x7C, 0x34,
+	0x28, 0x3F, 0x3A, 0x33, 0x39, 0x34, 0x5B, 0x31, 0x36, 0x5D, 0x7C, 0x37, 0x37,
+	0x35, 0x31, 0x7C, 0x38, 0x38, 0x33, 0x37, 0x29, 0x7C, 0x34, 0x5B, 0x32, 0x33,
+	0x5D, 0x36, 0x39, 0x39, 0x32, 0x05, 0x33, 0x30, 0x37, 0x38, 0x32, 0x48, 0x05,
+	0xFA, 0x01, 0x0C, 0x12, 0x03, 0x31, 0x31, 0x31, 0x32, 0x03, 0x31, 0x31, 0x31,
+	0x48, 0x03, 0x0A, 0x79, 0x0A, 0x0C, 0x12, 0x08, 0x5B, 0x31, 0x39, 0x5D, 0x5C,
+	0x64, 0x5C, 0x64, 0x48, 0x03, 0x22, 0x12, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C,
+	0x39, 0x39, 0x5B, 0x31, 0x33, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0x2A,
+	0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x4A,
+	0x02, 0x5A, 0x4D, 0xDA, 0x01, 0x12, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C, 0x39,
+	0x39, 0x5B, 0x31, 0x33, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xEA, 0x01,
+	0x12, 0x12, 0x0B, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x39, 0x5B, 0x31, 0x33, 0x39,
+	0x5D, 0x32, 0x03, 0x31, 0x31, 0x32, 0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0xFA, 0x01, 0x0B, 0x48, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x0A, 0xE9, 0x01, 0x0A, 0x19,
+	0x12, 0x13, 0x5B, 0x31, 0x33, 0x39, 0x5D, 0x5C, 0x64, 0x5C, 0x64, 0x28, 0x3F,
+	0x3A, 0x5C, 0x64, 0x7B, 0x32, 0x7D, 0x29, 0x3F, 0x48, 0x03, 0x48, 0x05, 0x22,
+	0x23, 0x12, 0x1A, 0x31, 0x31, 0x32, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x35, 0x5B,
+	0x30, 0x32, 0x33, 0x5D, 0x7C, 0x36, 0x31, 0x7C, 0x39, 0x5B, 0x33, 0x2D, 0x35,
+	0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32, 0x48, 0x03, 0x2A, 0x1B, 0x12,
+	0x10, 0x33, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x35, 0x37, 0x2D, 0x39, 0x5D, 0x5C,
+	0x64, 0x7B, 0x33, 0x7D, 0x32, 0x05, 0x33, 0x30, 0x30, 0x30, 0x30, 0x48, 0x05,
+	0x4A, 0x02, 0x5A, 0x57, 0xDA, 0x01, 0x15, 0x12, 0x0C, 0x31, 0x31, 0x32, 0x7C,
+	0x39, 0x39, 0x5B, 0x33, 0x2D, 0x35, 0x39, 0x5D, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0x48, 0x03, 0xEA, 0x01, 0x3C, 0x12, 0x35, 0x31, 0x31, 0x5B, 0x32, 0x34, 0x36,
+	0x39, 0x5D, 0x7C, 0x33, 0x5B, 0x30, 0x31, 0x33, 0x2D, 0x35, 0x37, 0x2D, 0x39,
+	0x5D, 0x5C, 0x64, 0x7B, 0x33, 0x7D, 0x7C, 0x39, 0x28, 0x3F, 0x3A, 0x35, 0x5B,
+	0x30, 0x32, 0x33, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x2D, 0x32, 0x35, 0x5D, 0x7C,
+	0x39, 0x5B, 0x33, 0x2D, 0x35, 0x39, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x32,
+	0xF2, 0x01, 0x0B, 0x48, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x01, 0xFA, 0x01, 0x20, 0x12, 0x17, 0x31, 0x31, 0x34, 0x7C, 0x39, 0x28, 0x3F,
+	0x3A, 0x35, 0x5B, 0x30, 0x32, 0x33, 0x5D, 0x7C, 0x36, 0x5B, 0x30, 0x2D, 0x32,
+	0x35, 0x5D, 0x29, 0x32, 0x03, 0x31, 0x31, 0x34, 0x48, 0x03,
+}
@@ -0,0 +1,414 @@
+package libphonenumber
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Cost categories of short numbers.
+type ShortNumberCost int
+
+const (
+	ShortNumberCost_TOLL_FREE ShortNumberCost = iota
+	ShortNumberCost_STANDARD_RATE
+	ShortNumberCost_PREMIUM_RATE
+	ShortNumberCost_UNKNOWN_COST
+)
+
+var (
+	// A mapping from a region code to the short number metadata for
+	// that region. Short number metadata describes the short codes,
+	// such as emergency numbers and SMS codes, that can be dialed within
+	// a region. It is kept apart from the regular metadata and loaded
+	// from shortMetaData on first use, so programs that never look at
+	// short numbers don't pay for decoding it.
+	regionToShortMetadataMap map[string]*PhoneMetadata
+	shortMetadataOnce        sync.Once
+
+	// In these countries, if extra digits are added to an emergency
+	// number, it no longer connects to the emergency service.
+	REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT = map[string]struct{}{
+		"BR": struct{}{},
+		"CL": struct{}{},
+		"NI": struct{}{},
+	}
+)
+
+func loadShortNumberMetadata() {
+	shortMetadata, err := unmarshalMetadataCollection(shortMetaData)
+	if err != nil {
+		// Compiled in like the metadata; see the panic in init.
+		panic(fmt.Sprintf("libphonenumber: invalid short number metadata: %v", err))
+	}
+	regionToShortMetadataMap = make(map[string]*PhoneMetadata)
+	for _, meta := range shortMetadata.GetMetadata() {
+		regionToShortMetadataMap[meta.GetId()] = meta
+	}
+}
+
+// Returns the short number metadata for the given region, or nil if
+// there is none.
+func getShortNumberMetadataForRegion(regionCode string) *PhoneMetadata {
+	shortMetadataOnce.Do(loadShortNumberMetadata)
+	return regionToShortMetadataMap[regionCode]
+}
+
+// Returns the set of regions that have short number metadata.
+func getSupportedShortNumberRegions() map[string]struct{} {
+	shortMetadataOnce.Do(loadShortNumberMetadata)
+	regions := make(map[string]struct{}, len(regionToShortMetadataMap))
+	for regionCode := range regionToShortMetadataMap {
+		regions[regionCode] = struct{}{}
+	}
+	return regions
+}
+
+// Helper method to check that the country calling code of the number
+// matches the region it's being dialed from.
+func (u *PhoneNumberUtil) regionDialingFromMatchesNumber(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	for _, regionCode := range u.GetRegionCodesForCountryCode(int(number.GetCountryCode())) {
+		if regionCode == regionDialingFrom {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper method to check whether a short number's length is one of the
+// possible lengths of the given number description.
+func hasPossibleLength(desc *PhoneNumberDesc, length int) bool {
+	for _, possibleLength := range desc.GetPossibleLength() {
+		if int(possibleLength) == length {
+			return true
+		}
+	}
+	return false
+}
+
+// Check whether a short number is a possible number when dialed from
+// the given region. This provides a more lenient check than
+// IsValidShortNumberForRegion.
+func (u *PhoneNumberUtil) IsPossibleShortNumberForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	if !u.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return false
+	}
+	numberLength := len(GetNationalSignificantNumber(number))
+	return hasPossibleLength(phoneMetadata.GetGeneralDesc(), numberLength)
+}
+
+// Check whether a short number is a possible number. If a country
+// calling code is shared by multiple regions, this returns true if it's
+// possible in any of them. This provides a more lenient check than
+// IsValidShortNumber.
+func (u *PhoneNumberUtil) IsPossibleShortNumber(number *PhoneNumber) bool {
+	regionCodes := u.GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	shortNumberLength := len(GetNationalSignificantNumber(number))
+	for _, region := range regionCodes {
+		phoneMetadata := getShortNumberMetadataForRegion(region)
+		if phoneMetadata == nil {
+			continue
+		}
+		if hasPossibleLength(phoneMetadata.GetGeneralDesc(), shortNumberLength) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tests whether a short number matches a valid pattern in a region.
+// Note that this doesn't verify the number is actually in use, which is
+// impossible to tell by just looking at the number itself.
+func (u *PhoneNumberUtil) IsValidShortNumberForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	if !u.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return false
+	}
+	shortNumber := GetNationalSignificantNumber(number)
+	generalDesc := phoneMetadata.GetGeneralDesc()
+	if !u.matchesPossibleNumberAndNationalNumber(shortNumber, generalDesc) {
+		return false
+	}
+	shortNumberDesc := phoneMetadata.GetShortCode()
+	return u.matchesPossibleNumberAndNationalNumber(shortNumber, shortNumberDesc)
+}
+
+// Tests whether a short number matches a valid pattern. If a country
+// calling code is shared by multiple regions, this returns true if it's
+// valid in any of them. Note that this doesn't verify the number is
+// actually in use, which is impossible to tell by just looking at the
+// number itself. See IsValidShortNumberForRegion for details.
+func (u *PhoneNumberUtil) IsValidShortNumber(number *PhoneNumber) bool {
+	regionCodes := u.GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	regionCode := u.getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	if len(regionCodes) > 1 && len(regionCode) > 0 {
+		// If a matching region had been found for the phone number from
+		// among two or more regions, then we have already implicitly
+		// verified its validity for that region.
+		return true
+	}
+	return u.IsValidShortNumberForRegion(number, regionCode)
+}
+
+// Gets the expected cost category of a short number when dialed from a
+// region (however, nothing is implied about its validity). If it is
+// important that the number is valid, then its validity must first be
+// checked using IsValidShortNumberForRegion. Note that emergency numbers
+// are always considered toll-free. Returns ShortNumberCost_UNKNOWN_COST
+// if the number is unknown or the region dialing from doesn't match the
+// country calling code of the number.
+func (u *PhoneNumberUtil) GetExpectedCostForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) ShortNumberCost {
+
+	if !u.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return ShortNumberCost_UNKNOWN_COST
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return ShortNumberCost_UNKNOWN_COST
+	}
+	shortNumber := GetNationalSignificantNumber(number)
+
+	// The possible lengths are not present for a particular sub-type if
+	// they match the general description; for this reason, we check the
+	// possible lengths against the general description first to allow
+	// an early exit if possible.
+	if !hasPossibleLength(phoneMetadata.GetGeneralDesc(), len(shortNumber)) {
+		return ShortNumberCost_UNKNOWN_COST
+	}
+
+	// The cost categories are tested in order of decreasing expense,
+	// since if for some reason the patterns overlap the most expensive
+	// matching cost category should be returned.
+	if u.matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetPremiumRate()) {
+		return ShortNumberCost_PREMIUM_RATE
+	}
+	if u.matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetStandardRate()) {
+		return ShortNumberCost_STANDARD_RATE
+	}
+	if u.matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetTollFree()) {
+		return ShortNumberCost_TOLL_FREE
+	}
+	if u.IsEmergencyNumber(shortNumber, regionDialingFrom) {
+		// Emergency numbers are implicitly toll-free.
+		return ShortNumberCost_TOLL_FREE
+	}
+	return ShortNumberCost_UNKNOWN_COST
+}
+
+// Gets the expected cost category of a short number (however, nothing is
+// implied about its validity). If the country calling code is unique to
+// a region, this method behaves exactly the same as
+// GetExpectedCostForRegion. However, if the country calling code is
+// shared by multiple regions, then it returns the highest cost in the
+// sequence ShortNumberCost_PREMIUM_RATE, ShortNumberCost_UNKNOWN_COST,
+// ShortNumberCost_STANDARD_RATE, ShortNumberCost_TOLL_FREE. The reason
+// for the position of ShortNumberCost_UNKNOWN_COST in this order is that
+// if a number is ShortNumberCost_UNKNOWN_COST in one region but
+// ShortNumberCost_STANDARD_RATE or ShortNumberCost_TOLL_FREE in another,
+// its expected cost cannot be estimated as one of the latter since it
+// might be a ShortNumberCost_PREMIUM_RATE number.
+//
+// For example, if a number is ShortNumberCost_STANDARD_RATE in the US,
+// but ShortNumberCost_TOLL_FREE in Canada, the expected cost returned
+// by this method will be ShortNumberCost_STANDARD_RATE, since the NANPA
+// countries share the same country calling code.
+func (u *PhoneNumberUtil) GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	regionCodes := u.GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	if len(regionCodes) == 0 {
+		return ShortNumberCost_UNKNOWN_COST
+	}
+	if len(regionCodes) == 1 {
+		return u.GetExpectedCostForRegion(number, regionCodes[0])
+	}
+	cost := ShortNumberCost_TOLL_FREE
+	for _, regionCode := range regionCodes {
+		costForRegion := u.GetExpectedCostForRegion(number, regionCode)
+		switch costForRegion {
+		case ShortNumberCost_PREMIUM_RATE:
+			return ShortNumberCost_PREMIUM_RATE
+		case ShortNumberCost_UNKNOWN_COST:
+			cost = ShortNumberCost_UNKNOWN_COST
+		case ShortNumberCost_STANDARD_RATE:
+			if cost != ShortNumberCost_UNKNOWN_COST {
+				cost = ShortNumberCost_STANDARD_RATE
+			}
+		case ShortNumberCost_TOLL_FREE:
+			// Do nothing.
+		}
+	}
+	return cost
+}
+
+// Helper method to get the region code for a given phone number, from a
+// list of possible region codes. If the list contains more than one
+// region, the first region for which the number is valid is returned.
+func (u *PhoneNumberUtil) getRegionCodeForShortNumberFromRegionList(
+	number *PhoneNumber,
+	regionCodes []string) string {
+
+	if len(regionCodes) == 0 {
+		return ""
+	} else if len(regionCodes) == 1 {
+		return regionCodes[0]
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	for _, regionCode := range regionCodes {
+		phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+		if phoneMetadata != nil &&
+			u.matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetShortCode()) {
+			// The number is valid for this region.
+			return regionCode
+		}
+	}
+	return ""
+}
+
+// Returns true if the given number, exactly as dialed, might be used to
+// connect to an emergency service in the given region.
+//
+// This method accepts a string, rather than a PhoneNumber, because it
+// needs to distinguish cases such as "+1 911" and "911", where the
+// former may not connect to an emergency service in all cases but the
+// latter would. This method takes into account cases where the number
+// might contain formatting, or might have additional digits appended
+// (when it is okay to do that in the specified region).
+func (u *PhoneNumberUtil) ConnectsToEmergencyNumber(number, regionCode string) bool {
+	return u.matchesEmergencyNumberHelper(number, regionCode, true /* allows prefix match */)
+}
+
+// Returns true if the given number exactly matches an emergency service
+// number in the given region.
+//
+// This method takes into account cases where the number might contain
+// formatting, but doesn't allow additional digits to be appended. Note
+// that IsEmergencyNumber(number, region) implies
+// ConnectsToEmergencyNumber(number, region).
+func (u *PhoneNumberUtil) IsEmergencyNumber(number, regionCode string) bool {
+	return u.matchesEmergencyNumberHelper(number, regionCode, false /* doesn't allow prefix match */)
+}
+
+func (u *PhoneNumberUtil) matchesEmergencyNumberHelper(
+	number, regionCode string,
+	allowPrefixMatch bool) bool {
+
+	possibleNumber := extractPossibleNumber(number)
+	if inds := PLUS_CHARS_PATTERN.FindStringIndex(possibleNumber); len(inds) > 0 && inds[0] == 0 {
+		// Returns false if the number starts with a plus sign. We don't
+		// believe dialing the country code before emergency numbers
+		// (e.g. +1911) works, but later, if that proves to work, we can
+		// add additional logic here to handle it.
+		return false
+	}
+	metadata := getShortNumberMetadataForRegion(regionCode)
+	if metadata == nil || metadata.GetEmergency() == nil {
+		return false
+	}
+	normalizedNumber := NormalizeDigitsOnly(possibleNumber)
+	_, mustBeExact := REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT[regionCode]
+	allowPrefixMatchForRegion := allowPrefixMatch && !mustBeExact
+	return u.matchNationalNumber(
+		normalizedNumber, metadata.GetEmergency(), allowPrefixMatchForRegion)
+}
+
+// Given a valid short number, determines whether it is carrier-specific
+// (however, nothing is implied about its validity). Carrier-specific
+// numbers may connect to a different end-point, or not connect at all,
+// depending on the user's carrier. If it is important that the number is
+// valid, then its validity must first be checked using
+// IsValidShortNumber or IsValidShortNumberForRegion.
+func (u *PhoneNumberUtil) IsCarrierSpecific(number *PhoneNumber) bool {
+	regionCodes := u.GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	regionCode := u.getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	return phoneMetadata != nil &&
+		u.matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is carrier-specific
+// when dialed from the given region (however, nothing is implied about
+// its validity). Returns false if the number doesn't match the region
+// provided.
+func (u *PhoneNumberUtil) IsCarrierSpecificForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	if !u.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		u.matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is an SMS service
+// (however, nothing is implied about its validity). An SMS service is
+// where the primary or only intended usage is to receive and/or send
+// text messages (SMSs). This includes MMS as MMS numbers downgrade to
+// SMS if the other party isn't MMS-capable. Returns false if the number
+// doesn't match the region provided.
+func (u *PhoneNumberUtil) IsSmsServiceForRegion(
+	number *PhoneNumber,
+	regionDialingFrom string) bool {
+
+	if !u.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		u.matchesPossibleNumberAndNationalNumber(
+			GetNationalSignificantNumber(number), phoneMetadata.GetSmsServices())
+}
+
+// Helper method to check whether a number has one of the possible
+// lengths of the given number description, if it lists any, and matches
+// its national number pattern.
+func (u *PhoneNumberUtil) matchesPossibleNumberAndNationalNumber(
+	number string,
+	numberDesc *PhoneNumberDesc) bool {
+
+	if len(numberDesc.GetPossibleLength()) > 0 &&
+		!hasPossibleLength(numberDesc, len(number)) {
+		return false
+	}
+	return u.matchNationalNumber(number, numberDesc, false)
+}
+
+// Returns whether the given national number (a string containing only
+// decimal digits) matches the national number pattern defined in the
+// given PhoneNumberDesc. If allowPrefixMatch is true, the number only
+// needs to start with a match of the pattern.
+func (u *PhoneNumberUtil) matchNationalNumber(
+	number string,
+	numberDesc *PhoneNumberDesc,
+	allowPrefixMatch bool) bool {
+
+	nationalNumberPattern := numberDesc.GetNationalNumberPattern()
+	// We don't want to consider it a prefix match when matching
+	// non-empty input against an empty pattern.
+	if len(nationalNumberPattern) == 0 {
+		return false
+	}
+	if allowPrefixMatch {
+		return u.regexForPrefix(nationalNumberPattern).MatchString(number)
+	}
+	return u.regexForMatch(nationalNumberPattern).MatchString(number)
+}
@@ -0,0 +1,253 @@
+package libphonenumber
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func shortNumber(countryCode int32, nationalNumber uint64) *PhoneNumber {
+	return &PhoneNumber{
+		CountryCode:    proto.Int32(countryCode),
+		NationalNumber: proto.Uint64(nationalNumber),
+	}
+}
+
+func TestIsPossibleShortNumber(t *testing.T) {
+	var tests = []struct {
+		num    *PhoneNumber
+		region string
+		exp    bool
+	}{
+		{num: shortNumber(1, 911), region: "US", exp: true},
+		{num: shortNumber(1, 24280), region: "US", exp: true},
+		{num: shortNumber(1, 91), region: "US", exp: false},
+		{num: shortNumber(1, 1234567), region: "US", exp: false},
+		{num: shortNumber(44, 999), region: "GB", exp: true},
+		// The region dialing from must match the country calling code.
+		{num: shortNumber(44, 999), region: "US", exp: false},
+	}
+	for i, test := range tests {
+		if got := IsPossibleShortNumberForRegion(test.num, test.region); got != test.exp {
+			t.Errorf("[test %d] IsPossibleShortNumberForRegion(%v, %s) = %v, want %v",
+				i, test.num, test.region, got, test.exp)
+		}
+	}
+	if !IsPossibleShortNumber(shortNumber(1, 911)) {
+		t.Error("IsPossibleShortNumber(+1 911) = false, want true")
+	}
+	if IsPossibleShortNumber(shortNumber(1, 91)) {
+		t.Error("IsPossibleShortNumber(+1 91) = true, want false")
+	}
+}
+
+func TestIsValidShortNumber(t *testing.T) {
+	var tests = []struct {
+		num    *PhoneNumber
+		region string
+		exp    bool
+	}{
+		{num: shortNumber(1, 911), region: "US", exp: true},
+		{num: shortNumber(1, 611), region: "US", exp: true},
+		{num: shortNumber(1, 111), region: "US", exp: false},
+		{num: shortNumber(44, 150), region: "GB", exp: true},
+		{num: shortNumber(44, 116123), region: "GB", exp: true},
+		{num: shortNumber(44, 911), region: "GB", exp: false},
+		{num: shortNumber(49, 115), region: "DE", exp: true},
+		{num: shortNumber(49, 112), region: "GB", exp: false},
+	}
+	for i, test := range tests {
+		if got := IsValidShortNumberForRegion(test.num, test.region); got != test.exp {
+			t.Errorf("[test %d] IsValidShortNumberForRegion(%v, %s) = %v, want %v",
+				i, test.num, test.region, got, test.exp)
+		}
+	}
+	// +1 is shared by several regions; the number is valid if it is
+	// valid in any of them.
+	if !IsValidShortNumber(shortNumber(1, 911)) {
+		t.Error("IsValidShortNumber(+1 911) = false, want true")
+	}
+	if IsValidShortNumber(shortNumber(1, 111)) {
+		t.Error("IsValidShortNumber(+1 111) = true, want false")
+	}
+	if !IsValidShortNumber(shortNumber(49, 110)) {
+		t.Error("IsValidShortNumber(+49 110) = false, want true")
+	}
+}
+
+func TestGetExpectedCost(t *testing.T) {
+	var tests = []struct {
+		num    *PhoneNumber
+		region string
+		exp    ShortNumberCost
+	}{
+		{num: shortNumber(1, 24280), region: "US", exp: ShortNumberCost_PREMIUM_RATE},
+		{num: shortNumber(33, 611), region: "FR", exp: ShortNumberCost_STANDARD_RATE},
+		{num: shortNumber(1, 611), region: "US", exp: ShortNumberCost_TOLL_FREE},
+		// Emergency numbers are implicitly toll-free.
+		{num: shortNumber(33, 17), region: "FR", exp: ShortNumberCost_TOLL_FREE},
+		{num: shortNumber(1, 12345), region: "US", exp: ShortNumberCost_UNKNOWN_COST},
+		{num: shortNumber(1, 1234567), region: "US", exp: ShortNumberCost_UNKNOWN_COST},
+		{num: shortNumber(1, 911), region: "GB", exp: ShortNumberCost_UNKNOWN_COST},
+	}
+	for i, test := range tests {
+		if got := GetExpectedCostForRegion(test.num, test.region); got != test.exp {
+			t.Errorf("[test %d] GetExpectedCostForRegion(%v, %s) = %v, want %v",
+				i, test.num, test.region, got, test.exp)
+		}
+	}
+
+	// 911 is not a short number in some of the other regions sharing
+	// +1, such as TT, so its cost there is unknown, which outranks
+	// toll-free and standard rate but not premium rate.
+	if got := GetExpectedCost(shortNumber(1, 24280)); got != ShortNumberCost_PREMIUM_RATE {
+		t.Errorf("GetExpectedCost(+1 24280) = %v, want %v", got, ShortNumberCost_PREMIUM_RATE)
+	}
+	if got := GetExpectedCost(shortNumber(1, 911)); got != ShortNumberCost_UNKNOWN_COST {
+		t.Errorf("GetExpectedCost(+1 911) = %v, want %v", got, ShortNumberCost_UNKNOWN_COST)
+	}
+	if got := GetExpectedCost(shortNumber(49, 110)); got != ShortNumberCost_TOLL_FREE {
+		t.Errorf("GetExpectedCost(+49 110) = %v, want %v", got, ShortNumberCost_TOLL_FREE)
+	}
+}
+
+func TestEmergencyNumbers(t *testing.T) {
+	var tests = []struct {
+		num      string
+		region   string
+		connects bool
+		exact    bool
+	}{
+		{num: "911", region: "US", connects: true, exact: true},
+		{num: "112", region: "US", connects: true, exact: true},
+		{num: "9-1-1", region: "US", connects: true, exact: true},
+		{num: "9111", region: "US", connects: true, exact: false},
+		{num: "+1911", region: "US", connects: false, exact: false},
+		{num: "999", region: "US", connects: false, exact: false},
+		{num: "999", region: "GB", connects: true, exact: true},
+		{num: "110", region: "DE", connects: true, exact: true},
+		{num: "17", region: "FR", connects: true, exact: true},
+		{num: "112", region: "IT", connects: true, exact: true},
+		{num: "113", region: "IT", connects: true, exact: true},
+		{num: "000", region: "AU", connects: true, exact: true},
+		// Brazilian emergency numbers don't work when followed by
+		// other digits.
+		{num: "190", region: "BR", connects: true, exact: true},
+		{num: "1900", region: "BR", connects: false, exact: false},
+		// No short number metadata for the region.
+		{num: "911", region: "ZZ", connects: false, exact: false},
+	}
+	for i, test := range tests {
+		if got := ConnectsToEmergencyNumber(test.num, test.region); got != test.connects {
+			t.Errorf("[test %d] ConnectsToEmergencyNumber(%s, %s) = %v, want %v",
+				i, test.num, test.region, got, test.connects)
+		}
+		if got := IsEmergencyNumber(test.num, test.region); got != test.exact {
+			t.Errorf("[test %d] IsEmergencyNumber(%s, %s) = %v, want %v",
+				i, test.num, test.region, got, test.exact)
+		}
+	}
+}
+
+func TestIsCarrierSpecific(t *testing.T) {
+	var tests = []struct {
+		num *PhoneNumber
+		exp bool
+	}{
+		{num: shortNumber(1, 611), exp: true},
+		{num: shortNumber(1, 911), exp: false},
+		{num: shortNumber(44, 202), exp: true},
+		{num: shortNumber(44, 999), exp: false},
+	}
+	for i, test := range tests {
+		if got := IsCarrierSpecific(test.num); got != test.exp {
+			t.Errorf("[test %d] IsCarrierSpecific(%v) = %v, want %v",
+				i, test.num, got, test.exp)
+		}
+	}
+	if !IsCarrierSpecificForRegion(shortNumber(1, 33669), "US") {
+		t.Error("IsCarrierSpecificForRegion(+1 33669, US) = false, want true")
+	}
+	if IsCarrierSpecificForRegion(shortNumber(1, 33669), "CA") {
+		t.Error("IsCarrierSpecificForRegion(+1 33669, CA) = true, want false")
+	}
+}
+
+func TestIsSmsServiceForRegion(t *testing.T) {
+	if IsSmsServiceForRegion(shortNumber(44, 24280), "US") {
+		t.Error("IsSmsServiceForRegion(+44 24280, US) = true, want false")
+	}
+	// The example number of the SMS services of a region, such as a US
+	// SMS short code, is an SMS service there.
+	var tested int
+	for region := range getSupportedShortNumberRegions() {
+		example := getShortNumberMetadataForRegion(region).GetSmsServices().GetExampleNumber()
+		if len(example) == 0 {
+			continue
+		}
+		tested++
+		num, err := Parse(example, region)
+		if err != nil {
+			t.Errorf("failed to parse %s in %s: %v", example, region, err)
+			continue
+		}
+		if !IsSmsServiceForRegion(num, region) {
+			t.Errorf("IsSmsServiceForRegion(%s, %s) = false, want true", example, region)
+		}
+	}
+	if tested == 0 {
+		t.Skip("the short number metadata describes no SMS services")
+	}
+}
+
+func TestShortNumberMetadataIsSeparate(t *testing.T) {
+	regions := getSupportedShortNumberRegions()
+	for _, region := range []string{"AU", "BR", "DE", "FR", "GB", "US"} {
+		if _, ok := regions[region]; !ok {
+			t.Errorf("no short number metadata for %s", region)
+		}
+		if getShortNumberMetadataForRegion(region).GetEmergency() == nil {
+			t.Errorf("short number metadata for %s has no emergency numbers", region)
+		}
+		// Regular metadata must not pick up the short number
+		// descriptions.
+		if defaultPhoneNumberUtil.getMetadataForRegion(region).GetEmergency() != nil {
+			t.Errorf("regular metadata for %s has emergency numbers", region)
+		}
+	}
+	if getShortNumberMetadataForRegion("ZZ") != nil {
+		t.Error("short number metadata for ZZ should be nil")
+	}
+}
+
+func TestShortNumbersUseTheMetadataOfTheirPhoneNumberUtil(t *testing.T) {
+	// Without DE, +49 has no region to dial short numbers from.
+	collection, err := unmarshalMetadataCollection(metaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metadata []*PhoneMetadata
+	for _, meta := range collection.GetMetadata() {
+		if meta.GetId() != "DE" {
+			metadata = append(metadata, meta)
+		}
+	}
+	collection.Metadata = metadata
+	data, err := MarshalMetadata(collection, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := NewPhoneNumberUtil()
+	if err := u.LoadMetadata(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	number := shortNumber(49, 110)
+	if u.IsValidShortNumber(number) {
+		t.Error("+49 110 is valid without metadata for DE")
+	}
+	if !IsValidShortNumber(number) {
+		t.Error("+49 110 is not valid with the default PhoneNumberUtil")
+	}
+}